@@ -6,12 +6,24 @@ import (
 	"log"
 	"net/http"
 	"os"
-
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/gorilla/websocket"
 	"github.com/rs/cors"
+	"github.com/swagger-editor/backend/graph"
 	"github.com/swagger-editor/backend/internal/adapters/primary/rest"
+	"github.com/swagger-editor/backend/internal/adapters/secondary/events"
 	"github.com/swagger-editor/backend/internal/adapters/secondary/repository"
+	"github.com/swagger-editor/backend/internal/core/lint"
+	"github.com/swagger-editor/backend/internal/core/ports"
 	"github.com/swagger-editor/backend/internal/core/services"
 )
 
@@ -21,14 +33,43 @@ func main() {
 		port = "8082"
 	}
 
-	// Initialize repositories (in-memory for now)
-	apiRepo := repository.NewInMemoryAPIRepository()
+	// Initialize repositories
+	apiRepo, err := newAPIRepository()
+	if err != nil {
+		log.Fatalf("Failed to open repository: %v", err)
+	}
 
 	// Initialize services with placeholders for converter and validator
 	// These will be implemented with actual logic later
 	converterService := &services.ConverterService{}
-	validatorService := &services.ValidatorService{}
-	apiService := services.NewAPIService(apiRepo, converterService, validatorService)
+	validatorService := services.NewValidatorService(apiRepo)
+	eventBus := events.NewInMemoryEventBus()
+	apiService := services.NewAPIService(apiRepo, converterService, validatorService, eventBus)
+
+	// Lint rules run with their defaults unless a YAML ruleset is configured
+	ruleset := lint.DefaultRuleset()
+	if path := os.Getenv("LINT_RULESET"); path != "" {
+		loaded, err := lint.LoadRuleset(path)
+		if err != nil {
+			log.Fatalf("Failed to load lint ruleset: %v", err)
+		}
+		ruleset = loaded
+	}
+	linterService := services.NewLinterService(ruleset)
+	diffService := services.NewDiffService(apiService, converterService)
+	mockService := services.NewMockService(apiService)
+	exampleService := services.NewExampleService(apiService)
+	var contractTargets []string
+	if targets := os.Getenv("CONTRACT_TEST_TARGETS"); targets != "" {
+		contractTargets = strings.Split(targets, ",")
+	}
+	contractService, err := services.NewContractService(apiService, contractTargets)
+	if err != nil {
+		log.Fatalf("Failed to configure contract tests: %v", err)
+	}
+	trafficService := services.NewTrafficService(apiService)
+	graphQLExportService := services.NewGraphQLExportService(apiService)
+	codegenService := services.NewCodegenService(apiService)
 
 	// Create router
 	r := chi.NewRouter()
@@ -40,11 +81,12 @@ func main() {
 	r.Use(middleware.RealIP)
 
 	// CORS
+	allowedOrigins := []string{"http://localhost:4000"}
 	corsMiddleware := cors.New(cors.Options{
-		AllowedOrigins:   []string{"http://localhost:4000"},
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedOrigins:   allowedOrigins,
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-Author", "Prefer"},
+		ExposedHeaders:   []string{"Link", "X-Export-Warning"},
 		AllowCredentials: true,
 		MaxAge:           300,
 	})
@@ -59,17 +101,34 @@ func main() {
 		})
 	})
 
+	// Initialize REST handlers
+	restHandler := rest.NewHandler(apiService, converterService, validatorService, linterService, diffService, mockService, exampleService, contractService, trafficService, graphQLExportService, codegenService)
+
 	// REST API routes
 	r.Route("/api/v1", func(r chi.Router) {
-		// Initialize REST handlers
-		restHandler := rest.NewHandler(apiService, converterService, validatorService)
-
 		// API definitions
 		r.Get("/definitions", restHandler.ListAPIDefinitions)
 		r.Post("/definitions", restHandler.CreateAPIDefinition)
 		r.Get("/definitions/{id}", restHandler.GetAPIDefinition)
 		r.Put("/definitions/{id}", restHandler.UpdateAPIDefinition)
 		r.Delete("/definitions/{id}", restHandler.DeleteAPIDefinition)
+		r.Get("/definitions/{id}/extensions", restHandler.GetExtensions)
+		r.Post("/definitions/{id}/validate", restHandler.ValidateAPIDefinition)
+
+		// Revision history
+		r.Get("/definitions/{id}/revisions", restHandler.ListRevisions)
+		r.Get("/definitions/{id}/revisions/{revision}", restHandler.GetRevision)
+		r.Post("/definitions/{id}/revisions/{revision}/restore", restHandler.RestoreRevision)
+		r.Get("/definitions/{id}/compat", restHandler.CheckCompatibility)
+
+		// Examples
+		r.Get("/definitions/{id}/schemas/{schemaId}/example", restHandler.GetSchemaExample)
+		r.Post("/definitions/{id}/schemas/{schemaId}/example", restHandler.WriteSchemaExample)
+		r.Post("/definitions/{id}/examples", restHandler.WriteExamples)
+
+		// Contract and traffic validation
+		r.Post("/definitions/{id}/contract-test", restHandler.RunContractTest)
+		r.Post("/definitions/{id}/traffic", restHandler.ValidateTraffic)
 
 		// Conversion endpoints
 		r.Post("/convert/swagger-to-json", restHandler.ConvertSwaggerToJSON)
@@ -79,42 +138,35 @@ func main() {
 		r.Post("/validate/swagger", restHandler.ValidateSwagger)
 		r.Post("/validate/json", restHandler.ValidateJSON)
 
+		// Style checks
+		r.Post("/lint", restHandler.Lint)
+
+		// Semantic diff
+		r.Post("/diff", restHandler.Diff)
+
 		// Import/Export
 		r.Post("/import", restHandler.ImportSwagger)
 		r.Get("/export/{id}", restHandler.ExportSwagger)
+		r.Get("/definitions/{id}/export", restHandler.ExportDefinition)
+		r.Get("/definitions/{id}/codegen/go", restHandler.GenerateGoCode)
+		r.Get("/definitions/{id}/codegen/typescript", restHandler.GenerateTypeScriptCode)
 	})
 
-	// GraphQL endpoint (placeholder for now)
-	r.Post("/graphql", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(map[string]string{
-			"message": "GraphQL endpoint will be available soon",
-		})
-	})
+	// Mock servers of stored definitions
+	r.HandleFunc("/mock/{id}", restHandler.Mock)
+	r.HandleFunc("/mock/{id}/*", restHandler.Mock)
 
-	// GraphQL playground
+	// GraphQL endpoint and playground. Subscriptions upgrade a GET request
+	// to a WebSocket, or stream server-sent events in reply to a POST.
+	graphQLHandler := newGraphQLHandler(apiService, converterService, validatorService, eventBus, allowedOrigins)
+	playgroundHandler := playground.Handler("GraphQL Playground", "/graphql")
+	r.Post("/graphql", graphQLHandler.ServeHTTP)
 	r.Get("/graphql", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "text/html")
-		w.Write([]byte(`
-			<!DOCTYPE html>
-			<html>
-			<head>
-				<title>GraphQL Playground</title>
-				<style>
-					body { font-family: sans-serif; padding: 20px; }
-					h1 { color: #333; }
-					.info { background: #f0f0f0; padding: 20px; border-radius: 5px; }
-				</style>
-			</head>
-			<body>
-				<h1>GraphQL Playground</h1>
-				<div class="info">
-					<p>GraphQL endpoint will be available at <code>/graphql</code></p>
-					<p>The playground will be activated once GraphQL is fully configured.</p>
-				</div>
-			</body>
-			</html>
-		`))
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			graphQLHandler.ServeHTTP(w, r)
+			return
+		}
+		playgroundHandler.ServeHTTP(w, r)
 	})
 
 	// Static file server for frontend (if needed in production)
@@ -130,4 +182,51 @@ func main() {
 	if err := http.ListenAndServe(fmt.Sprintf(":%s", port), r); err != nil {
 		log.Fatal(err)
 	}
-}
\ No newline at end of file
+}
+
+// newAPIRepository picks the storage adapter named by STORAGE: "memory", the
+// default, keeps definitions until the server stops; "file" stores them in
+// DATA_DIR and "sqlite" in the SQLite database at DATABASE_URL
+func newAPIRepository() (ports.APIRepository, error) {
+	switch storage := os.Getenv("STORAGE"); storage {
+	case "", "memory":
+		return repository.NewInMemoryAPIRepository(), nil
+	case "file":
+		dir := os.Getenv("DATA_DIR")
+		if dir == "" {
+			dir = "data"
+		}
+		log.Printf("💾 Storing definitions in %s", dir)
+		return repository.NewFileAPIRepository(dir)
+	case "sqlite":
+		path := os.Getenv("DATABASE_URL")
+		if path == "" {
+			path = "data/swagger-editor.db"
+		}
+		log.Printf("💾 Storing definitions in %s", path)
+		return repository.NewSQLiteAPIRepository(path)
+	default:
+		return nil, fmt.Errorf("unknown STORAGE %q: use memory, file or sqlite", storage)
+	}
+}
+
+// newGraphQLHandler serves the GraphQL schema over POST requests, with
+// introspection for the playground, and subscriptions over WebSockets from
+// the allowed origins and over server-sent events
+func newGraphQLHandler(apiService ports.APIService, converterService ports.ConverterService, validatorService ports.ValidatorService, events ports.EventBus, allowedOrigins []string) http.Handler {
+	resolver := graph.NewResolver(apiService, converterService, validatorService, events)
+	server := handler.New(graph.NewExecutableSchema(graph.Config{Resolvers: resolver}))
+	server.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get("Origin")
+				return origin == "" || origin == "http://"+r.Host || slices.Contains(allowedOrigins, origin)
+			},
+		},
+	})
+	server.AddTransport(transport.SSE{})
+	server.AddTransport(transport.POST{})
+	server.Use(extension.Introspection{})
+	return server
+}
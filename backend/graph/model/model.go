@@ -0,0 +1,24 @@
+// Package model holds the GraphQL types that have no domain counterpart of
+// their own
+package model
+
+import "github.com/swagger-editor/backend/internal/core/domain"
+
+// Endpoint is an endpoint together with the definition it belongs to, so
+// that the parameters, request body and responses it refers to by ID can be
+// resolved
+type Endpoint struct {
+	domain.Endpoint
+	Definition *domain.APIDefinition
+}
+
+// Content is a media type of a request or response body together with its
+// name, which keys it in the definition
+type Content struct {
+	MediaType  string
+	Schema     interface{}
+	Example    interface{}
+	Examples   map[string]interface{}
+	Encoding   map[string]interface{}
+	Extensions map[string]interface{}
+}
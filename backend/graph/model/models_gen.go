@@ -0,0 +1,22 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package model
+
+import (
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// A response of an endpoint with the status code it is declared for
+type EndpointResponse struct {
+	Code     string           `json:"code"`
+	Response *domain.Response `json:"response"`
+}
+
+type Mutation struct {
+}
+
+type Query struct {
+}
+
+type Subscription struct {
+}
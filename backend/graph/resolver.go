@@ -0,0 +1,119 @@
+// Package graph serves the GraphQL API. The schema lives in the .graphqls
+// files; generated.go and the resolver stubs are produced from it with
+// go generate.
+package graph
+
+//go:generate go run github.com/99designs/gqlgen generate
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/swagger-editor/backend/graph/model"
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/ports"
+)
+
+// Resolver resolves GraphQL operations through the same services as the
+// REST API
+type Resolver struct {
+	apiService       ports.APIService
+	converterService ports.ConverterService
+	validatorService ports.ValidatorService
+	events           ports.EventBus
+}
+
+// NewResolver creates the root resolver; subscriptions follow events
+func NewResolver(apiService ports.APIService, converterService ports.ConverterService, validatorService ports.ValidatorService, events ports.EventBus) *Resolver {
+	return &Resolver{
+		apiService:       apiService,
+		converterService: converterService,
+		validatorService: validatorService,
+		events:           events,
+	}
+}
+
+// definitionFromJSON reads a definition given in its normalized JSON form
+func definitionFromJSON(value map[string]interface{}) (*domain.APIDefinition, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var api domain.APIDefinition
+	if err := json.Unmarshal(data, &api); err != nil {
+		return nil, fmt.Errorf("invalid definition: %w", err)
+	}
+	return &api, nil
+}
+
+// filesFromJSON reads the documents of a multi-file specification, keyed by
+// path relative to the root document
+func filesFromJSON(value map[string]interface{}) (map[string]string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	files := make(map[string]string, len(value))
+	for path, content := range value {
+		text, ok := content.(string)
+		if !ok {
+			return nil, fmt.Errorf("file %s must be given as a string", path)
+		}
+		files[path] = text
+	}
+	return files, nil
+}
+
+func changeFromInput(change *domain.Change) domain.Change {
+	if change == nil {
+		return domain.Change{}
+	}
+	return *change
+}
+
+// requirements returns security requirements as JSON objects
+func requirements(security []domain.SecurityRequirement) []map[string]interface{} {
+	if security == nil {
+		return nil
+	}
+	result := make([]map[string]interface{}, len(security))
+	for i, requirement := range security {
+		result[i] = make(map[string]interface{}, len(requirement))
+		for scheme, scopes := range requirement {
+			result[i][scheme] = scopes
+		}
+	}
+	return result
+}
+
+// endpoints pairs endpoints with the definition they belong to
+func endpoints(api *domain.APIDefinition, list []domain.Endpoint) []*model.Endpoint {
+	result := make([]*model.Endpoint, len(list))
+	for i, endpoint := range list {
+		result[i] = &model.Endpoint{Endpoint: endpoint, Definition: api}
+	}
+	return result
+}
+
+// contents lists the media types of a body, sorted by name
+func contents(content map[string]domain.MediaType) []*model.Content {
+	names := make([]string, 0, len(content))
+	for name := range content {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]*model.Content, len(names))
+	for i, name := range names {
+		media := content[name]
+		result[i] = &model.Content{
+			MediaType:  name,
+			Schema:     media.Schema,
+			Example:    media.Example,
+			Examples:   media.Examples,
+			Encoding:   media.Encoding,
+			Extensions: media.Extensions,
+		}
+	}
+	return result
+}
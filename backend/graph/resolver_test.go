@@ -0,0 +1,216 @@
+package graph
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/swagger-editor/backend/internal/adapters/secondary/events"
+	"github.com/swagger-editor/backend/internal/adapters/secondary/repository"
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/services"
+)
+
+const petStore = `
+openapi: 3.0.3
+info:
+  title: Pet Store
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      tags: [pets]
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+      responses:
+        "200":
+          description: The pets
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Pet'
+    post:
+      operationId: createPet
+      tags: [pets]
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+      responses:
+        "201":
+          description: Created
+  /stores:
+    get:
+      operationId: listStores
+      tags: [stores]
+      responses:
+        "200":
+          description: The stores
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+`
+
+func newTestResolver() *Resolver {
+	converterService := &services.ConverterService{}
+	apiRepo := repository.NewInMemoryAPIRepository()
+	validatorService := services.NewValidatorService(apiRepo)
+	eventBus := events.NewInMemoryEventBus()
+	apiService := services.NewAPIService(apiRepo, converterService, validatorService, eventBus)
+	return NewResolver(apiService, converterService, validatorService, eventBus)
+}
+
+func newTestClient(resolver *Resolver) *client.Client {
+	server := handler.New(NewExecutableSchema(Config{Resolvers: resolver}))
+	server.AddTransport(transport.POST{})
+	return client.New(server)
+}
+
+// TestQueryNestedEndpoints imports a definition and fetches its endpoints,
+// filtered by tag and method, with their parameters, bodies and responses
+// in one query
+func TestQueryNestedEndpoints(t *testing.T) {
+	c := newTestClient(newTestResolver())
+
+	var imported struct {
+		ImportDefinition struct{ ID string }
+	}
+	c.MustPost(`mutation($content: String!) { importDefinition(content: $content) { id } }`,
+		&imported, client.Var("content", petStore))
+
+	var resp struct {
+		Definition struct {
+			Metadata  struct{ Name string }
+			Endpoints []struct {
+				OperationID string
+				Parameters  []struct{ Name, In string }
+				RequestBody *struct {
+					Content []struct {
+						MediaType string
+						Schema    interface{}
+					}
+				}
+				Responses []struct {
+					Code     string
+					Response struct{ Description string }
+				}
+			}
+			Schema struct {
+				Name     string
+				Required []string
+			}
+		}
+	}
+	c.MustPost(`query($id: ID!) {
+		definition(id: $id) {
+			metadata { name }
+			endpoints(tag: "pets", method: "get") {
+				operationId
+				parameters { name in }
+				requestBody { content { mediaType schema } }
+				responses { code response { description } }
+			}
+			schema(id: "Pet") { name required }
+		}
+	}`, &resp, client.Var("id", imported.ImportDefinition.ID))
+
+	definition := resp.Definition
+	if definition.Metadata.Name != "Pet Store" {
+		t.Errorf("name = %q, want Pet Store", definition.Metadata.Name)
+	}
+	if len(definition.Endpoints) != 1 || definition.Endpoints[0].OperationID != "listPets" {
+		t.Fatalf("endpoints = %+v, want only listPets", definition.Endpoints)
+	}
+	endpoint := definition.Endpoints[0]
+	if len(endpoint.Parameters) != 1 || endpoint.Parameters[0].Name != "limit" || endpoint.Parameters[0].In != "query" {
+		t.Errorf("parameters = %+v, want the limit query parameter", endpoint.Parameters)
+	}
+	if endpoint.RequestBody != nil {
+		t.Errorf("requestBody = %+v, want none", endpoint.RequestBody)
+	}
+	if len(endpoint.Responses) != 1 || endpoint.Responses[0].Code != "200" || endpoint.Responses[0].Response.Description != "The pets" {
+		t.Errorf("responses = %+v, want the 200 response", endpoint.Responses)
+	}
+	if definition.Schema.Name != "Pet" || len(definition.Schema.Required) != 1 {
+		t.Errorf("schema = %+v, want Pet requiring name", definition.Schema)
+	}
+}
+
+// TestValidateMutation reports problems of a document as data rather than
+// as a GraphQL error
+func TestValidateMutation(t *testing.T) {
+	c := newTestClient(newTestResolver())
+
+	var resp struct {
+		Validate struct {
+			Valid  bool
+			Errors []struct{ Message string }
+		}
+	}
+	c.MustPost(`mutation { validate(content: "openapi: 3.0.3\ninfo:\n  title: Broken\n") { valid errors { message } } }`, &resp)
+
+	if resp.Validate.Valid || len(resp.Validate.Errors) == 0 {
+		t.Errorf("validate = %+v, want errors for a document without a version and paths", resp.Validate)
+	}
+}
+
+// TestDefinitionEventsFiltersByDefinition follows one definition while two
+// are saved, and sees only the changes to the one it follows
+func TestDefinitionEventsFiltersByDefinition(t *testing.T) {
+	resolver := newTestResolver()
+	c := newTestClient(resolver)
+
+	definition := map[string]interface{}{"metadata": map[string]interface{}{"name": "Pet Store", "version": "1.0.0"}}
+	var first, second struct {
+		CreateDefinition struct{ ID string }
+	}
+	c.MustPost(`mutation($definition: JSON!) { createDefinition(definition: $definition) { id } }`,
+		&first, client.Var("definition", definition))
+	c.MustPost(`mutation($definition: JSON!) { createDefinition(definition: $definition) { id } }`,
+		&second, client.Var("definition", definition))
+	followed := first.CreateDefinition.ID
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := resolver.Subscription().DefinitionEvents(ctx, &followed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var validated struct{ ValidateDefinition struct{ Valid bool } }
+	c.MustPost(`mutation($id: ID!) { validateDefinition(id: $id) { valid } }`,
+		&validated, client.Var("id", second.CreateDefinition.ID))
+	c.MustPost(`mutation($id: ID!) { validateDefinition(id: $id) { valid } }`,
+		&validated, client.Var("id", followed))
+	var deleted struct{ DeleteDefinition bool }
+	c.MustPost(`mutation($id: ID!) { deleteDefinition(id: $id) }`, &deleted, client.Var("id", followed))
+
+	for _, want := range []domain.DefinitionEventType{domain.DefinitionValidated, domain.DefinitionDeleted} {
+		select {
+		case event := <-events:
+			if event.Type != want || event.DefinitionID != followed {
+				t.Errorf("event = %s of %s, want %s of %s", event.Type, event.DefinitionID, want, followed)
+			}
+			if want == domain.DefinitionValidated && (event.Valid == nil || !*event.Valid) {
+				t.Errorf("validated event = %+v, want it to report the definition valid", event)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("no %s event received", want)
+		}
+	}
+}
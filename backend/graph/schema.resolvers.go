@@ -0,0 +1,160 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.80
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// CreateDefinition is the resolver for the createDefinition field.
+func (r *mutationResolver) CreateDefinition(ctx context.Context, definition map[string]any, change *domain.Change) (*domain.APIDefinition, error) {
+	api, err := definitionFromJSON(definition)
+	if err != nil {
+		return nil, err
+	}
+	return r.apiService.CreateAPIDefinition(ctx, api, changeFromInput(change))
+}
+
+// UpdateDefinition is the resolver for the updateDefinition field.
+func (r *mutationResolver) UpdateDefinition(ctx context.Context, id string, definition map[string]any, change *domain.Change) (*domain.APIDefinition, error) {
+	api, err := definitionFromJSON(definition)
+	if err != nil {
+		return nil, err
+	}
+	return r.apiService.UpdateAPIDefinition(ctx, id, api, changeFromInput(change))
+}
+
+// DeleteDefinition is the resolver for the deleteDefinition field.
+func (r *mutationResolver) DeleteDefinition(ctx context.Context, id string) (bool, error) {
+	if err := r.apiService.DeleteAPIDefinition(ctx, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ImportDefinition is the resolver for the importDefinition field.
+func (r *mutationResolver) ImportDefinition(ctx context.Context, content string, files map[string]any, change *domain.Change) (*domain.APIDefinition, error) {
+	bundle, err := filesFromJSON(files)
+	if err != nil {
+		return nil, err
+	}
+	return r.apiService.ImportSwagger(ctx, content, bundle, changeFromInput(change))
+}
+
+// Convert is the resolver for the convert field.
+func (r *mutationResolver) Convert(ctx context.Context, input domain.ConversionRequest) (*domain.ConversionResponse, error) {
+	return r.converterService.ConvertSwaggerToJSON(ctx, &input)
+}
+
+// ConvertToSwagger is the resolver for the convertToSwagger field.
+func (r *mutationResolver) ConvertToSwagger(ctx context.Context, definition map[string]any, format *string, version *string) (string, error) {
+	api, err := definitionFromJSON(definition)
+	if err != nil {
+		return "", err
+	}
+	target := "yaml"
+	if format != nil && *format != "" {
+		target = *format
+	}
+	openAPIVersion := ""
+	if version != nil {
+		openAPIVersion = *version
+	}
+	content, warnings, err := r.converterService.ConvertJSONToSwagger(ctx, api, target, openAPIVersion)
+	if err != nil {
+		return "", err
+	}
+	if len(warnings) > 0 {
+		graphql.RegisterExtension(ctx, "exportWarnings", warnings)
+	}
+	return content, nil
+}
+
+// Validate is the resolver for the validate field.
+func (r *mutationResolver) Validate(ctx context.Context, content string) (*domain.ValidationResponse, error) {
+	return r.validatorService.ValidateSwagger(ctx, content)
+}
+
+// ValidateJSON is the resolver for the validateJSON field.
+func (r *mutationResolver) ValidateJSON(ctx context.Context, input domain.ValidationRequest) (*domain.ValidationResponse, error) {
+	return r.validatorService.ValidateJSON(ctx, &input)
+}
+
+// ValidateDefinition is the resolver for the validateDefinition field.
+func (r *mutationResolver) ValidateDefinition(ctx context.Context, id string) (*domain.ValidationResponse, error) {
+	return r.apiService.ValidateStored(ctx, id)
+}
+
+// Definitions is the resolver for the definitions field.
+func (r *queryResolver) Definitions(ctx context.Context, extension *string) ([]*domain.APIDefinition, error) {
+	if extension != nil && *extension != "" {
+		return r.apiService.FindByExtension(ctx, *extension)
+	}
+	return r.apiService.ListAPIDefinitions(ctx)
+}
+
+// Definition is the resolver for the definition field.
+func (r *queryResolver) Definition(ctx context.Context, id string) (*domain.APIDefinition, error) {
+	return r.apiService.GetAPIDefinition(ctx, id)
+}
+
+// Revisions is the resolver for the revisions field.
+func (r *queryResolver) Revisions(ctx context.Context, definitionID string) ([]*domain.Revision, error) {
+	return r.apiService.ListRevisions(ctx, definitionID)
+}
+
+// DefinitionEvents is the resolver for the definitionEvents field.
+func (r *subscriptionResolver) DefinitionEvents(ctx context.Context, definitionID *string) (<-chan *domain.DefinitionEvent, error) {
+	filter := ""
+	if definitionID != nil {
+		filter = *definitionID
+	}
+
+	events := r.events.Subscribe(ctx, filter)
+	result := make(chan *domain.DefinitionEvent)
+	go func() {
+		defer close(result)
+		for event := range events {
+			select {
+			case result <- &event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return result, nil
+}
+
+// Files is the resolver for the files field.
+func (r *conversionRequestResolver) Files(ctx context.Context, obj *domain.ConversionRequest, data map[string]any) error {
+	files, err := filesFromJSON(data)
+	if err != nil {
+		return err
+	}
+	obj.Files = files
+	return nil
+}
+
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
+// ConversionRequest returns ConversionRequestResolver implementation.
+func (r *Resolver) ConversionRequest() ConversionRequestResolver {
+	return &conversionRequestResolver{r}
+}
+
+type mutationResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
+type conversionRequestResolver struct{ *Resolver }
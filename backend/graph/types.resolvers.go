@@ -0,0 +1,157 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.80
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/swagger-editor/backend/graph/model"
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// Endpoints is the resolver for the endpoints field.
+func (r *aPIDefinitionResolver) Endpoints(ctx context.Context, obj *domain.APIDefinition, tag *string, method *string) ([]*model.Endpoint, error) {
+	var result []*model.Endpoint
+	for _, endpoint := range endpoints(obj, obj.Endpoints) {
+		if tag != nil && !slices.Contains(endpoint.Tags, *tag) {
+			continue
+		}
+		if method != nil && !strings.EqualFold(endpoint.Method, *method) {
+			continue
+		}
+		result = append(result, endpoint)
+	}
+	return result, nil
+}
+
+// Webhooks is the resolver for the webhooks field.
+func (r *aPIDefinitionResolver) Webhooks(ctx context.Context, obj *domain.APIDefinition) ([]*model.Endpoint, error) {
+	return endpoints(obj, obj.Webhooks), nil
+}
+
+// Schema is the resolver for the schema field.
+func (r *aPIDefinitionResolver) Schema(ctx context.Context, obj *domain.APIDefinition, id string) (*domain.Schema, error) {
+	for _, schema := range obj.Schemas {
+		if schema.ID == id {
+			return &schema, nil
+		}
+	}
+	for _, schema := range obj.Schemas {
+		if schema.Name == id {
+			return &schema, nil
+		}
+	}
+	return nil, nil
+}
+
+// Security is the resolver for the security field.
+func (r *aPIDefinitionResolver) Security(ctx context.Context, obj *domain.APIDefinition) ([]map[string]any, error) {
+	return requirements(obj.Security), nil
+}
+
+// Parameters is the resolver for the parameters field.
+func (r *endpointResolver) Parameters(ctx context.Context, obj *model.Endpoint) ([]*domain.Parameter, error) {
+	result := make([]*domain.Parameter, 0, len(obj.Parameters))
+	for _, id := range obj.Parameters {
+		for i := range obj.Definition.Parameters {
+			if obj.Definition.Parameters[i].ID == id {
+				result = append(result, &obj.Definition.Parameters[i])
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// RequestBody is the resolver for the requestBody field.
+func (r *endpointResolver) RequestBody(ctx context.Context, obj *model.Endpoint) (*domain.RequestBody, error) {
+	if obj.Endpoint.RequestBody == "" {
+		return nil, nil
+	}
+	for i := range obj.Definition.RequestBodies {
+		if obj.Definition.RequestBodies[i].ID == obj.Endpoint.RequestBody {
+			return &obj.Definition.RequestBodies[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// Responses is the resolver for the responses field.
+func (r *endpointResolver) Responses(ctx context.Context, obj *model.Endpoint) ([]*model.EndpointResponse, error) {
+	codes := make([]string, 0, len(obj.Endpoint.Responses))
+	for code := range obj.Endpoint.Responses {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	result := make([]*model.EndpointResponse, 0, len(codes))
+	for _, code := range codes {
+		for i := range obj.Definition.Responses {
+			if obj.Definition.Responses[i].ID == obj.Endpoint.Responses[code] {
+				result = append(result, &model.EndpointResponse{Code: code, Response: &obj.Definition.Responses[i]})
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// Security is the resolver for the security field.
+func (r *endpointResolver) Security(ctx context.Context, obj *model.Endpoint) ([]map[string]any, error) {
+	return requirements(obj.Endpoint.Security), nil
+}
+
+// Content is the resolver for the content field.
+func (r *parameterResolver) Content(ctx context.Context, obj *domain.Parameter) ([]*model.Content, error) {
+	return contents(obj.Content), nil
+}
+
+// Content is the resolver for the content field.
+func (r *requestBodyResolver) Content(ctx context.Context, obj *domain.RequestBody) ([]*model.Content, error) {
+	return contents(obj.Content), nil
+}
+
+// Content is the resolver for the content field.
+func (r *responseResolver) Content(ctx context.Context, obj *domain.Response) ([]*model.Content, error) {
+	return contents(obj.Content), nil
+}
+
+// APIDefinition returns APIDefinitionResolver implementation.
+func (r *Resolver) APIDefinition() APIDefinitionResolver { return &aPIDefinitionResolver{r} }
+
+// Endpoint returns EndpointResolver implementation.
+func (r *Resolver) Endpoint() EndpointResolver { return &endpointResolver{r} }
+
+// Parameter returns ParameterResolver implementation.
+func (r *Resolver) Parameter() ParameterResolver { return &parameterResolver{r} }
+
+// RequestBody returns RequestBodyResolver implementation.
+func (r *Resolver) RequestBody() RequestBodyResolver { return &requestBodyResolver{r} }
+
+// Response returns ResponseResolver implementation.
+func (r *Resolver) Response() ResponseResolver { return &responseResolver{r} }
+
+type aPIDefinitionResolver struct{ *Resolver }
+type endpointResolver struct{ *Resolver }
+type parameterResolver struct{ *Resolver }
+type requestBodyResolver struct{ *Resolver }
+type responseResolver struct{ *Resolver }
+
+// !!! WARNING !!!
+// The code below was going to be deleted when updating resolvers. It has been copied here so you have
+// one last chance to move it out of harms way if you want. There are two reasons this happens:
+//  - When renaming or deleting a resolver the old code will be put in here. You can safely delete
+//    it when you're done.
+//  - You have helper methods in this file. Move them out to keep these resolver files clean.
+/*
+	func (r *definitionEventResolver) Type(ctx context.Context, obj *domain.DefinitionEvent) (string, error) {
+	panic(fmt.Errorf("not implemented: Type - type"))
+}
+func (r *Resolver) DefinitionEvent() DefinitionEventResolver { return &definitionEventResolver{r} }
+type definitionEventResolver struct{ *Resolver }
+*/
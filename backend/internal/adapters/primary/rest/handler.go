@@ -1,8 +1,13 @@
 package rest
 
 import (
+	"archive/zip"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/swagger-editor/backend/internal/core/domain"
@@ -14,6 +19,14 @@ type Handler struct {
 	apiService       ports.APIService
 	converterService ports.ConverterService
 	validatorService ports.ValidatorService
+	linterService    ports.LinterService
+	diffService      ports.DiffService
+	mockService      ports.MockService
+	exampleService   ports.ExampleService
+	contractService  ports.ContractService
+	trafficService   ports.TrafficService
+	graphQLExport    ports.GraphQLExportService
+	codegenService   ports.CodegenService
 }
 
 // NewHandler creates a new REST handler
@@ -21,16 +34,44 @@ func NewHandler(
 	apiService ports.APIService,
 	converterService ports.ConverterService,
 	validatorService ports.ValidatorService,
+	linterService ports.LinterService,
+	diffService ports.DiffService,
+	mockService ports.MockService,
+	exampleService ports.ExampleService,
+	contractService ports.ContractService,
+	trafficService ports.TrafficService,
+	graphQLExport ports.GraphQLExportService,
+	codegenService ports.CodegenService,
 ) *Handler {
 	return &Handler{
 		apiService:       apiService,
 		converterService: converterService,
 		validatorService: validatorService,
+		linterService:    linterService,
+		diffService:      diffService,
+		mockService:      mockService,
+		exampleService:   exampleService,
+		contractService:  contractService,
+		trafficService:   trafficService,
+		graphQLExport:    graphQLExport,
+		codegenService:   codegenService,
 	}
 }
 
-// ListAPIDefinitions lists all API definitions
+// ListAPIDefinitions lists all API definitions, or only those that set the
+// x- extension named by the extension query parameter
 func (h *Handler) ListAPIDefinitions(w http.ResponseWriter, r *http.Request) {
+	if key := r.URL.Query().Get("extension"); key != "" {
+		apis, err := h.apiService.FindByExtension(r.Context(), key)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		respondWithJSON(w, http.StatusOK, apis)
+		return
+	}
+
 	apis, err := h.apiService.ListAPIDefinitions(r.Context())
 	if err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
@@ -40,6 +81,19 @@ func (h *Handler) ListAPIDefinitions(w http.ResponseWriter, r *http.Request) {
 	respondWithJSON(w, http.StatusOK, apis)
 }
 
+// GetExtensions lists where x- extensions are set in an API definition
+func (h *Handler) GetExtensions(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	usages, err := h.apiService.GetExtensions(r.Context(), id, r.URL.Query().Get("key"))
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, usages)
+}
+
 // CreateAPIDefinition creates a new API definition
 func (h *Handler) CreateAPIDefinition(w http.ResponseWriter, r *http.Request) {
 	var api domain.APIDefinition
@@ -48,7 +102,11 @@ func (h *Handler) CreateAPIDefinition(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	created, err := h.apiService.CreateAPIDefinition(r.Context(), &api)
+	created, err := h.apiService.CreateAPIDefinition(r.Context(), &api, changeFromRequest(r))
+	if errors.Is(err, domain.ErrConflict) {
+		respondWithError(w, http.StatusConflict, err.Error())
+		return
+	}
 	if err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
@@ -80,7 +138,7 @@ func (h *Handler) UpdateAPIDefinition(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	updated, err := h.apiService.UpdateAPIDefinition(r.Context(), id, &api)
+	updated, err := h.apiService.UpdateAPIDefinition(r.Context(), id, &api, changeFromRequest(r))
 	if err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
@@ -89,6 +147,73 @@ func (h *Handler) UpdateAPIDefinition(w http.ResponseWriter, r *http.Request) {
 	respondWithJSON(w, http.StatusOK, updated)
 }
 
+// ValidateAPIDefinition validates a stored API definition, letting the
+// clients that follow it know the result
+func (h *Handler) ValidateAPIDefinition(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	result, err := h.apiService.ValidateStored(r.Context(), id)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, result)
+}
+
+// ListRevisions lists the revisions of an API definition
+func (h *Handler) ListRevisions(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	revisions, err := h.apiService.ListRevisions(r.Context(), id)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, revisions)
+}
+
+// GetRevision retrieves an API definition as it was at a revision
+func (h *Handler) GetRevision(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	number, err := strconv.Atoi(chi.URLParam(r, "revision"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid revision number")
+		return
+	}
+
+	api, err := h.apiService.GetRevision(r.Context(), id, number)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, api)
+}
+
+// RestoreRevision makes an old revision the current state of an API definition
+func (h *Handler) RestoreRevision(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	number, err := strconv.Atoi(chi.URLParam(r, "revision"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid revision number")
+		return
+	}
+
+	api, err := h.apiService.RestoreRevision(r.Context(), id, number, changeFromRequest(r))
+	if errors.Is(err, domain.ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, api)
+}
+
 // DeleteAPIDefinition deletes an API definition
 func (h *Handler) DeleteAPIDefinition(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
@@ -131,11 +256,14 @@ func (h *Handler) ConvertJSONToSwagger(w http.ResponseWriter, r *http.Request) {
 		format = "yaml"
 	}
 
-	result, err := h.converterService.ConvertJSONToSwagger(r.Context(), &api, format)
+	version := r.URL.Query().Get("version")
+
+	result, warnings, err := h.converterService.ConvertJSONToSwagger(r.Context(), &api, format, version)
 	if err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+	setExportWarnings(w, warnings)
 
 	// Return as text for YAML, JSON for JSON format
 	if format == "yaml" {
@@ -150,14 +278,15 @@ func (h *Handler) ConvertJSONToSwagger(w http.ResponseWriter, r *http.Request) {
 // ValidateSwagger validates a Swagger specification
 func (h *Handler) ValidateSwagger(w http.ResponseWriter, r *http.Request) {
 	var request struct {
-		Content string `json:"content"`
+		Content string            `json:"content"`
+		Files   map[string]string `json:"files"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
 		return
 	}
 
-	result, err := h.validatorService.ValidateSwagger(r.Context(), request.Content)
+	result, err := h.validatorService.ValidateSwaggerBundle(r.Context(), request.Content, request.Files)
 	if err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
@@ -183,17 +312,244 @@ func (h *Handler) ValidateJSON(w http.ResponseWriter, r *http.Request) {
 	respondWithJSON(w, http.StatusOK, result)
 }
 
+// Lint checks a specification against the lint rules. A ruleset in the
+// request that cannot be used is the client's mistake.
+func (h *Handler) Lint(w http.ResponseWriter, r *http.Request) {
+	var request domain.LintRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	result, err := h.linterService.Lint(r.Context(), &request)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, result)
+}
+
+// Diff compares two API definitions. The changes are returned as JSON, or
+// as Markdown when the format query parameter or field is markdown.
+func (h *Handler) Diff(w http.ResponseWriter, r *http.Request) {
+	var request domain.DiffRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	if format := r.URL.Query().Get("format"); format != "" {
+		request.Format = format
+	}
+
+	result, err := h.diffService.Diff(r.Context(), &request)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if request.Format == "markdown" {
+		w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(result.Markdown))
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, result)
+}
+
+// CheckCompatibility reports which changes to an API definition since the
+// revision named by the against query parameter break clients. With
+// enforce=true, a blocked release is answered with 409 Conflict so that a
+// release gate can fail on the status alone.
+func (h *Handler) CheckCompatibility(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	against := 0
+	if value := r.URL.Query().Get("against"); value != "" {
+		number, err := strconv.Atoi(value)
+		if err != nil || number < 1 {
+			respondWithError(w, http.StatusBadRequest, "Invalid revision number")
+			return
+		}
+		against = number
+	}
+
+	report, err := h.diffService.CheckCompatibility(r.Context(), id, against)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	if report.ReleaseBlocked && r.URL.Query().Get("enforce") == "true" {
+		respondWithJSON(w, http.StatusConflict, report)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, report)
+}
+
+// GetSchemaExample synthesizes an example for a schema of an API
+// definition, drawn from the seed query parameter
+func (h *Handler) GetSchemaExample(w http.ResponseWriter, r *http.Request) {
+	seed, ok := seedFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.exampleService.GenerateExample(r.Context(), chi.URLParam(r, "id"), chi.URLParam(r, "schemaId"), seed)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, result)
+}
+
+// WriteSchemaExample stores a synthesized example in a schema of an API
+// definition. An existing example is kept unless overwrite=true.
+func (h *Handler) WriteSchemaExample(w http.ResponseWriter, r *http.Request) {
+	h.writeExamples(w, r, chi.URLParam(r, "schemaId"))
+}
+
+// WriteExamples stores synthesized examples in every schema and media type
+// of an API definition that lacks one, or in all of them with overwrite=true
+func (h *Handler) WriteExamples(w http.ResponseWriter, r *http.Request) {
+	h.writeExamples(w, r, "")
+}
+
+func (h *Handler) writeExamples(w http.ResponseWriter, r *http.Request, schemaID string) {
+	seed, ok := seedFromRequest(w, r)
+	if !ok {
+		return
+	}
+	overwrite := r.URL.Query().Get("overwrite") == "true"
+
+	api, err := h.exampleService.WriteExamples(r.Context(), chi.URLParam(r, "id"), schemaID, seed, overwrite, changeFromRequest(r))
+	if errors.Is(err, domain.ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if errors.Is(err, domain.ErrInvalid) {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, api)
+}
+
+// RunContractTest calls every endpoint of an API definition on the service
+// at the requested base URL and reports, per operation, whether the
+// responses conform to the definition
+func (h *Handler) RunContractTest(w http.ResponseWriter, r *http.Request) {
+	var request domain.ContractTestRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	report, err := h.contractService.Run(r.Context(), chi.URLParam(r, "id"), &request)
+	if errors.Is(err, domain.ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, report)
+}
+
+// maxTrafficSize limits the recorded traffic uploaded in one request
+const maxTrafficSize = 50 << 20
+
+// ValidateTraffic checks the HAR document or JSON lines log in the request
+// body against an API definition. The format query parameter names the
+// format when it should not be detected.
+func (h *Handler) ValidateTraffic(w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxTrafficSize+1))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	if len(data) > maxTrafficSize {
+		respondWithError(w, http.StatusRequestEntityTooLarge, "Traffic too large")
+		return
+	}
+
+	report, err := h.trafficService.ValidateTraffic(r.Context(), chi.URLParam(r, "id"), data, r.URL.Query().Get("format"))
+	if errors.Is(err, domain.ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, report)
+}
+
+// maxMockBodySize limits the request bodies the mock server reads
+const maxMockBodySize = 10 << 20
+
+// Mock answers any request under /mock/{id} on behalf of the stored API
+// definition, with the path below it matched against the endpoint paths
+func (h *Handler) Mock(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	path, err := url.PathUnescape(chi.URLParam(r, "*"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid path")
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxMockBodySize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			respondWithError(w, http.StatusRequestEntityTooLarge, "Request body too large")
+			return
+		}
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	response, err := h.mockService.Serve(r.Context(), id, &domain.MockRequest{
+		Method:  r.Method,
+		Path:    "/" + path,
+		Query:   r.URL.Query(),
+		Headers: r.Header,
+		Body:    body,
+	})
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	for name, value := range response.Headers {
+		w.Header().Set(name, value)
+	}
+	w.WriteHeader(response.Status)
+	w.Write(response.Body)
+}
+
 // ImportSwagger imports a Swagger specification
 func (h *Handler) ImportSwagger(w http.ResponseWriter, r *http.Request) {
 	var request struct {
-		Content string `json:"content"`
+		Content string            `json:"content"`
+		Files   map[string]string `json:"files"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
 		return
 	}
 
-	api, err := h.apiService.ImportSwagger(r.Context(), request.Content)
+	api, err := h.apiService.ImportSwagger(r.Context(), request.Content, request.Files, changeFromRequest(r))
 	if err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
@@ -210,11 +566,14 @@ func (h *Handler) ExportSwagger(w http.ResponseWriter, r *http.Request) {
 		format = "yaml"
 	}
 
-	content, err := h.apiService.ExportSwagger(r.Context(), id, format)
+	version := r.URL.Query().Get("version")
+
+	content, warnings, err := h.apiService.ExportSwagger(r.Context(), id, format, version)
 	if err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+	setExportWarnings(w, warnings)
 
 	// Set appropriate content type
 	if format == "yaml" {
@@ -229,8 +588,97 @@ func (h *Handler) ExportSwagger(w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(content))
 }
 
+// ExportDefinition exports an API definition as Swagger (format yaml or
+// json) or as a GraphQL schema (format graphql)
+func (h *Handler) ExportDefinition(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("format") != "graphql" {
+		h.ExportSwagger(w, r)
+		return
+	}
+
+	schema, err := h.graphQLExport.ExportGraphQL(r.Context(), chi.URLParam(r, "id"))
+	if errors.Is(err, domain.ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/graphql; charset=utf-8")
+	w.Header().Set("Content-Disposition", "attachment; filename=schema.graphql")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(schema))
+}
+
+// GenerateGoCode generates a Go package for an API definition, named by the
+// package query parameter, as a zip archive or, with format=json, as a list
+// of files
+func (h *Handler) GenerateGoCode(w http.ResponseWriter, r *http.Request) {
+	code, err := h.codegenService.GenerateGo(r.Context(), chi.URLParam(r, "id"), r.URL.Query().Get("package"))
+	if errors.Is(err, domain.ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithCode(w, r, code)
+}
+
+// GenerateTypeScriptCode generates the TypeScript types and client of an API
+// definition, as a zip archive or, with format=json, as a list of files
+func (h *Handler) GenerateTypeScriptCode(w http.ResponseWriter, r *http.Request) {
+	code, err := h.codegenService.GenerateTypeScript(r.Context(), chi.URLParam(r, "id"))
+	if errors.Is(err, domain.ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithCode(w, r, code)
+}
+
 // Helper functions
 
+// changeFromRequest reads who makes a change from the X-Author header and
+// why from the message query parameter
+func changeFromRequest(r *http.Request) domain.Change {
+	return domain.Change{
+		Author:  r.Header.Get("X-Author"),
+		Message: r.URL.Query().Get("message"),
+	}
+}
+
+// seedFromRequest reads the seed query parameter, 0 when it is absent,
+// answering 400 when it is not a number
+func seedFromRequest(w http.ResponseWriter, r *http.Request) (uint64, bool) {
+	value := r.URL.Query().Get("seed")
+	if value == "" {
+		return 0, true
+	}
+	seed, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid seed")
+		return 0, false
+	}
+	return seed, true
+}
+
+// setExportWarnings adds an X-Export-Warning header per warning, as the body
+// of an export is the document itself
+func setExportWarnings(w http.ResponseWriter, warnings []domain.Warning) {
+	for _, warning := range warnings {
+		w.Header().Add("X-Export-Warning", warning.Path+": "+warning.Message)
+	}
+}
+
 func respondWithError(w http.ResponseWriter, code int, message string) {
 	respondWithJSON(w, code, map[string]string{"error": message})
 }
@@ -241,4 +689,27 @@ func respondWithJSON(w http.ResponseWriter, code int, payload interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(code)
 	w.Write(response)
-}
\ No newline at end of file
+}
+
+// respondWithCode writes generated code as JSON when format=json, and as a
+// zip archive with the files in a directory named after the code otherwise
+func respondWithCode(w http.ResponseWriter, r *http.Request, code *domain.GeneratedCode) {
+	if r.URL.Query().Get("format") == "json" {
+		respondWithJSON(w, http.StatusOK, code)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", "attachment; filename="+code.Name+".zip")
+	w.WriteHeader(http.StatusOK)
+
+	archive := zip.NewWriter(w)
+	for _, file := range code.Files {
+		entry, err := archive.Create(code.Name + "/" + file.Path)
+		if err != nil {
+			return
+		}
+		io.WriteString(entry, file.Content)
+	}
+	archive.Close()
+}
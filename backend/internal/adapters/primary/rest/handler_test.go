@@ -0,0 +1,140 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/swagger-editor/backend/internal/adapters/secondary/events"
+	"github.com/swagger-editor/backend/internal/adapters/secondary/repository"
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/lint"
+	"github.com/swagger-editor/backend/internal/core/services"
+)
+
+const petSpec = `
+openapi: 3.0.3
+info:
+  title: Pets
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      responses:
+        "200":
+          description: The pets
+`
+
+// newTestRouter serves the handler over an in-memory repository that holds
+// one imported definition, whose ID it returns
+func newTestRouter(t *testing.T) (http.Handler, string) {
+	t.Helper()
+	converter := &services.ConverterService{}
+	repo := repository.NewInMemoryAPIRepository()
+	apiService := services.NewAPIService(repo, converter, services.NewValidatorService(repo), events.NewInMemoryEventBus())
+	contractService, err := services.NewContractService(apiService, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewHandler(
+		apiService,
+		converter,
+		services.NewValidatorService(repo),
+		services.NewLinterService(lint.DefaultRuleset()),
+		services.NewDiffService(apiService, converter),
+		services.NewMockService(apiService),
+		services.NewExampleService(apiService),
+		contractService,
+		services.NewTrafficService(apiService),
+		services.NewGraphQLExportService(apiService),
+		services.NewCodegenService(apiService),
+	)
+
+	api, err := apiService.ImportSwagger(context.Background(), petSpec, nil, domain.Change{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := chi.NewRouter()
+	r.Route("/api/v1", func(r chi.Router) {
+		r.Get("/definitions/{id}/revisions/{revision}", h.GetRevision)
+		r.Post("/definitions/{id}/revisions/{revision}/restore", h.RestoreRevision)
+		r.Get("/definitions/{id}/compat", h.CheckCompatibility)
+		r.Post("/definitions/{id}/contract-test", h.RunContractTest)
+		r.Post("/definitions/{id}/traffic", h.ValidateTraffic)
+		r.Get("/definitions/{id}/export", h.ExportDefinition)
+		r.Get("/definitions/{id}/codegen/go", h.GenerateGoCode)
+		r.Get("/definitions/{id}/codegen/typescript", h.GenerateTypeScriptCode)
+	})
+	return r, api.ID
+}
+
+// TestHandlerStatusCodes checks the status each handler answers with when
+// what the request names does not exist
+func TestHandlerStatusCodes(t *testing.T) {
+	router, id := newTestRouter(t)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		want   int
+	}{
+		{"revision", http.MethodGet, "/api/v1/definitions/" + id + "/revisions/1", "", http.StatusOK},
+		{"missing revision", http.MethodGet, "/api/v1/definitions/" + id + "/revisions/99", "", http.StatusNotFound},
+		{"restore revision", http.MethodPost, "/api/v1/definitions/" + id + "/revisions/1/restore", "", http.StatusOK},
+		{"restore missing revision", http.MethodPost, "/api/v1/definitions/" + id + "/revisions/99/restore", "", http.StatusNotFound},
+		{"restore revision of missing definition", http.MethodPost, "/api/v1/definitions/missing/revisions/1/restore", "", http.StatusNotFound},
+		{"compat of missing definition", http.MethodGet, "/api/v1/definitions/missing/compat", "", http.StatusNotFound},
+		{"compat against missing revision", http.MethodGet, "/api/v1/definitions/" + id + "/compat?against=99", "", http.StatusNotFound},
+		{"restore invalid revision", http.MethodPost, "/api/v1/definitions/" + id + "/revisions/first/restore", "", http.StatusBadRequest},
+		{"contract test of missing definition", http.MethodPost, "/api/v1/definitions/missing/contract-test", `{"baseUrl": "http://203.0.113.10"}`, http.StatusNotFound},
+		{"contract test against private address", http.MethodPost, "/api/v1/definitions/" + id + "/contract-test", `{"baseUrl": "http://10.0.0.1"}`, http.StatusBadRequest},
+		{"traffic of missing definition", http.MethodPost, "/api/v1/definitions/missing/traffic", `{"method": "GET", "url": "https://pets.example.com/pets", "status": 200}`, http.StatusNotFound},
+		{"invalid traffic", http.MethodPost, "/api/v1/definitions/" + id + "/traffic", "not traffic", http.StatusBadRequest},
+		{"GraphQL export", http.MethodGet, "/api/v1/definitions/" + id + "/export?format=graphql", "", http.StatusOK},
+		{"GraphQL export of missing definition", http.MethodGet, "/api/v1/definitions/missing/export?format=graphql", "", http.StatusNotFound},
+		{"Go code", http.MethodGet, "/api/v1/definitions/" + id + "/codegen/go?format=json", "", http.StatusOK},
+		{"Go code of missing definition", http.MethodGet, "/api/v1/definitions/missing/codegen/go", "", http.StatusNotFound},
+		{"TypeScript code", http.MethodGet, "/api/v1/definitions/" + id + "/codegen/typescript?format=json", "", http.StatusOK},
+		{"TypeScript code of missing definition", http.MethodGet, "/api/v1/definitions/missing/codegen/typescript", "", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Errorf("%s %s: status %d, want %d: %s", tt.method, tt.path, rec.Code, tt.want, rec.Body)
+			}
+		})
+	}
+}
+
+// TestCheckCompatibilityOfFirstRevision reports no changes for a definition
+// that has a single revision, which a release gate lets pass
+func TestCheckCompatibilityOfFirstRevision(t *testing.T) {
+	router, id := newTestRouter(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/definitions/"+id+"/compat?enforce=true", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status %d, want 200: %s", rec.Code, rec.Body)
+	}
+	var report domain.CompatibilityReport
+	if err := json.Unmarshal(rec.Body.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	if report.Revision != 1 || report.Against != 0 || report.ReleaseBlocked || report.Changes == nil || len(report.Changes) != 0 {
+		t.Errorf("report = %+v, want revision 1 with no changes", report)
+	}
+}
@@ -0,0 +1,74 @@
+// Package events delivers changes to API definitions to the clients that
+// follow them, such as editors open on the same definition.
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// bufferSize is how many events a subscriber may fall behind before further
+// events are dropped for it
+const bufferSize = 64
+
+// InMemoryEventBus is an in-process implementation of EventBus. Publish
+// never blocks: a subscriber that does not keep up misses events rather
+// than holding up the save that published them.
+type InMemoryEventBus struct {
+	mu          sync.RWMutex
+	subscribers map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	definitionID string
+	events       chan domain.DefinitionEvent
+}
+
+// NewInMemoryEventBus creates a new in-memory event bus
+func NewInMemoryEventBus() *InMemoryEventBus {
+	return &InMemoryEventBus{
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// Publish sends an event to the subscribers of its definition and to those
+// of all definitions
+func (b *InMemoryEventBus) Publish(ctx context.Context, event domain.DefinitionEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for s := range b.subscribers {
+		if s.definitionID != "" && s.definitionID != event.DefinitionID {
+			continue
+		}
+		select {
+		case s.events <- event:
+		default:
+		}
+	}
+}
+
+// Subscribe returns the events of one definition, or of all definitions
+// when definitionID is empty, until ctx is done
+func (b *InMemoryEventBus) Subscribe(ctx context.Context, definitionID string) <-chan domain.DefinitionEvent {
+	s := &subscriber{
+		definitionID: definitionID,
+		events:       make(chan domain.DefinitionEvent, bufferSize),
+	}
+
+	b.mu.Lock()
+	b.subscribers[s] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subscribers, s)
+		b.mu.Unlock()
+		close(s.events)
+	}()
+
+	return s.events
+}
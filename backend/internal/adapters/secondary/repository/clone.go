@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"reflect"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// cloneDefinition returns a copy of api that shares no slices, maps or
+// pointers with it, including the raw values held in interface{} fields
+func cloneDefinition(api *domain.APIDefinition) *domain.APIDefinition {
+	return deepCopy(reflect.ValueOf(api)).Interface().(*domain.APIDefinition)
+}
+
+func deepCopy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.New(v.Elem().Type())
+		copied.Elem().Set(deepCopy(v.Elem()))
+		return copied
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.New(v.Type()).Elem()
+		copied.Set(deepCopy(v.Elem()))
+		return copied
+
+	case reflect.Struct:
+		// Copying the whole struct first keeps unexported fields, such as
+		// those of time.Time, which are values or immutable
+		copied := reflect.New(v.Type()).Elem()
+		copied.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if copied.Field(i).CanSet() {
+				copied.Field(i).Set(deepCopy(v.Field(i)))
+			}
+		}
+		return copied
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			copied.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return copied
+
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			copied.SetMapIndex(iter.Key(), deepCopy(iter.Value()))
+		}
+		return copied
+
+	default:
+		return v
+	}
+}
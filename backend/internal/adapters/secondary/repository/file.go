@@ -0,0 +1,487 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+const (
+	definitionExt = ".json"
+	lockFileName  = ".lock"
+	tempPrefix    = ".tmp-"
+	revisionsDir  = "revisions"
+)
+
+// FileAPIRepository stores each API definition as a JSON file in a
+// directory. Files are replaced atomically, so a crash leaves either the old
+// or the new version on disk, and the directory is locked while it is open
+// so no other process can write to it.
+//
+// Revisions are kept as one file each under revisions/<id>/. A revision is
+// written before the definition it records, so when a crash falls between
+// the two writes the store rolls forward to that revision on the next open.
+type FileAPIRepository struct {
+	mu   sync.RWMutex
+	dir  string
+	lock *fileLock
+
+	// apis caches the encoded definitions; the lock guarantees the files do
+	// not change behind our back
+	apis map[string][]byte
+	// lastRevision holds the highest revision number of each definition
+	lastRevision map[string]int
+}
+
+// NewFileAPIRepository opens the store in dir, creating the directory if
+// needed. It fails when another process holds the store.
+func NewFileAPIRepository(dir string) (*FileAPIRepository, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	lock, err := acquireLock(filepath.Join(dir, lockFileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock data directory %s: %w", dir, err)
+	}
+
+	r := &FileAPIRepository{
+		dir:          dir,
+		lock:         lock,
+		apis:         make(map[string][]byte),
+		lastRevision: make(map[string]int),
+	}
+	if err := r.load(); err != nil {
+		lock.release()
+		return nil, err
+	}
+	return r, nil
+}
+
+// load reads every definition into the cache and removes temporary files
+// left by writes that were interrupted
+func (r *FileAPIRepository) load() error {
+	entries, err := os.ReadDir(r.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read data directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() {
+			continue
+		}
+		if strings.HasPrefix(name, tempPrefix) {
+			os.Remove(filepath.Join(r.dir, name))
+			continue
+		}
+		if !strings.HasSuffix(name, definitionExt) {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(r.dir, name))
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		var api domain.APIDefinition
+		if err := json.Unmarshal(data, &api); err != nil {
+			return fmt.Errorf("failed to decode %s: %w", name, err)
+		}
+		r.apis[api.ID] = data
+	}
+	return r.loadRevisions()
+}
+
+// loadRevisions finds the latest revision of every definition and rolls
+// forward any definition whose file is missing or older than that revision
+func (r *FileAPIRepository) loadRevisions() error {
+	entries, err := os.ReadDir(filepath.Join(r.dir, revisionsDir))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read revisions directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		id, err := url.PathUnescape(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		numbers, err := r.revisionNumbers(id)
+		if err != nil {
+			return err
+		}
+		if len(numbers) == 0 {
+			continue
+		}
+		last := numbers[len(numbers)-1]
+		r.lastRevision[id] = last
+
+		current, err := r.current(id)
+		if err != nil {
+			return err
+		}
+		// Definitions saved without a revision are left as they are
+		if current != nil && (current.Revision == 0 || current.Revision >= last) {
+			continue
+		}
+
+		revision, err := r.readRevision(id, last)
+		if err != nil {
+			return err
+		}
+		if err := r.write(revision.Definition); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close releases the lock on the store
+func (r *FileAPIRepository) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.lock == nil {
+		return nil
+	}
+	err := r.lock.release()
+	r.lock = nil
+	return err
+}
+
+// Save stores an API definition
+func (r *FileAPIRepository) Save(ctx context.Context, api *domain.APIDefinition) error {
+	if api == nil {
+		return errors.New("api definition cannot be nil")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.write(api)
+}
+
+// FindByID retrieves an API definition by ID
+func (r *FileAPIRepository) FindByID(ctx context.Context, id string) (*domain.APIDefinition, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	data, exists := r.apis[id]
+	if !exists {
+		return nil, nil
+	}
+	return decodeDefinition(data)
+}
+
+// FindAll retrieves all API definitions
+func (r *FileAPIRepository) FindAll(ctx context.Context) ([]*domain.APIDefinition, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	apis := make([]*domain.APIDefinition, 0, len(r.apis))
+	for _, data := range r.apis {
+		api, err := decodeDefinition(data)
+		if err != nil {
+			return nil, err
+		}
+		apis = append(apis, api)
+	}
+
+	return apis, nil
+}
+
+// Update updates an existing API definition
+func (r *FileAPIRepository) Update(ctx context.Context, api *domain.APIDefinition) error {
+	if api == nil {
+		return errors.New("api definition cannot be nil")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.apis[api.ID]; !exists {
+		return errors.New("api definition not found")
+	}
+
+	return r.write(api)
+}
+
+// Delete removes an API definition
+func (r *FileAPIRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.apis[id]; !exists {
+		return errors.New("api definition not found")
+	}
+
+	path, err := r.path(id)
+	if err != nil {
+		return err
+	}
+	// History goes first: a definition left without it is still usable,
+	// while history left without its definition would be rolled forward
+	if err := os.RemoveAll(r.revisionDir(id)); err != nil {
+		return fmt.Errorf("failed to delete revisions: %w", err)
+	}
+	delete(r.lastRevision, id)
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete api definition: %w", err)
+	}
+	if err := syncDir(r.dir); err != nil {
+		return err
+	}
+
+	delete(r.apis, id)
+	return nil
+}
+
+// ExistsByID checks if an API definition exists
+func (r *FileAPIRepository) ExistsByID(ctx context.Context, id string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, exists := r.apis[id]
+	return exists, nil
+}
+
+// SaveRevision stores an API definition and records it as a new revision
+func (r *FileAPIRepository) SaveRevision(ctx context.Context, api *domain.APIDefinition, revision *domain.Revision) error {
+	if api == nil || revision == nil {
+		return errors.New("api definition and revision cannot be nil")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.lock == nil {
+		return errors.New("repository is closed")
+	}
+	if _, err := r.path(api.ID); err != nil {
+		return err
+	}
+
+	number := r.lastRevision[api.ID] + 1
+	snapshot := *api
+	snapshot.Revision = number
+
+	stored := *revision
+	stored.DefinitionID = api.ID
+	stored.Number = number
+	stored.Definition = &snapshot
+
+	data, err := json.MarshalIndent(&stored, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode revision: %w", err)
+	}
+	dir := r.revisionDir(api.ID)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create revisions directory: %w", err)
+	}
+	if err := writeFileAtomic(dir, filepath.Join(dir, strconv.Itoa(number)+definitionExt), data); err != nil {
+		return fmt.Errorf("failed to write revision: %w", err)
+	}
+	r.lastRevision[api.ID] = number
+
+	if err := r.write(&snapshot); err != nil {
+		return err
+	}
+
+	api.Revision = number
+	revision.DefinitionID = api.ID
+	revision.Number = number
+	return nil
+}
+
+// ListRevisions lists the revisions of an API definition, oldest first
+func (r *FileAPIRepository) ListRevisions(ctx context.Context, id string) ([]*domain.Revision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	numbers, err := r.revisionNumbers(id)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*domain.Revision, 0, len(numbers))
+	for _, number := range numbers {
+		revision, err := r.readRevision(id, number)
+		if err != nil {
+			return nil, err
+		}
+		revision.Definition = nil
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
+// FindRevision retrieves a revision of an API definition with its snapshot
+func (r *FileAPIRepository) FindRevision(ctx context.Context, id string, number int) (*domain.Revision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if number < 1 || number > r.lastRevision[id] {
+		return nil, nil
+	}
+	revision, err := r.readRevision(id, number)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	return revision, err
+}
+
+// current decodes the cached definition, or returns nil when there is none
+func (r *FileAPIRepository) current(id string) (*domain.APIDefinition, error) {
+	data, exists := r.apis[id]
+	if !exists {
+		return nil, nil
+	}
+	return decodeDefinition(data)
+}
+
+func (r *FileAPIRepository) revisionDir(id string) string {
+	return filepath.Join(r.dir, revisionsDir, url.PathEscape(id))
+}
+
+// revisionNumbers lists the revision numbers on disk in ascending order,
+// removing temporary files of interrupted writes on the way
+func (r *FileAPIRepository) revisionNumbers(id string) ([]int, error) {
+	dir := r.revisionDir(id)
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read revisions: %w", err)
+	}
+
+	var numbers []int
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, tempPrefix) {
+			os.Remove(filepath.Join(dir, name))
+			continue
+		}
+		number, err := strconv.Atoi(strings.TrimSuffix(name, definitionExt))
+		if err != nil || !strings.HasSuffix(name, definitionExt) {
+			continue
+		}
+		numbers = append(numbers, number)
+	}
+	sort.Ints(numbers)
+	return numbers, nil
+}
+
+func (r *FileAPIRepository) readRevision(id string, number int) (*domain.Revision, error) {
+	data, err := os.ReadFile(filepath.Join(r.revisionDir(id), strconv.Itoa(number)+definitionExt))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read revision %d: %w", number, err)
+	}
+	var revision domain.Revision
+	if err := json.Unmarshal(data, &revision); err != nil {
+		return nil, fmt.Errorf("failed to decode revision %d: %w", number, err)
+	}
+	return &revision, nil
+}
+
+// write encodes a definition and atomically replaces its file. The caller
+// holds the write lock.
+func (r *FileAPIRepository) write(api *domain.APIDefinition) error {
+	if r.lock == nil {
+		return errors.New("repository is closed")
+	}
+
+	path, err := r.path(api.ID)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(api, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode api definition: %w", err)
+	}
+
+	if err := writeFileAtomic(r.dir, path, data); err != nil {
+		return fmt.Errorf("failed to write api definition: %w", err)
+	}
+
+	r.apis[api.ID] = data
+	return nil
+}
+
+// path returns the file of a definition. IDs are escaped so that any ID
+// maps to a file directly inside the store.
+func (r *FileAPIRepository) path(id string) (string, error) {
+	name := url.PathEscape(id)
+	if id == "" || strings.HasPrefix(name, ".") {
+		return "", fmt.Errorf("invalid api definition id %q", id)
+	}
+	return filepath.Join(r.dir, name+definitionExt), nil
+}
+
+// writeFileAtomic writes data to a temporary file in dir, flushes it to disk
+// and renames it over path, then flushes the directory entry
+func writeFileAtomic(dir, path string, data []byte) error {
+	tmp, err := os.CreateTemp(dir, tempPrefix+"*")
+	if err != nil {
+		return err
+	}
+	tmpName := tmp.Name()
+	defer os.Remove(tmpName)
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmpName, path); err != nil {
+		return err
+	}
+	return syncDir(dir)
+}
+
+// syncDir flushes the entries of a directory so renames and removals
+// survive a crash. Platforms that cannot sync a directory are ignored.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	if err := d.Sync(); err != nil && !errors.Is(err, os.ErrInvalid) && !errors.Is(err, os.ErrPermission) {
+		return fmt.Errorf("failed to sync data directory: %w", err)
+	}
+	return nil
+}
+
+func decodeDefinition(data []byte) (*domain.APIDefinition, error) {
+	var api domain.APIDefinition
+	if err := json.Unmarshal(data, &api); err != nil {
+		return nil, fmt.Errorf("failed to decode api definition: %w", err)
+	}
+	return &api, nil
+}
@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+func newTestFileRepository(t *testing.T, dir string) *FileAPIRepository {
+	t.Helper()
+	repo, err := NewFileAPIRepository(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { repo.Close() })
+	return repo
+}
+
+// tempFiles lists the temporary files left in dir
+func tempFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), tempPrefix) {
+			names = append(names, entry.Name())
+		}
+	}
+	return names
+}
+
+// TestFileRepositoryReplacesFilesAtomically saves a definition twice and
+// finds a complete file each time, with no temporary file left over
+func TestFileRepositoryReplacesFilesAtomically(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	repo := newTestFileRepository(t, dir)
+
+	api := newTestDefinition("pets")
+	for _, name := range []string{"Pets", "Pet Store"} {
+		api.Metadata.Name = name
+		if err := repo.Save(ctx, api); err != nil {
+			t.Fatal(err)
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, "pets"+definitionExt))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var saved domain.APIDefinition
+		if err := json.Unmarshal(data, &saved); err != nil {
+			t.Fatalf("file after saving %s is not a complete definition: %v", name, err)
+		}
+		if saved.Metadata.Name != name {
+			t.Errorf("file holds %s, want %s", saved.Metadata.Name, name)
+		}
+		if temps := tempFiles(t, dir); len(temps) != 0 {
+			t.Errorf("temporary files left after saving %s: %v", name, temps)
+		}
+	}
+}
+
+// TestWriteFileAtomicLeavesNothingOnFailure fails the final rename and finds
+// neither a temporary file nor a change to the target
+func TestWriteFileAtomicLeavesNothingOnFailure(t *testing.T) {
+	dir := t.TempDir()
+	// A non-empty directory cannot be replaced by a file
+	target := filepath.Join(dir, "pets"+definitionExt)
+	if err := os.MkdirAll(filepath.Join(target, "keep"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := writeFileAtomic(dir, target, []byte(`{"id": "pets"}`)); err == nil {
+		t.Fatal("write over a directory succeeded, want an error")
+	}
+	if temps := tempFiles(t, dir); len(temps) != 0 {
+		t.Errorf("temporary files left after the failed write: %v", temps)
+	}
+	if info, err := os.Stat(filepath.Join(target, "keep")); err != nil || !info.IsDir() {
+		t.Errorf("target was changed by the failed write: %v", err)
+	}
+}
+
+// TestFileRepositoryRemovesTempFilesOnOpen leaves the temporary files of
+// interrupted writes in the store and its revisions, and finds them gone
+// after the next open with the complete files untouched
+func TestFileRepositoryRemovesTempFilesOnOpen(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	repo := newTestFileRepository(t, dir)
+	if err := repo.SaveRevision(ctx, newTestDefinition("pets"), &domain.Revision{Author: "ann"}); err != nil {
+		t.Fatal(err)
+	}
+	repo.Close()
+
+	revisions := repo.revisionDir("pets")
+	for _, path := range []string{
+		filepath.Join(dir, tempPrefix+"1234"),
+		filepath.Join(revisions, tempPrefix+"5678"),
+	} {
+		if err := os.WriteFile(path, []byte(`{"id": "pe`), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	repo = newTestFileRepository(t, dir)
+	for _, dir := range []string{dir, revisions} {
+		if temps := tempFiles(t, dir); len(temps) != 0 {
+			t.Errorf("temporary files left in %s: %v", dir, temps)
+		}
+	}
+	all, err := repo.FindAll(ctx)
+	if err != nil || len(all) != 1 {
+		t.Fatalf("definitions = %v, %v, want pets alone", all, err)
+	}
+	if list, err := repo.ListRevisions(ctx, "pets"); err != nil || len(list) != 1 {
+		t.Errorf("revisions = %v, %v, want the one saved", list, err)
+	}
+}
+
+// TestFileRepositoryRollsForwardFromRevisions reopens a store whose last
+// revision was written without the definition it records, as a crash
+// between the two writes leaves it, and finds the definition at that
+// revision
+func TestFileRepositoryRollsForwardFromRevisions(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	repo := newTestFileRepository(t, dir)
+
+	api := newTestDefinition("pets")
+	if err := repo.SaveRevision(ctx, api, &domain.Revision{Author: "ann"}); err != nil {
+		t.Fatal(err)
+	}
+	first, err := os.ReadFile(filepath.Join(dir, "pets"+definitionExt))
+	if err != nil {
+		t.Fatal(err)
+	}
+	api.Metadata.Name = "Pet Store"
+	if err := repo.SaveRevision(ctx, api, &domain.Revision{Author: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+	repo.Close()
+
+	// Put back the definition as it was before the second revision
+	if err := os.WriteFile(filepath.Join(dir, "pets"+definitionExt), first, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	repo = newTestFileRepository(t, dir)
+	loaded, err := repo.FindByID(ctx, "pets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Metadata.Name != "Pet Store" || loaded.Revision != 2 {
+		t.Errorf("definition = %s at revision %d, want Pet Store at revision 2", loaded.Metadata.Name, loaded.Revision)
+	}
+
+	// The roll forward is written back, and numbering carries on
+	repo.Close()
+	repo = newTestFileRepository(t, dir)
+	third := &domain.Revision{Author: "cy"}
+	if err := repo.SaveRevision(ctx, loaded, third); err != nil {
+		t.Fatal(err)
+	}
+	if third.Number != 3 {
+		t.Errorf("next revision is numbered %d, want 3", third.Number)
+	}
+}
+
+// TestFileRepositoryRollsForwardMissingDefinition reopens a store that has
+// revisions of a definition whose file was never written
+func TestFileRepositoryRollsForwardMissingDefinition(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	repo := newTestFileRepository(t, dir)
+	if err := repo.SaveRevision(ctx, newTestDefinition("pets"), &domain.Revision{Author: "ann"}); err != nil {
+		t.Fatal(err)
+	}
+	repo.Close()
+	if err := os.Remove(filepath.Join(dir, "pets"+definitionExt)); err != nil {
+		t.Fatal(err)
+	}
+
+	repo = newTestFileRepository(t, dir)
+	if loaded, err := repo.FindByID(ctx, "pets"); err != nil || loaded == nil || loaded.Revision != 1 {
+		t.Errorf("definition = %+v, %v, want pets at revision 1", loaded, err)
+	}
+}
+
+// TestFileRepositoryLocksDirectory opens the same directory twice and gets
+// an error until the first store is closed
+func TestFileRepositoryLocksDirectory(t *testing.T) {
+	dir := t.TempDir()
+	repo := newTestFileRepository(t, dir)
+
+	if second, err := NewFileAPIRepository(dir); err == nil {
+		second.Close()
+		t.Fatal("second open of a locked directory succeeded, want an error")
+	} else if !strings.Contains(err.Error(), "in use") {
+		t.Errorf("second open: %v, want the store reported in use", err)
+	}
+
+	if err := repo.Close(); err != nil {
+		t.Fatal(err)
+	}
+	newTestFileRepository(t, dir)
+}
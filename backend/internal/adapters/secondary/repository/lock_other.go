@@ -0,0 +1,33 @@
+//go:build !unix
+
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+// fileLock marks the store as open by creating the lock file exclusively.
+// Unlike the advisory lock used on Unix, the file outlives a crash and has
+// to be removed by hand before the store can be opened again.
+type fileLock struct {
+	path string
+}
+
+func acquireLock(path string) (*fileLock, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("the store is in use by another process (remove %s if it is not)", path)
+		}
+		return nil, err
+	}
+	fmt.Fprintf(file, "%d\n", os.Getpid())
+	file.Close()
+	return &fileLock{path: path}, nil
+}
+
+func (l *fileLock) release() error {
+	return os.Remove(l.path)
+}
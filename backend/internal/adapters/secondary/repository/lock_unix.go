@@ -0,0 +1,38 @@
+//go:build unix
+
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// fileLock is an advisory lock held on an open file for as long as the
+// store is open. The kernel drops it when the process exits, so a crash
+// never leaves the store locked.
+type fileLock struct {
+	file *os.File
+}
+
+func acquireLock(path string) (*fileLock, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		file.Close()
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return nil, fmt.Errorf("the store is in use by another process")
+		}
+		return nil, err
+	}
+	return &fileLock{file: file}, nil
+}
+
+func (l *fileLock) release() error {
+	syscall.Flock(int(l.file.Fd()), syscall.LOCK_UN)
+	return l.file.Close()
+}
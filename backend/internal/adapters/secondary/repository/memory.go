@@ -8,17 +8,21 @@ import (
 	"github.com/swagger-editor/backend/internal/core/domain"
 )
 
-// InMemoryAPIRepository is an in-memory implementation of APIRepository
+// InMemoryAPIRepository is an in-memory implementation of APIRepository.
+// Definitions are deep-copied on the way in and out, so callers never share
+// state with the store or with each other.
 type InMemoryAPIRepository struct {
-	mu     sync.RWMutex
-	apis   map[string]*domain.APIDefinition
-	nextID int
+	mu        sync.RWMutex
+	apis      map[string]*domain.APIDefinition
+	revisions map[string][]*domain.Revision
+	nextID    int
 }
 
 // NewInMemoryAPIRepository creates a new in-memory API repository
 func NewInMemoryAPIRepository() *InMemoryAPIRepository {
 	return &InMemoryAPIRepository{
-		apis: make(map[string]*domain.APIDefinition),
+		apis:      make(map[string]*domain.APIDefinition),
+		revisions: make(map[string][]*domain.Revision),
 	}
 }
 
@@ -31,7 +35,7 @@ func (r *InMemoryAPIRepository) Save(ctx context.Context, api *domain.APIDefinit
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	r.apis[api.ID] = api
+	r.apis[api.ID] = cloneDefinition(api)
 	return nil
 }
 
@@ -46,8 +50,7 @@ func (r *InMemoryAPIRepository) FindByID(ctx context.Context, id string) (*domai
 	}
 
 	// Return a copy to prevent external modifications
-	apiCopy := *api
-	return &apiCopy, nil
+	return cloneDefinition(api), nil
 }
 
 // FindAll retrieves all API definitions
@@ -57,8 +60,7 @@ func (r *InMemoryAPIRepository) FindAll(ctx context.Context) ([]*domain.APIDefin
 
 	apis := make([]*domain.APIDefinition, 0, len(r.apis))
 	for _, api := range r.apis {
-		apiCopy := *api
-		apis = append(apis, &apiCopy)
+		apis = append(apis, cloneDefinition(api))
 	}
 
 	return apis, nil
@@ -77,7 +79,7 @@ func (r *InMemoryAPIRepository) Update(ctx context.Context, api *domain.APIDefin
 		return errors.New("api definition not found")
 	}
 
-	r.apis[api.ID] = api
+	r.apis[api.ID] = cloneDefinition(api)
 	return nil
 }
 
@@ -91,6 +93,7 @@ func (r *InMemoryAPIRepository) Delete(ctx context.Context, id string) error {
 	}
 
 	delete(r.apis, id)
+	delete(r.revisions, id)
 	return nil
 }
 
@@ -101,4 +104,54 @@ func (r *InMemoryAPIRepository) ExistsByID(ctx context.Context, id string) (bool
 
 	_, exists := r.apis[id]
 	return exists, nil
-}
\ No newline at end of file
+}
+
+// SaveRevision stores an API definition and records it as a new revision
+func (r *InMemoryAPIRepository) SaveRevision(ctx context.Context, api *domain.APIDefinition, revision *domain.Revision) error {
+	if api == nil || revision == nil {
+		return errors.New("api definition and revision cannot be nil")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	api.Revision = len(r.revisions[api.ID]) + 1
+	revision.DefinitionID = api.ID
+	revision.Number = api.Revision
+
+	stored := *revision
+	stored.Definition = cloneDefinition(api)
+	r.revisions[api.ID] = append(r.revisions[api.ID], &stored)
+	r.apis[api.ID] = cloneDefinition(api)
+	return nil
+}
+
+// ListRevisions lists the revisions of an API definition, oldest first
+func (r *InMemoryAPIRepository) ListRevisions(ctx context.Context, id string) ([]*domain.Revision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	revisions := make([]*domain.Revision, 0, len(r.revisions[id]))
+	for _, revision := range r.revisions[id] {
+		summary := *revision
+		summary.Definition = nil
+		revisions = append(revisions, &summary)
+	}
+
+	return revisions, nil
+}
+
+// FindRevision retrieves a revision of an API definition with its snapshot
+func (r *InMemoryAPIRepository) FindRevision(ctx context.Context, id string, number int) (*domain.Revision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	revisions := r.revisions[id]
+	if number < 1 || number > len(revisions) {
+		return nil, nil
+	}
+
+	revision := *revisions[number-1]
+	revision.Definition = cloneDefinition(revision.Definition)
+	return &revision, nil
+}
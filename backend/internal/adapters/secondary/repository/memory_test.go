@@ -0,0 +1,250 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// newTestDefinition builds a definition with something shared-looking at
+// every level: slices of structs, maps of raw schema objects and pointers
+func newTestDefinition(id string) *domain.APIDefinition {
+	minLength := 1
+	return &domain.APIDefinition{
+		ID: id,
+		Metadata: domain.APIMetadata{
+			Name:    "Pets",
+			Version: "1.0.0",
+			Servers: []domain.Server{{URL: "https://api.example.com/v1"}},
+			Contact: &domain.Contact{Name: "Team"},
+			Tags:    []string{"pets"},
+		},
+		Endpoints: []domain.Endpoint{{
+			ID:         "endpoint-listpets",
+			Path:       "/pets",
+			Method:     "GET",
+			Tags:       []string{"pets"},
+			Parameters: []string{"param-limit"},
+			Responses:  map[string]string{"200": "response-listpets-200"},
+			Security:   []domain.SecurityRequirement{{"apiKey": {}}},
+			Extensions: map[string]interface{}{"x-rate-limit": map[string]interface{}{"rps": 10}},
+		}},
+		PathItems: []domain.PathItem{{
+			Path:       "/pets",
+			Summary:    "Pets",
+			Servers:    []domain.Server{{URL: "https://pets.example.com"}},
+			Extensions: map[string]interface{}{"x-owner": "pets-team"},
+		}},
+		Schemas: []domain.Schema{{
+			ID:       "schema-pet",
+			Name:     "Pet",
+			Type:     "object",
+			Required: []string{"name"},
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{"type": "string", "minLength": 1},
+				"tags": map[string]interface{}{
+					"type":  "array",
+					"items": map[string]interface{}{"type": "string"},
+				},
+			},
+			Enum:      []interface{}{map[string]interface{}{"name": "Rex"}},
+			MinLength: &minLength,
+		}},
+		Parameters: []domain.Parameter{{
+			ID:     "param-limit",
+			Name:   "limit",
+			In:     "query",
+			Schema: map[string]interface{}{"type": "integer"},
+		}},
+		Responses: []domain.Response{{
+			ID:          "response-listpets-200",
+			Description: "ok",
+			Content: map[string]domain.MediaType{
+				"application/json": {Schema: map[string]interface{}{
+					"type":  "array",
+					"items": map[string]interface{}{"$ref": "schema-pet"},
+				}},
+			},
+		}},
+		Components: map[string]interface{}{
+			"headers": map[string]interface{}{"Rate": map[string]interface{}{"schema": map[string]interface{}{"type": "integer"}}},
+		},
+		CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		UpdatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+}
+
+// mutate changes a definition at every level a shallow copy would share
+func mutate(api *domain.APIDefinition, marker string) {
+	api.Metadata.Name = marker
+	api.Metadata.Servers[0].URL = marker
+	api.Metadata.Contact.Name = marker
+	api.Metadata.Tags[0] = marker
+
+	endpoint := &api.Endpoints[0]
+	endpoint.Path = marker
+	endpoint.Tags[0] = marker
+	endpoint.Parameters[0] = marker
+	endpoint.Responses["200"] = marker
+	endpoint.Security[0]["apiKey"] = []string{marker}
+	endpoint.Extensions["x-rate-limit"].(map[string]interface{})["rps"] = marker
+
+	schema := &api.Schemas[0]
+	schema.Required[0] = marker
+	schema.Properties["name"].(map[string]interface{})["type"] = marker
+	schema.Properties["tags"].(map[string]interface{})["items"].(map[string]interface{})["type"] = marker
+	schema.Properties[marker] = map[string]interface{}{}
+	schema.Enum[0].(map[string]interface{})["name"] = marker
+	*schema.MinLength = 99
+
+	api.Parameters[0].Schema.(map[string]interface{})["type"] = marker
+	media := api.Responses[0].Content["application/json"]
+	media.Schema.(map[string]interface{})["items"].(map[string]interface{})["$ref"] = marker
+	api.Components["headers"].(map[string]interface{})["Rate"] = marker
+}
+
+func TestInMemoryRepositoryIsolatesReads(t *testing.T) {
+	ctx := context.Background()
+	repo := NewInMemoryAPIRepository()
+	if err := repo.Save(ctx, newTestDefinition("pets")); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := repo.FindByID(ctx, "pets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mutate(found, "changed by FindByID caller")
+
+	all, err := repo.FindAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mutate(all[0], "changed by FindAll caller")
+
+	stored, err := repo.FindByID(ctx, "pets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(stored, newTestDefinition("pets")) {
+		t.Errorf("stored definition changed through a returned copy:\n%s", toJSON(t, stored))
+	}
+}
+
+func TestInMemoryRepositoryIsolatesWrites(t *testing.T) {
+	ctx := context.Background()
+	repo := NewInMemoryAPIRepository()
+
+	saved := newTestDefinition("pets")
+	if err := repo.Save(ctx, saved); err != nil {
+		t.Fatal(err)
+	}
+	mutate(saved, "changed after Save")
+
+	updated := newTestDefinition("pets")
+	updated.Metadata.Version = "2.0.0"
+	if err := repo.Update(ctx, updated); err != nil {
+		t.Fatal(err)
+	}
+	mutate(updated, "changed after Update")
+
+	stored, err := repo.FindByID(ctx, "pets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := newTestDefinition("pets")
+	expected.Metadata.Version = "2.0.0"
+	if !reflect.DeepEqual(stored, expected) {
+		t.Errorf("stored definition changed through the caller's value:\n%s", toJSON(t, stored))
+	}
+}
+
+// TestInMemoryRepositoryConcurrentAccess has readers walk every value of
+// the definitions while writers mutate their own copies and store them.
+// Run it with -race: any state shared with the store shows up as a race.
+func TestInMemoryRepositoryConcurrentAccess(t *testing.T) {
+	ctx := context.Background()
+	repo := NewInMemoryAPIRepository()
+	for i := 0; i < 4; i++ {
+		if err := repo.Save(ctx, newTestDefinition(fmt.Sprintf("api-%d", i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	const workers, rounds = 8, 50
+	var wg sync.WaitGroup
+	errs := make(chan error, 2*workers*rounds)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(2)
+
+		go func(w int) {
+			defer wg.Done()
+			id := fmt.Sprintf("api-%d", w%4)
+			for i := 0; i < rounds; i++ {
+				api, err := repo.FindByID(ctx, id)
+				if err != nil || api == nil {
+					errs <- fmt.Errorf("FindByID(%s): %v", id, err)
+					return
+				}
+				mutate(api, fmt.Sprintf("writer %d round %d", w, i))
+				if err := repo.Update(ctx, api); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}(w)
+
+		go func() {
+			defer wg.Done()
+			for i := 0; i < rounds; i++ {
+				apis, err := repo.FindAll(ctx)
+				if err != nil {
+					errs <- err
+					return
+				}
+				// Encoding reads every field, map entry and slice element
+				if _, err := json.Marshal(apis); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestCloneDefinitionKeepsNilAndEmptyApart(t *testing.T) {
+	api := &domain.APIDefinition{
+		ID:        "empty",
+		Endpoints: []domain.Endpoint{{ID: "e", Security: []domain.SecurityRequirement{}}, {ID: "f"}},
+	}
+
+	clone := cloneDefinition(api)
+	if !reflect.DeepEqual(clone, api) {
+		t.Fatalf("clone differs from original:\n%s\n%s", toJSON(t, clone), toJSON(t, api))
+	}
+	if clone.Endpoints[0].Security == nil || clone.Endpoints[1].Security != nil {
+		t.Error("clone does not keep an empty security list apart from a missing one")
+	}
+}
+
+func toJSON(t *testing.T, value interface{}) string {
+	t.Helper()
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
@@ -0,0 +1,148 @@
+-- Definitions and their normalized parts. Every part keeps its full JSON in
+-- data, so definitions read back exactly as they were saved; the other
+-- columns exist to be queried. Positions preserve the order of each list.
+
+CREATE TABLE definitions (
+    id           TEXT PRIMARY KEY,
+    name         TEXT NOT NULL,
+    version      TEXT NOT NULL,
+    spec_version TEXT NOT NULL,
+    base_url     TEXT NOT NULL,
+    metadata     TEXT NOT NULL,
+    security     TEXT,
+    tags         TEXT,
+    components   TEXT,
+    extensions   TEXT,
+    created_at   TEXT NOT NULL,
+    updated_at   TEXT NOT NULL
+);
+
+CREATE TABLE endpoints (
+    definition_id   TEXT    NOT NULL REFERENCES definitions (id) ON DELETE CASCADE,
+    webhook         INTEGER NOT NULL,
+    position        INTEGER NOT NULL,
+    id              TEXT    NOT NULL,
+    path            TEXT    NOT NULL,
+    method          TEXT    NOT NULL,
+    operation_id    TEXT    NOT NULL,
+    summary         TEXT    NOT NULL,
+    deprecated      INTEGER NOT NULL,
+    request_body_id TEXT    NOT NULL,
+    data            TEXT    NOT NULL,
+    PRIMARY KEY (definition_id, webhook, position)
+);
+CREATE INDEX endpoints_id ON endpoints (definition_id, id);
+CREATE INDEX endpoints_path ON endpoints (path, method);
+
+CREATE TABLE schemas (
+    definition_id TEXT    NOT NULL REFERENCES definitions (id) ON DELETE CASCADE,
+    position      INTEGER NOT NULL,
+    id            TEXT    NOT NULL,
+    name          TEXT    NOT NULL,
+    type          TEXT    NOT NULL,
+    data          TEXT    NOT NULL,
+    PRIMARY KEY (definition_id, position)
+);
+CREATE INDEX schemas_id ON schemas (definition_id, id);
+CREATE INDEX schemas_name ON schemas (name);
+
+CREATE TABLE parameters (
+    definition_id TEXT    NOT NULL REFERENCES definitions (id) ON DELETE CASCADE,
+    position      INTEGER NOT NULL,
+    id            TEXT    NOT NULL,
+    component     TEXT    NOT NULL,
+    name          TEXT    NOT NULL,
+    location      TEXT    NOT NULL,
+    required      INTEGER NOT NULL,
+    data          TEXT    NOT NULL,
+    PRIMARY KEY (definition_id, position)
+);
+CREATE INDEX parameters_id ON parameters (definition_id, id);
+
+CREATE TABLE responses (
+    definition_id TEXT    NOT NULL REFERENCES definitions (id) ON DELETE CASCADE,
+    position      INTEGER NOT NULL,
+    id            TEXT    NOT NULL,
+    component     TEXT    NOT NULL,
+    description   TEXT    NOT NULL,
+    data          TEXT    NOT NULL,
+    PRIMARY KEY (definition_id, position)
+);
+CREATE INDEX responses_id ON responses (definition_id, id);
+
+CREATE TABLE request_bodies (
+    definition_id TEXT    NOT NULL REFERENCES definitions (id) ON DELETE CASCADE,
+    position      INTEGER NOT NULL,
+    id            TEXT    NOT NULL,
+    component     TEXT    NOT NULL,
+    required      INTEGER NOT NULL,
+    data          TEXT    NOT NULL,
+    PRIMARY KEY (definition_id, position)
+);
+CREATE INDEX request_bodies_id ON request_bodies (definition_id, id);
+
+CREATE TABLE security_schemes (
+    definition_id TEXT    NOT NULL REFERENCES definitions (id) ON DELETE CASCADE,
+    position      INTEGER NOT NULL,
+    id            TEXT    NOT NULL,
+    component     TEXT    NOT NULL,
+    type          TEXT    NOT NULL,
+    scheme        TEXT    NOT NULL,
+    data          TEXT    NOT NULL,
+    PRIMARY KEY (definition_id, position)
+);
+CREATE INDEX security_schemes_id ON security_schemes (definition_id, id);
+
+-- Links between endpoints and the parts they use
+
+CREATE TABLE endpoint_parameters (
+    definition_id TEXT    NOT NULL REFERENCES definitions (id) ON DELETE CASCADE,
+    endpoint_id   TEXT    NOT NULL,
+    position      INTEGER NOT NULL,
+    parameter_id  TEXT    NOT NULL
+);
+CREATE INDEX endpoint_parameters_endpoint ON endpoint_parameters (definition_id, endpoint_id);
+
+CREATE TABLE endpoint_responses (
+    definition_id TEXT NOT NULL REFERENCES definitions (id) ON DELETE CASCADE,
+    endpoint_id   TEXT NOT NULL,
+    status_code   TEXT NOT NULL,
+    response_id   TEXT NOT NULL
+);
+CREATE INDEX endpoint_responses_endpoint ON endpoint_responses (definition_id, endpoint_id);
+
+CREATE TABLE endpoint_tags (
+    definition_id TEXT NOT NULL REFERENCES definitions (id) ON DELETE CASCADE,
+    endpoint_id   TEXT NOT NULL,
+    tag           TEXT NOT NULL
+);
+CREATE INDEX endpoint_tags_tag ON endpoint_tags (tag);
+
+-- schema_usages records which named schemas each part refers to directly.
+-- owner_kind is one of schema, parameter, response or requestBody.
+CREATE TABLE schema_usages (
+    definition_id TEXT NOT NULL REFERENCES definitions (id) ON DELETE CASCADE,
+    owner_kind    TEXT NOT NULL,
+    owner_id      TEXT NOT NULL,
+    schema_id     TEXT NOT NULL
+);
+CREATE INDEX schema_usages_schema ON schema_usages (definition_id, schema_id);
+
+-- endpoint_schemas lists the named schemas each endpoint uses directly,
+-- through its parameters, request body and responses. Schemas nested in
+-- those follow from schema_usages rows with owner_kind schema.
+CREATE VIEW endpoint_schemas AS
+SELECT ep.definition_id, ep.endpoint_id, su.schema_id, 'parameter' AS via
+FROM endpoint_parameters ep
+JOIN schema_usages su
+  ON su.definition_id = ep.definition_id AND su.owner_kind = 'parameter' AND su.owner_id = ep.parameter_id
+UNION
+SELECT e.definition_id, e.id, su.schema_id, 'requestBody'
+FROM endpoints e
+JOIN schema_usages su
+  ON su.definition_id = e.definition_id AND su.owner_kind = 'requestBody' AND su.owner_id = e.request_body_id
+UNION
+SELECT er.definition_id, er.endpoint_id, su.schema_id, 'response'
+FROM endpoint_responses er
+JOIN schema_usages su
+  ON su.definition_id = er.definition_id AND su.owner_kind = 'response' AND su.owner_id = er.response_id;
@@ -0,0 +1,17 @@
+-- Every save of a definition is recorded as a numbered revision holding the
+-- full JSON of the definition at that point. Saving replaces the rows of a
+-- definition, so revisions have no foreign key to definitions; they are
+-- deleted together with the definition instead.
+
+ALTER TABLE definitions ADD COLUMN revision INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE revisions (
+    definition_id TEXT    NOT NULL,
+    number        INTEGER NOT NULL,
+    author        TEXT    NOT NULL,
+    message       TEXT    NOT NULL,
+    restored_from INTEGER NOT NULL,
+    created_at    TEXT    NOT NULL,
+    snapshot      TEXT    NOT NULL,
+    PRIMARY KEY (definition_id, number)
+);
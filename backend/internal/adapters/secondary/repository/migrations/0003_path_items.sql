@@ -0,0 +1,5 @@
+-- The fields paths and webhooks declare for all of their operations, as the
+-- JSON of the path item lists of a definition
+
+ALTER TABLE definitions ADD COLUMN path_items TEXT;
+ALTER TABLE definitions ADD COLUMN webhook_items TEXT;
@@ -0,0 +1,686 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// SQLiteAPIRepository stores API definitions in a SQLite database, with a
+// table for each part of the normalized model so the parts can be queried
+// with SQL. Each definition is written in a single transaction.
+type SQLiteAPIRepository struct {
+	db *sql.DB
+}
+
+// NewSQLiteAPIRepository opens the database at path, creating it if needed,
+// and applies any migrations it has not seen yet
+func NewSQLiteAPIRepository(path string) (*SQLiteAPIRepository, error) {
+	if path != ":memory:" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create database directory: %w", err)
+		}
+	}
+
+	db, err := sql.Open("sqlite3", "file:"+path+"?_foreign_keys=on&_busy_timeout=5000&_journal_mode=WAL")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	// SQLite allows one writer at a time; a single connection also keeps
+	// in-memory databases from being opened once per connection
+	db.SetMaxOpenConns(1)
+
+	r := &SQLiteAPIRepository{db: db}
+	if err := r.migrate(context.Background()); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// Close closes the database
+func (r *SQLiteAPIRepository) Close() error {
+	return r.db.Close()
+}
+
+// migrate applies the embedded migrations in order of their version, the
+// number their file name starts with, each in its own transaction
+func (r *SQLiteAPIRepository) migrate(ctx context.Context) error {
+	if _, err := r.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INTEGER PRIMARY KEY,
+		applied_at TEXT NOT NULL
+	)`); err != nil {
+		return fmt.Errorf("failed to create migrations table: %w", err)
+	}
+
+	applied := make(map[int]bool)
+	rows, err := r.db.QueryContext(ctx, `SELECT version FROM schema_migrations`)
+	if err != nil {
+		return fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to read applied migrations: %w", err)
+		}
+		applied[version] = true
+	}
+	rows.Close()
+
+	names, err := fs.Glob(migrations, "migrations/*.sql")
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		prefix, _, _ := strings.Cut(path.Base(name), "_")
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			return fmt.Errorf("migration %s does not start with a version number", name)
+		}
+		if applied[version] {
+			continue
+		}
+
+		script, err := migrations.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		err = r.inTx(ctx, func(tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, string(script)); err != nil {
+				return err
+			}
+			_, err := tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, applied_at) VALUES (?, ?)`,
+				version, time.Now().UTC().Format(time.RFC3339))
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to apply migration %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Save stores an API definition, replacing any with the same ID
+func (r *SQLiteAPIRepository) Save(ctx context.Context, api *domain.APIDefinition) error {
+	if api == nil {
+		return errors.New("api definition cannot be nil")
+	}
+
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM definitions WHERE id = ?`, api.ID); err != nil {
+			return fmt.Errorf("failed to replace api definition: %w", err)
+		}
+		return insertDefinition(ctx, tx, api)
+	})
+}
+
+// FindByID retrieves an API definition by ID
+func (r *SQLiteAPIRepository) FindByID(ctx context.Context, id string) (*domain.APIDefinition, error) {
+	var api *domain.APIDefinition
+	err := r.inTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		api, err = loadDefinition(ctx, tx, id)
+		return err
+	})
+	return api, err
+}
+
+// FindAll retrieves all API definitions, oldest first
+func (r *SQLiteAPIRepository) FindAll(ctx context.Context) ([]*domain.APIDefinition, error) {
+	apis := make([]*domain.APIDefinition, 0)
+	err := r.inTx(ctx, func(tx *sql.Tx) error {
+		ids, err := queryStrings(ctx, tx, `SELECT id FROM definitions ORDER BY created_at, id`)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			api, err := loadDefinition(ctx, tx, id)
+			if err != nil {
+				return err
+			}
+			apis = append(apis, api)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apis, nil
+}
+
+// Update updates an existing API definition
+func (r *SQLiteAPIRepository) Update(ctx context.Context, api *domain.APIDefinition) error {
+	if api == nil {
+		return errors.New("api definition cannot be nil")
+	}
+
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, `DELETE FROM definitions WHERE id = ?`, api.ID)
+		if err != nil {
+			return fmt.Errorf("failed to replace api definition: %w", err)
+		}
+		if n, _ := result.RowsAffected(); n == 0 {
+			return errors.New("api definition not found")
+		}
+		return insertDefinition(ctx, tx, api)
+	})
+}
+
+// Delete removes an API definition, all of its parts through the foreign
+// keys, and its revisions
+func (r *SQLiteAPIRepository) Delete(ctx context.Context, id string) error {
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, `DELETE FROM definitions WHERE id = ?`, id)
+		if err != nil {
+			return fmt.Errorf("failed to delete api definition: %w", err)
+		}
+		if n, _ := result.RowsAffected(); n == 0 {
+			return errors.New("api definition not found")
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM revisions WHERE definition_id = ?`, id); err != nil {
+			return fmt.Errorf("failed to delete revisions: %w", err)
+		}
+		return nil
+	})
+}
+
+// ExistsByID checks if an API definition exists
+func (r *SQLiteAPIRepository) ExistsByID(ctx context.Context, id string) (bool, error) {
+	var count int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM definitions WHERE id = ?`, id).Scan(&count); err != nil {
+		return false, fmt.Errorf("failed to look up api definition: %w", err)
+	}
+	return count > 0, nil
+}
+
+// SaveRevision stores an API definition and records it as a new revision
+// in one transaction
+func (r *SQLiteAPIRepository) SaveRevision(ctx context.Context, api *domain.APIDefinition, revision *domain.Revision) error {
+	if api == nil || revision == nil {
+		return errors.New("api definition and revision cannot be nil")
+	}
+
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		var number int
+		if err := tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(number), 0) + 1 FROM revisions WHERE definition_id = ?`, api.ID).
+			Scan(&number); err != nil {
+			return fmt.Errorf("failed to number revision: %w", err)
+		}
+
+		snapshot := *api
+		snapshot.Revision = number
+		data, err := encodeJSON(&snapshot)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM definitions WHERE id = ?`, api.ID); err != nil {
+			return fmt.Errorf("failed to replace api definition: %w", err)
+		}
+		if err := insertDefinition(ctx, tx, &snapshot); err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO revisions
+			(definition_id, number, author, message, restored_from, created_at, snapshot)
+			VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			api.ID, number, revision.Author, revision.Message, revision.RestoredFrom,
+			revision.CreatedAt.Format(time.RFC3339Nano), data)
+		if err != nil {
+			return fmt.Errorf("failed to insert revision: %w", err)
+		}
+
+		api.Revision = number
+		revision.DefinitionID = api.ID
+		revision.Number = number
+		return nil
+	})
+}
+
+// ListRevisions lists the revisions of an API definition, oldest first
+func (r *SQLiteAPIRepository) ListRevisions(ctx context.Context, id string) ([]*domain.Revision, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT number, author, message, restored_from, created_at
+		FROM revisions WHERE definition_id = ? ORDER BY number`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+	defer rows.Close()
+
+	revisions := make([]*domain.Revision, 0)
+	for rows.Next() {
+		revision := &domain.Revision{DefinitionID: id}
+		var createdAt string
+		if err := rows.Scan(&revision.Number, &revision.Author, &revision.Message, &revision.RestoredFrom, &createdAt); err != nil {
+			return nil, fmt.Errorf("failed to list revisions: %w", err)
+		}
+		if revision.CreatedAt, err = time.Parse(time.RFC3339Nano, createdAt); err != nil {
+			return nil, fmt.Errorf("failed to list revisions: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+	return revisions, nil
+}
+
+// FindRevision retrieves a revision of an API definition with its snapshot
+func (r *SQLiteAPIRepository) FindRevision(ctx context.Context, id string, number int) (*domain.Revision, error) {
+	revision := &domain.Revision{DefinitionID: id, Number: number}
+	var createdAt, snapshot string
+	err := r.db.QueryRowContext(ctx, `SELECT author, message, restored_from, created_at, snapshot
+		FROM revisions WHERE definition_id = ? AND number = ?`, id, number).
+		Scan(&revision.Author, &revision.Message, &revision.RestoredFrom, &createdAt, &snapshot)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find revision: %w", err)
+	}
+
+	if revision.CreatedAt, err = time.Parse(time.RFC3339Nano, createdAt); err != nil {
+		return nil, fmt.Errorf("failed to find revision: %w", err)
+	}
+	revision.Definition = &domain.APIDefinition{}
+	if err := decodeJSON(snapshot, revision.Definition); err != nil {
+		return nil, err
+	}
+	return revision, nil
+}
+
+// inTx runs fn in a transaction, committing when it succeeds
+func (r *SQLiteAPIRepository) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func insertDefinition(ctx context.Context, tx *sql.Tx, api *domain.APIDefinition) error {
+	metadata, err := encodeJSON(api.Metadata)
+	if err != nil {
+		return err
+	}
+	security, err := encodeOptional(api.Security, len(api.Security) > 0)
+	if err != nil {
+		return err
+	}
+	tags, err := encodeOptional(api.Tags, len(api.Tags) > 0)
+	if err != nil {
+		return err
+	}
+	components, err := encodeOptional(api.Components, len(api.Components) > 0)
+	if err != nil {
+		return err
+	}
+	extensions, err := encodeOptional(api.Extensions, len(api.Extensions) > 0)
+	if err != nil {
+		return err
+	}
+	pathItems, err := encodeOptional(api.PathItems, len(api.PathItems) > 0)
+	if err != nil {
+		return err
+	}
+	webhookItems, err := encodeOptional(api.WebhookItems, len(api.WebhookItems) > 0)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO definitions
+		(id, revision, name, version, spec_version, base_url, metadata, security, tags, components, extensions,
+		 path_items, webhook_items, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		api.ID, api.Revision, api.Metadata.Name, api.Metadata.Version, api.Metadata.SpecVersion, api.Metadata.BaseURL,
+		metadata, security, tags, components, extensions, pathItems, webhookItems,
+		api.CreatedAt.Format(time.RFC3339Nano), api.UpdatedAt.Format(time.RFC3339Nano))
+	if err != nil {
+		return fmt.Errorf("failed to insert api definition: %w", err)
+	}
+
+	schemaIDs := make(map[string]bool, len(api.Schemas))
+	for _, schema := range api.Schemas {
+		schemaIDs[schema.ID] = true
+	}
+
+	for _, group := range []struct {
+		webhook   bool
+		endpoints []domain.Endpoint
+	}{{false, api.Endpoints}, {true, api.Webhooks}} {
+		for i, endpoint := range group.endpoints {
+			if err := insertEndpoint(ctx, tx, api.ID, group.webhook, i, endpoint); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, schema := range api.Schemas {
+		err := insertPart(ctx, tx, `INSERT INTO schemas (definition_id, position, id, name, type, data) VALUES (?, ?, ?, ?, ?, ?)`,
+			schema, api.ID, i, schema.ID, schema.Name, schema.Type)
+		if err != nil {
+			return err
+		}
+		if err := insertSchemaUsages(ctx, tx, api.ID, "schema", schema.ID, schema, schemaIDs); err != nil {
+			return err
+		}
+	}
+
+	for i, param := range api.Parameters {
+		err := insertPart(ctx, tx, `INSERT INTO parameters (definition_id, position, id, component, name, location, required, data) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			param, api.ID, i, param.ID, param.Component, param.Name, param.In, param.Required)
+		if err != nil {
+			return err
+		}
+		if err := insertSchemaUsages(ctx, tx, api.ID, "parameter", param.ID, param, schemaIDs); err != nil {
+			return err
+		}
+	}
+
+	for i, response := range api.Responses {
+		err := insertPart(ctx, tx, `INSERT INTO responses (definition_id, position, id, component, description, data) VALUES (?, ?, ?, ?, ?, ?)`,
+			response, api.ID, i, response.ID, response.Component, response.Description)
+		if err != nil {
+			return err
+		}
+		if err := insertSchemaUsages(ctx, tx, api.ID, "response", response.ID, response, schemaIDs); err != nil {
+			return err
+		}
+	}
+
+	for i, body := range api.RequestBodies {
+		err := insertPart(ctx, tx, `INSERT INTO request_bodies (definition_id, position, id, component, required, data) VALUES (?, ?, ?, ?, ?, ?)`,
+			body, api.ID, i, body.ID, body.Component, body.Required)
+		if err != nil {
+			return err
+		}
+		if err := insertSchemaUsages(ctx, tx, api.ID, "requestBody", body.ID, body, schemaIDs); err != nil {
+			return err
+		}
+	}
+
+	for i, scheme := range api.SecuritySchemes {
+		err := insertPart(ctx, tx, `INSERT INTO security_schemes (definition_id, position, id, component, type, scheme, data) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			scheme, api.ID, i, scheme.ID, scheme.Component, scheme.Type, scheme.Scheme)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertEndpoint(ctx context.Context, tx *sql.Tx, definitionID string, webhook bool, position int, endpoint domain.Endpoint) error {
+	err := insertPart(ctx, tx, `INSERT INTO endpoints
+		(definition_id, webhook, position, id, path, method, operation_id, summary, deprecated, request_body_id, data)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		endpoint, definitionID, webhook, position, endpoint.ID, endpoint.Path, endpoint.Method,
+		endpoint.OperationID, endpoint.Summary, endpoint.Deprecated, endpoint.RequestBody)
+	if err != nil {
+		return err
+	}
+
+	for i, paramID := range endpoint.Parameters {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO endpoint_parameters (definition_id, endpoint_id, position, parameter_id) VALUES (?, ?, ?, ?)`,
+			definitionID, endpoint.ID, i, paramID); err != nil {
+			return fmt.Errorf("failed to insert endpoint parameter: %w", err)
+		}
+	}
+
+	codes := make([]string, 0, len(endpoint.Responses))
+	for code := range endpoint.Responses {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO endpoint_responses (definition_id, endpoint_id, status_code, response_id) VALUES (?, ?, ?, ?)`,
+			definitionID, endpoint.ID, code, endpoint.Responses[code]); err != nil {
+			return fmt.Errorf("failed to insert endpoint response: %w", err)
+		}
+	}
+
+	for _, tag := range endpoint.Tags {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO endpoint_tags (definition_id, endpoint_id, tag) VALUES (?, ?, ?)`,
+			definitionID, endpoint.ID, tag); err != nil {
+			return fmt.Errorf("failed to insert endpoint tag: %w", err)
+		}
+	}
+	return nil
+}
+
+// insertPart runs an INSERT whose last placeholder is the JSON of value
+func insertPart(ctx context.Context, tx *sql.Tx, query string, value interface{}, args ...interface{}) error {
+	data, err := encodeJSON(value)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, query, append(args, data)...); err != nil {
+		return fmt.Errorf("failed to insert api definition part: %w", err)
+	}
+	return nil
+}
+
+// insertSchemaUsages records the named schemas a part refers to: $ref values
+// inside schema objects and media types whose schema is a schema ID
+func insertSchemaUsages(ctx context.Context, tx *sql.Tx, definitionID, ownerKind, ownerID string, part interface{}, schemaIDs map[string]bool) error {
+	var value interface{}
+	data, err := json.Marshal(part)
+	if err != nil {
+		return fmt.Errorf("failed to encode api definition part: %w", err)
+	}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("failed to decode api definition part: %w", err)
+	}
+
+	used := make(map[string]bool)
+	var walk func(value interface{})
+	walk = func(value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			for _, key := range []string{"$ref", "schema"} {
+				if id, ok := v[key].(string); ok && schemaIDs[id] {
+					used[id] = true
+				}
+			}
+			for _, item := range v {
+				walk(item)
+			}
+		case []interface{}:
+			for _, item := range v {
+				walk(item)
+			}
+		}
+	}
+	walk(value)
+
+	ids := make([]string, 0, len(used))
+	for id := range used {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO schema_usages (definition_id, owner_kind, owner_id, schema_id) VALUES (?, ?, ?, ?)`,
+			definitionID, ownerKind, ownerID, id); err != nil {
+			return fmt.Errorf("failed to insert schema usage: %w", err)
+		}
+	}
+	return nil
+}
+
+// loadDefinition reads a definition and its parts; it returns nil when
+// there is no definition with the ID
+func loadDefinition(ctx context.Context, tx *sql.Tx, id string) (*domain.APIDefinition, error) {
+	var (
+		metadata                               string
+		security, tags, components, extensions sql.NullString
+		pathItems, webhookItems                sql.NullString
+		createdAt, updatedAt                   string
+	)
+	api := &domain.APIDefinition{ID: id}
+	err := tx.QueryRowContext(ctx, `SELECT revision, metadata, security, tags, components, extensions,
+		path_items, webhook_items, created_at, updated_at
+		FROM definitions WHERE id = ?`, id).
+		Scan(&api.Revision, &metadata, &security, &tags, &components, &extensions,
+			&pathItems, &webhookItems, &createdAt, &updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load api definition: %w", err)
+	}
+
+	if err := decodeJSON(metadata, &api.Metadata); err != nil {
+		return nil, err
+	}
+	for _, column := range []struct {
+		value  sql.NullString
+		target interface{}
+	}{
+		{security, &api.Security},
+		{tags, &api.Tags},
+		{components, &api.Components},
+		{extensions, &api.Extensions},
+		{pathItems, &api.PathItems},
+		{webhookItems, &api.WebhookItems},
+	} {
+		if column.value.Valid {
+			if err := decodeJSON(column.value.String, column.target); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if api.CreatedAt, err = time.Parse(time.RFC3339Nano, createdAt); err != nil {
+		return nil, fmt.Errorf("failed to load api definition: %w", err)
+	}
+	if api.UpdatedAt, err = time.Parse(time.RFC3339Nano, updatedAt); err != nil {
+		return nil, fmt.Errorf("failed to load api definition: %w", err)
+	}
+
+	api.Endpoints = []domain.Endpoint{}
+	api.Schemas = []domain.Schema{}
+	api.Parameters = []domain.Parameter{}
+	api.Responses = []domain.Response{}
+	api.RequestBodies = []domain.RequestBody{}
+
+	parts := []struct {
+		query  string
+		append func(data string) error
+	}{
+		{`SELECT data FROM endpoints WHERE definition_id = ? AND webhook = 0 ORDER BY position`, func(data string) error {
+			return decodeInto(data, &api.Endpoints)
+		}},
+		{`SELECT data FROM endpoints WHERE definition_id = ? AND webhook = 1 ORDER BY position`, func(data string) error {
+			return decodeInto(data, &api.Webhooks)
+		}},
+		{`SELECT data FROM schemas WHERE definition_id = ? ORDER BY position`, func(data string) error {
+			return decodeInto(data, &api.Schemas)
+		}},
+		{`SELECT data FROM parameters WHERE definition_id = ? ORDER BY position`, func(data string) error {
+			return decodeInto(data, &api.Parameters)
+		}},
+		{`SELECT data FROM responses WHERE definition_id = ? ORDER BY position`, func(data string) error {
+			return decodeInto(data, &api.Responses)
+		}},
+		{`SELECT data FROM request_bodies WHERE definition_id = ? ORDER BY position`, func(data string) error {
+			return decodeInto(data, &api.RequestBodies)
+		}},
+		{`SELECT data FROM security_schemes WHERE definition_id = ? ORDER BY position`, func(data string) error {
+			return decodeInto(data, &api.SecuritySchemes)
+		}},
+	}
+	for _, part := range parts {
+		rows, err := queryStrings(ctx, tx, part.query, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, data := range rows {
+			if err := part.append(data); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return api, nil
+}
+
+// decodeInto decodes one element and appends it to the slice list points to
+func decodeInto[T any](data string, list *[]T) error {
+	var item T
+	if err := decodeJSON(data, &item); err != nil {
+		return err
+	}
+	*list = append(*list, item)
+	return nil
+}
+
+func queryStrings(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query api definitions: %w", err)
+	}
+	defer rows.Close()
+
+	var values []string
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, fmt.Errorf("failed to query api definitions: %w", err)
+		}
+		values = append(values, value)
+	}
+	return values, rows.Err()
+}
+
+func encodeJSON(value interface{}) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode api definition: %w", err)
+	}
+	return string(data), nil
+}
+
+// encodeOptional encodes value, or returns NULL when it is not set
+func encodeOptional(value interface{}, set bool) (sql.NullString, error) {
+	if !set {
+		return sql.NullString{}, nil
+	}
+	data, err := encodeJSON(value)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: data, Valid: true}, nil
+}
+
+func decodeJSON(data string, target interface{}) error {
+	if err := json.Unmarshal([]byte(data), target); err != nil {
+		return fmt.Errorf("failed to decode api definition: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+//go:build cgo
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+func newTestSQLiteRepository(t *testing.T, path string) *SQLiteAPIRepository {
+	t.Helper()
+	repo, err := NewSQLiteAPIRepository(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { repo.Close() })
+	return repo
+}
+
+// TestSQLiteRepositoryAppliesMigrations opens a database that only has the
+// first migration and a definition saved under it, and checks the others are
+// applied on top without touching the definition, and only once
+func TestSQLiteRepositoryAppliesMigrations(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "api.db")
+
+	db, err := sql.Open("sqlite3", "file:"+path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	initial, err := migrations.ReadFile("migrations/0001_initial.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, statement := range []string{
+		`CREATE TABLE schema_migrations (version INTEGER PRIMARY KEY, applied_at TEXT NOT NULL)`,
+		string(initial),
+		`INSERT INTO schema_migrations (version, applied_at) VALUES (1, '2024-01-01T00:00:00Z')`,
+		`INSERT INTO definitions (id, name, version, spec_version, base_url, metadata, created_at, updated_at)
+			VALUES ('old', 'Old', '1.0.0', '3.0.3', '', '{"name":"Old","version":"1.0.0"}', '2024-01-01T00:00:00Z', '2024-01-01T00:00:00Z')`,
+	} {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db.Close()
+
+	repo := newTestSQLiteRepository(t, path)
+	versions := queryColumn(t, repo, `SELECT version FROM schema_migrations ORDER BY version`)
+	if !reflect.DeepEqual(versions, []string{"1", "2", "3"}) {
+		t.Errorf("applied migrations = %v, want 1, 2 and 3", versions)
+	}
+
+	old, err := repo.FindByID(ctx, "old")
+	if err != nil || old == nil {
+		t.Fatalf("definition saved before the migration: %v, %v", old, err)
+	}
+	if old.Metadata.Name != "Old" || old.Revision != 0 {
+		t.Errorf("definition = %s at revision %d, want Old at revision 0", old.Metadata.Name, old.Revision)
+	}
+	revisions, err := repo.ListRevisions(ctx, "old")
+	if err != nil || len(revisions) != 0 {
+		t.Errorf("revisions = %v, %v, want none", revisions, err)
+	}
+
+	// Opening the database again finds nothing left to apply
+	repo.Close()
+	newTestSQLiteRepository(t, path)
+}
+
+// queryColumn returns the first column of the rows a query returns
+func queryColumn(t *testing.T, repo *SQLiteAPIRepository, query string) []string {
+	t.Helper()
+	rows, err := repo.db.Query(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var values []string
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, value)
+	}
+	return values
+}
+
+// TestSQLiteRepositorySavesRevisions saves a definition twice and reads it
+// back with both revisions, each with the snapshot it was saved with
+func TestSQLiteRepositorySavesRevisions(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestSQLiteRepository(t, filepath.Join(t.TempDir(), "api.db"))
+
+	api := newTestDefinition("pets")
+	// Lists read back empty rather than nil
+	api.RequestBodies = []domain.RequestBody{}
+	first := &domain.Revision{Author: "ann", Message: "Create", CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}
+	if err := repo.SaveRevision(ctx, api, first); err != nil {
+		t.Fatal(err)
+	}
+	api.Metadata.Name = "Pet Store"
+	second := &domain.Revision{Author: "bob", Message: "Rename", CreatedAt: time.Date(2024, 1, 3, 3, 4, 5, 0, time.UTC)}
+	if err := repo.SaveRevision(ctx, api, second); err != nil {
+		t.Fatal(err)
+	}
+	if first.Number != 1 || second.Number != 2 || api.Revision != 2 {
+		t.Fatalf("revisions numbered %d and %d, definition at %d, want 1, 2 and 2", first.Number, second.Number, api.Revision)
+	}
+
+	loaded, err := repo.FindByID(ctx, "pets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := toJSON(t, loaded), toJSON(t, api); got != want {
+		t.Errorf("loaded definition differs from the saved one:\n got %s\nwant %s", got, want)
+	}
+
+	revisions, err := repo.ListRevisions(ctx, "pets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revisions) != 2 || revisions[0].Author != "ann" || revisions[1].Message != "Rename" ||
+		!revisions[1].CreatedAt.Equal(second.CreatedAt) {
+		t.Errorf("revisions = %+v, want ann's Create then bob's Rename", revisions)
+	}
+
+	revision, err := repo.FindRevision(ctx, "pets", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revision == nil || revision.Definition.Metadata.Name != "Pets" || revision.Definition.Revision != 1 {
+		t.Errorf("revision 1 = %+v, want the snapshot named Pets", revision)
+	}
+	if missing, err := repo.FindRevision(ctx, "pets", 3); missing != nil || err != nil {
+		t.Errorf("revision 3 = %+v, %v, want none", missing, err)
+	}
+
+	if err := repo.Delete(ctx, "pets"); err != nil {
+		t.Fatal(err)
+	}
+	if revisions, _ := repo.ListRevisions(ctx, "pets"); len(revisions) != 0 {
+		t.Errorf("revisions after delete = %v, want none", revisions)
+	}
+}
+
+// TestSQLiteRepositoryRecordsSchemaUsages finds the endpoints that use a
+// schema through the endpoint_schemas view, as the README shows
+func TestSQLiteRepositoryRecordsSchemaUsages(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestSQLiteRepository(t, filepath.Join(t.TempDir(), "api.db"))
+
+	api := newTestDefinition("pets")
+	api.Schemas = append(api.Schemas, domain.Schema{
+		ID: "schema-owner", Name: "Owner", Type: "object",
+		Properties: map[string]interface{}{"pet": map[string]interface{}{"$ref": "schema-pet"}},
+	})
+	if err := repo.Save(ctx, api); err != nil {
+		t.Fatal(err)
+	}
+
+	uses := queryColumn(t, repo, `SELECT e.method || ' ' || e.path || ' ' || es.via
+		FROM endpoint_schemas es
+		JOIN endpoints e ON e.definition_id = es.definition_id AND e.id = es.endpoint_id
+		JOIN schemas s ON s.definition_id = es.definition_id AND s.id = es.schema_id
+		WHERE s.name = 'Pet'`)
+	if !reflect.DeepEqual(uses, []string{"GET /pets response"}) {
+		t.Errorf("endpoints using Pet = %v, want GET /pets through its response", uses)
+	}
+
+	owners := queryColumn(t, repo, `SELECT owner_kind || ' ' || owner_id FROM schema_usages
+		WHERE definition_id = 'pets' AND schema_id = 'schema-pet' ORDER BY owner_kind, owner_id`)
+	if !reflect.DeepEqual(owners, []string{"response response-listpets-200", "schema schema-owner"}) {
+		t.Errorf("parts using schema-pet = %v, want the response and the Owner schema", owners)
+	}
+}
@@ -0,0 +1,338 @@
+// Package codegen holds what the code generators share: following schema
+// references to the schemas of a definition, telling what kind of value a
+// schema describes, picking the media types and responses an operation is
+// generated for and handing out unique names.
+package codegen
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// MaxDepth bounds how many references are followed to reach a schema
+const MaxDepth = 8
+
+// Schemas resolves the schema references of one API definition
+type Schemas struct {
+	refs   *resolver.SchemaResolver
+	byID   map[string]*domain.Schema
+	byName map[string]*domain.Schema
+	maps   map[string]map[string]interface{}
+}
+
+// NewSchemas indexes the schemas of a definition
+func NewSchemas(api *domain.APIDefinition) *Schemas {
+	s := &Schemas{
+		refs:   resolver.NewSchemaResolver(api),
+		byID:   make(map[string]*domain.Schema, len(api.Schemas)),
+		byName: make(map[string]*domain.Schema, len(api.Schemas)),
+		maps:   make(map[string]map[string]interface{}, len(api.Schemas)),
+	}
+	for i := range api.Schemas {
+		schema := &api.Schemas[i]
+		s.byID[schema.ID] = schema
+		s.byName[schema.Name] = schema
+		object := resolver.SchemaMap(*schema)
+		// A reference with sibling keywords reads as the allOf member it is
+		// equivalent to, which the generators take for an alias
+		if ref, ok := object["$ref"]; ok {
+			allOf, _ := object["allOf"].([]interface{})
+			object["allOf"] = append(allOf, map[string]interface{}{"$ref": ref})
+			delete(object, "$ref")
+		}
+		s.maps[schema.ID] = object
+	}
+	return s
+}
+
+// Map returns the JSON Schema object form of a named schema
+func (s *Schemas) Map(schema *domain.Schema) map[string]interface{} {
+	return s.maps[schema.ID]
+}
+
+// Resolve follows references and returns the schema object they lead to.
+// A reference to a named schema as a whole returns that schema instead, so
+// it is mapped to the type made for it.
+func (s *Schemas) Resolve(schema interface{}) (map[string]interface{}, *domain.Schema) {
+	for i := 0; i <= MaxDepth; i++ {
+		switch v := schema.(type) {
+		case string:
+			schema = map[string]interface{}{"$ref": v}
+		case map[string]interface{}:
+			ref, ok := v["$ref"].(string)
+			if !ok {
+				return v, nil
+			}
+			if named := s.named(ref); named != nil {
+				return nil, named
+			}
+			target, err := s.refs.Lookup(ref)
+			if err != nil {
+				return nil, nil
+			}
+			schema = target
+		default:
+			return nil, nil
+		}
+	}
+	return nil, nil
+}
+
+// Object returns the schema object a schema resolves to, named or not
+func (s *Schemas) Object(schema interface{}) map[string]interface{} {
+	object, named := s.Resolve(schema)
+	if named != nil {
+		return s.maps[named.ID]
+	}
+	return object
+}
+
+// named returns the schema a reference designates as a whole: a Schema ID
+// or a components/definitions pointer without a pointer into it
+func (s *Schemas) named(ref string) *domain.Schema {
+	if schema, ok := s.byID[ref]; ok {
+		return schema
+	}
+	for _, prefix := range []string{"#/components/schemas/", "#/definitions/"} {
+		name, ok := strings.CutPrefix(ref, prefix)
+		if ok && !strings.Contains(name, "/") {
+			return s.byName[resolver.UnescapeToken(name)]
+		}
+	}
+	return nil
+}
+
+// Properties returns the properties of an object with those of its allOf
+// members, and which of them are required. Properties given as a reference
+// are returned as a $ref object.
+func (s *Schemas) Properties(object map[string]interface{}) (map[string]map[string]interface{}, map[string]bool) {
+	properties := make(map[string]map[string]interface{})
+	required := make(map[string]bool)
+	s.collect(object, properties, required, 0)
+	return properties, required
+}
+
+func (s *Schemas) collect(object map[string]interface{}, properties map[string]map[string]interface{}, required map[string]bool, depth int) {
+	if depth > MaxDepth {
+		return
+	}
+	if members, ok := object["allOf"].([]interface{}); ok {
+		for _, member := range members {
+			if resolved := s.Object(member); resolved != nil {
+				s.collect(resolved, properties, required, depth+1)
+			}
+		}
+	}
+
+	own, _ := object["properties"].(map[string]interface{})
+	for name, property := range own {
+		switch p := property.(type) {
+		case map[string]interface{}:
+			properties[name] = p
+		case string:
+			properties[name] = map[string]interface{}{"$ref": p}
+		}
+	}
+	for _, name := range Strings(object["required"]) {
+		required[name] = true
+	}
+}
+
+// SchemaType returns the first non-null type of a schema, or the one its
+// keywords imply, or mixed when it allows several
+func SchemaType(object map[string]interface{}) string {
+	switch t := object["type"].(type) {
+	case string:
+		return t
+	case []interface{}:
+		var types []string
+		for _, item := range t {
+			if name, ok := item.(string); ok && name != "null" {
+				types = append(types, name)
+			}
+		}
+		if len(types) == 1 {
+			return types[0]
+		}
+		return "mixed"
+	}
+
+	switch {
+	case object["properties"] != nil, object["allOf"] != nil:
+		return "object"
+	case object["items"] != nil:
+		return "array"
+	}
+	return "mixed"
+}
+
+// Nullable reports whether a schema allows null
+func Nullable(object map[string]interface{}) bool {
+	if object["nullable"] == true {
+		return true
+	}
+	types, _ := object["type"].([]interface{})
+	for _, t := range types {
+		if t == "null" {
+			return true
+		}
+	}
+	return false
+}
+
+// EnumValues returns the values of a string enum, leaving out null. Enums
+// with values of other types have none.
+func EnumValues(object map[string]interface{}) []string {
+	enum, _ := object["enum"].([]interface{})
+	var values []string
+	for _, value := range enum {
+		switch v := value.(type) {
+		case string:
+			values = append(values, v)
+		case nil:
+		default:
+			return nil
+		}
+	}
+	return values
+}
+
+// Branches returns the members of a oneOf or anyOf
+func Branches(object map[string]interface{}) []interface{} {
+	if oneOf, ok := object["oneOf"].([]interface{}); ok && len(oneOf) > 0 {
+		return oneOf
+	}
+	anyOf, _ := object["anyOf"].([]interface{})
+	return anyOf
+}
+
+// Number returns a numeric keyword of a schema
+func Number(object map[string]interface{}, key string) (float64, bool) {
+	switch n := object[key].(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// Strings returns the strings of a list
+func Strings(value interface{}) []string {
+	var strings []string
+	list, _ := value.([]interface{})
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			strings = append(strings, s)
+		}
+	}
+	return strings
+}
+
+// PickMedia picks the media type an operation is generated for: JSON when
+// declared, then forms, then the first in order
+func PickMedia(content map[string]domain.MediaType) (string, domain.MediaType, bool) {
+	mediaTypes := SortedKeys(content)
+	if len(mediaTypes) == 0 {
+		return "", domain.MediaType{}, false
+	}
+	sort.SliceStable(mediaTypes, func(i, j int) bool { return mediaRank(mediaTypes[i]) < mediaRank(mediaTypes[j]) })
+	return mediaTypes[0], content[mediaTypes[0]], true
+}
+
+// IsJSON reports whether a media type holds JSON
+func IsJSON(mediaType string) bool {
+	return mediaRank(mediaType) == 0
+}
+
+func mediaRank(mediaType string) int {
+	base, _, _ := strings.Cut(mediaType, ";")
+	base = strings.TrimSpace(strings.ToLower(base))
+	switch {
+	case base == "application/json" || strings.HasSuffix(base, "+json"):
+		return 0
+	case base == "application/x-www-form-urlencoded" || base == "multipart/form-data":
+		return 1
+	}
+	return 2
+}
+
+// SuccessResponse returns the ID of the response a call succeeds with: the
+// lowest 2xx code, a 2XX range or default
+func SuccessResponse(responses map[string]string) (string, bool) {
+	best := 0
+	for code := range responses {
+		if status, err := strconv.Atoi(code); err == nil && status >= 200 && status < 300 && (best == 0 || status < best) {
+			best = status
+		}
+	}
+	if best != 0 {
+		return responses[strconv.Itoa(best)], true
+	}
+	for _, code := range []string{"2XX", "2xx", "default"} {
+		if id, ok := responses[code]; ok {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// OperationWords returns the words an operation is named after: those of
+// its operationId, or else its method and path, such as get, pets, by and
+// petId for GET /pets/{petId}
+func OperationWords(endpoint domain.Endpoint) []string {
+	if endpoint.OperationID != "" {
+		return Words(endpoint.OperationID)
+	}
+
+	words := []string{strings.ToLower(endpoint.Method)}
+	for _, segment := range strings.Split(endpoint.Path, "/") {
+		if param, ok := strings.CutPrefix(segment, "{"); ok {
+			words = append(words, "by")
+			words = append(words, Words(strings.TrimSuffix(param, "}"))...)
+		} else {
+			words = append(words, Words(segment)...)
+		}
+	}
+	return words
+}
+
+// Words splits a name into its words at anything but ASCII letters and
+// digits and at changes of case, so pet_id, petId and PetID all give pet
+// and id in their own case
+func Words(name string) []string {
+	var words []string
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool {
+		return !(r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)))
+	}) {
+		runes := []rune(part)
+		start := 0
+		for i := 1; i < len(runes); i++ {
+			lowerToUpper := unicode.IsLower(runes[i-1]) && unicode.IsUpper(runes[i])
+			// The last capital of a run of capitals starts the next word,
+			// as in HTTPServer
+			acronymEnd := unicode.IsUpper(runes[i-1]) && unicode.IsUpper(runes[i]) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if lowerToUpper || acronymEnd {
+				words = append(words, string(runes[start:i]))
+				start = i
+			}
+		}
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+// SortedKeys returns the keys of a map in order
+func SortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,122 @@
+// Package codegentest provides the definition that the tests of the code
+// generators and of the contract runner share, as net/http/httptest does
+// for HTTP tests.
+package codegentest
+
+import "github.com/swagger-editor/backend/internal/core/domain"
+
+// PetStore builds a definition with a recursive schema, an enum, a readOnly
+// property, a discriminated oneOf of objects, an allOf of a named schema,
+// constraints, response headers and parameters of several types in the
+// path, the query and a header. Each call returns a new copy, which tests
+// may change.
+func PetStore() *domain.APIDefinition {
+	return &domain.APIDefinition{
+		ID:       "pets",
+		Metadata: domain.APIMetadata{Name: "Pet Store", Version: "1.0.0", BaseURL: "https://pets.example.com/v1"},
+		Endpoints: []domain.Endpoint{
+			{
+				ID: "endpoint-listpets", Path: "/pets", Method: "GET", OperationID: "listPets",
+				Parameters: []string{"param-limit", "param-tags", "param-since", "param-trace"},
+				Responses:  map[string]string{"200": "response-pets", "default": "response-error"},
+			},
+			{
+				ID: "endpoint-getpet", Path: "/pets/{petId}", Method: "GET",
+				Parameters: []string{"param-petid"},
+				Responses:  map[string]string{"200": "response-pet", "404": "response-error"},
+			},
+			{
+				ID: "endpoint-createpet", Path: "/pets", Method: "POST", OperationID: "create-pet",
+				RequestBody: "body-pet",
+				Responses:   map[string]string{"201": "response-pet", "4XX": "response-error"},
+			},
+			{
+				ID: "endpoint-deletepet", Path: "/pets/{petId}", Method: "DELETE", OperationID: "deletePet",
+				Parameters: []string{"param-petid"},
+				Responses:  map[string]string{"204": "response-empty"},
+				Deprecated: true,
+			},
+		},
+		Schemas: []domain.Schema{
+			{
+				ID: "schema-pet", Name: "Pet", Type: "object", Required: []string{"id", "name", "owner"},
+				Properties: map[string]interface{}{
+					"id":       map[string]interface{}{"type": "integer", "format": "int64", "minimum": 1.0, "readOnly": true},
+					"name":     map[string]interface{}{"type": "string", "description": "What the pet answers to", "minLength": 1.0, "pattern": "^[A-Za-z ]+$"},
+					"status":   map[string]interface{}{"type": "string", "enum": []interface{}{"available", "sold", "on-hold"}},
+					"parent":   map[string]interface{}{"$ref": "schema-pet"},
+					"owner":    map[string]interface{}{"$ref": "schema-owner"},
+					"tags":     map[string]interface{}{"type": "array", "maxItems": 5.0, "items": map[string]interface{}{"type": "string"}},
+					"metadata": map[string]interface{}{"type": "object", "additionalProperties": true},
+					"born":     map[string]interface{}{"type": "string", "format": "date-time"},
+				},
+			},
+			{
+				ID: "schema-owner", Name: "Owner",
+				OneOf: []interface{}{
+					map[string]interface{}{"$ref": "schema-person"},
+					map[string]interface{}{"$ref": "schema-shelter"},
+				},
+				Discriminator: map[string]interface{}{
+					"propertyName": "kind",
+					"mapping":      map[string]interface{}{"person": "#/components/schemas/Person"},
+				},
+			},
+			{
+				ID: "schema-person", Name: "Person", Type: "object", Required: []string{"kind", "name"},
+				Properties: map[string]interface{}{
+					"kind": map[string]interface{}{"type": "string"},
+					"name": map[string]interface{}{"type": "string"},
+				},
+			},
+			{
+				ID: "schema-shelter", Name: "Shelter",
+				AllOf: []interface{}{
+					map[string]interface{}{"$ref": "schema-place"},
+					map[string]interface{}{"properties": map[string]interface{}{"kind": map[string]interface{}{"type": "string"}}},
+				},
+			},
+			{
+				ID: "schema-place", Name: "Place", Type: "object", Required: []string{"city"},
+				Properties: map[string]interface{}{"city": map[string]interface{}{"type": "string", "nullable": true}},
+			},
+			{
+				ID: "schema-error", Name: "Problem", Type: "object", Required: []string{"message"},
+				Properties: map[string]interface{}{"message": map[string]interface{}{"type": "string"}},
+			},
+		},
+		Parameters: []domain.Parameter{
+			{ID: "param-limit", Name: "limit", In: "query", Schema: map[string]interface{}{"type": "integer", "minimum": 1.0, "maximum": 100.0}},
+			{ID: "param-tags", Name: "tags", In: "query", Schema: map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}}},
+			{ID: "param-since", Name: "since", In: "query", Schema: map[string]interface{}{"type": "string", "format": "date-time"}},
+			{ID: "param-trace", Name: "X-Trace-Id", In: "header", Required: true, Schema: map[string]interface{}{"type": "string"}},
+			{ID: "param-petid", Name: "petId", In: "path", Required: true, Schema: map[string]interface{}{"type": "integer", "format": "int64", "minimum": 1.0}},
+		},
+		Responses: []domain.Response{
+			{
+				ID: "response-pets", Description: "Pets",
+				Headers: map[string]interface{}{"X-Total": map[string]interface{}{"$ref": "#/components/headers/Total"}},
+				Content: map[string]domain.MediaType{
+					"application/json": {Schema: map[string]interface{}{"type": "array", "items": "schema-pet"}},
+				},
+			},
+			{ID: "response-pet", Description: "A pet", Content: map[string]domain.MediaType{
+				"application/json": {Schema: "schema-pet"},
+			}},
+			{ID: "response-empty", Description: "Deleted"},
+			{ID: "response-error", Description: "Error", Content: map[string]domain.MediaType{
+				"application/json": {Schema: "schema-error"},
+			}},
+		},
+		RequestBodies: []domain.RequestBody{
+			{ID: "body-pet", Required: true, Content: map[string]domain.MediaType{
+				"application/json": {Schema: "schema-pet"},
+			}},
+		},
+		Components: map[string]interface{}{
+			"headers": map[string]interface{}{
+				"Total": map[string]interface{}{"required": true, "schema": map[string]interface{}{"type": "integer"}},
+			},
+		},
+	}
+}
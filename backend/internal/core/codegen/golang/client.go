@@ -0,0 +1,311 @@
+package golang
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/codegen"
+)
+
+// clientCode is the part of the client every API shares
+const clientCode = `
+// Client calls the operations of the API over HTTP
+type Client struct {
+	// BaseURL is the URL the operation paths are relative to
+	BaseURL string
+	// HTTPClient sends the requests; http.DefaultClient when nil
+	HTTPClient *http.Client
+	// RequestEditors change every request before it is sent, such as to
+	// add credentials
+	RequestEditors []func(*http.Request) error
+}
+
+// NewClient creates a client for the API at baseURL
+func NewClient(baseURL string) *Client {
+	return &Client{BaseURL: baseURL}
+}
+
+// APIError is returned for responses outside the 2xx range
+type APIError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("unexpected status %d: %s", e.StatusCode, bytes.TrimSpace(e.Body))
+}
+
+// do sends a request and decodes the response body into result: as JSON,
+// or as is when result is a *[]byte. body is sent as is when it is an
+// io.Reader and as JSON otherwise.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, header http.Header, contentType string, body interface{}, result interface{}) error {
+	var reader io.Reader
+	switch b := body.(type) {
+	case nil:
+	case io.Reader:
+		reader = b
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			return fmt.Errorf("encoding request body: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	target := strings.TrimSuffix(c.BaseURL, "/") + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, reader)
+	if err != nil {
+		return err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	if reader != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	for _, edit := range c.RequestEditors {
+		if err := edit(req); err != nil {
+			return err
+		}
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &APIError{StatusCode: resp.StatusCode, Body: data}
+	}
+
+	switch r := result.(type) {
+	case nil:
+	case *[]byte:
+		*r = data
+	default:
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, result); err != nil {
+				return fmt.Errorf("decoding response body: %w", err)
+			}
+		}
+	}
+	return nil
+}
+`
+
+// writeClient writes the client with a method per operation
+func (g *Generator) writeClient() {
+	f := g.client
+	for _, path := range []string{"bytes", "context", "encoding/json", "fmt", "io", "net/http", "net/url", "strings"} {
+		f.use(path)
+	}
+
+	if g.api.Metadata.BaseURL != "" {
+		fmt.Fprintf(&f.body, "\n// DefaultBaseURL is the URL of the first server of the definition\nconst DefaultBaseURL = %s\n", codegen.Quote(g.api.Metadata.BaseURL))
+	}
+	f.body.WriteString(clientCode)
+	for _, op := range g.operations {
+		g.writeClientMethod(op)
+	}
+}
+
+// writeClientMethod writes the client method of an operation. Path
+// parameters are arguments of their own; the other parameters are given in
+// a params struct, which may be nil when none is required.
+func (g *Generator) writeClientMethod(op operation) {
+	f := g.client
+	b := &f.body
+
+	args := []string{"ctx context.Context"}
+	for _, p := range op.pathParams {
+		args = append(args, p.arg+" "+p.t.expr)
+		f.useType(p.t.expr)
+	}
+	paramsPointer := true
+	for _, p := range op.params {
+		if p.required {
+			paramsPointer = false
+		}
+	}
+	if op.paramsType != "" {
+		if paramsPointer {
+			args = append(args, "params *"+op.paramsType)
+		} else {
+			args = append(args, "params "+op.paramsType)
+		}
+	}
+	if op.body != nil {
+		args = append(args, "body "+op.body.bodyType())
+		f.useType(op.body.bodyType())
+	}
+
+	results := "error"
+	switch {
+	case op.raw:
+		results = "([]byte, error)"
+	case op.result != nil && op.result.pointer:
+		results = "(*" + op.result.expr + ", error)"
+	case op.result != nil:
+		results = "(" + op.result.expr + ", error)"
+		f.useType(op.result.expr)
+	}
+
+	fmt.Fprintf(b, "\n// %s calls %s %s\n", op.name, op.method, op.path)
+	writeOperationComment(b, op)
+	fmt.Fprintf(b, "func (c *Client) %s(%s) %s {\n", op.name, strings.Join(args, ", "), results)
+
+	// The path, with the values of its parameters escaped
+	var path []string
+	rest := op.path
+	for _, p := range op.pathParams {
+		before, after, _ := strings.Cut(rest, "{"+p.name+"}")
+		if before != "" {
+			path = append(path, codegen.Quote(before))
+		}
+		path = append(path, "url.PathEscape("+g.clientValue(b, p, p.arg, "\t")+")")
+		rest = after
+	}
+	if rest != "" || len(path) == 0 {
+		path = append(path, codegen.Quote(rest))
+	}
+	pathExpr := strings.Join(path, " + ")
+	if len(op.pathParams) > 0 {
+		fmt.Fprintf(b, "\tpath := %s\n", pathExpr)
+		pathExpr = "path"
+	}
+
+	query, header := "nil", "nil"
+	var params strings.Builder
+	for _, p := range op.params {
+		switch p.in {
+		case "query":
+			query = "query"
+		default:
+			header = "header"
+		}
+		g.writeClientParam(&params, p)
+	}
+	if query != "nil" {
+		b.WriteString("\tquery := url.Values{}\n")
+	}
+	if header != "nil" {
+		b.WriteString("\theader := http.Header{}\n")
+	}
+	if params.Len() > 0 {
+		if paramsPointer {
+			fmt.Fprintf(b, "\tif params != nil {\n%s\t}\n", indent(params.String()))
+		} else {
+			b.WriteString(params.String())
+		}
+	}
+
+	contentType, body := `""`, "nil"
+	if op.body != nil {
+		contentType, body = codegen.Quote(op.body.mediaType), "body"
+		if op.body.t != nil && !op.body.required {
+			// A nil body is left out rather than sent as null
+			b.WriteString("\tvar payload interface{}\n\tif body != nil {\n\t\tpayload = body\n\t}\n")
+			body = "payload"
+		}
+	}
+	call := fmt.Sprintf("c.do(ctx, %s, %s, %s, %s, %s, %s", codegen.Quote(op.method), pathExpr, query, header, contentType, body)
+
+	switch {
+	case op.raw:
+		fmt.Fprintf(b, "\tvar result []byte\n\terr := %s, &result)\n\treturn result, err\n", call)
+	case op.result != nil && op.result.pointer:
+		fmt.Fprintf(b, "\tvar result %s\n\tif err := %s, &result); err != nil {\n\t\treturn nil, err\n\t}\n\treturn &result, nil\n", op.result.expr, call)
+	case op.result != nil:
+		fmt.Fprintf(b, "\tvar result %s\n\terr := %s, &result)\n\treturn result, err\n", op.result.expr, call)
+	default:
+		fmt.Fprintf(b, "\treturn %s, nil)\n", call)
+	}
+	b.WriteString("}\n")
+}
+
+// writeClientParam writes the code that adds a query, header or cookie
+// parameter to a request
+func (g *Generator) writeClientParam(b *strings.Builder, p param) {
+	value := "params." + p.field
+	var set func(string) string
+	switch p.in {
+	case "query":
+		set = func(v string) string { return fmt.Sprintf("query.Add(%s, %s)\n", codegen.Quote(p.name), v) }
+	case "header":
+		set = func(v string) string { return fmt.Sprintf("header.Set(%s, %s)\n", codegen.Quote(p.name), v) }
+	default:
+		set = func(v string) string {
+			return fmt.Sprintf("header.Add(\"Cookie\", (&http.Cookie{Name: %s, Value: %s}).String())\n", codegen.Quote(p.name), v)
+		}
+	}
+
+	switch {
+	case p.t.elem != nil && p.in == "query":
+		// Lists are sent as one query parameter per item
+		fmt.Fprintf(b, "\tfor _, item := range %s {\n\t\t%s\t}\n", value, set(formatValue(g.client, *p.t.elem, "item")))
+	case p.t.elem != nil:
+		var inner strings.Builder
+		joined := g.clientValue(&inner, p, value, "\t\t")
+		fmt.Fprintf(b, "\tif len(%s) > 0 {\n%s\t\t%s\t}\n", value, inner.String(), set(joined))
+	case p.pointer():
+		fmt.Fprintf(b, "\tif %s != nil {\n\t\t%s\t}\n", value, set(formatValue(g.client, p.t, "*"+value)))
+	default:
+		b.WriteString("\t" + set(formatValue(g.client, p.t, value)))
+	}
+}
+
+// clientValue returns the text of a parameter value; a list is joined with
+// commas by the code it writes first
+func (g *Generator) clientValue(b *strings.Builder, p param, value, indent string) string {
+	if p.t.elem == nil {
+		return formatValue(g.client, p.t, value)
+	}
+	values := unexported(p.name + " values")
+	fmt.Fprintf(b, "%svar %s []string\n%sfor _, item := range %s {\n%s\t%s = append(%s, %s)\n%s}\n",
+		indent, values, indent, value, indent, values, values, formatValue(g.client, *p.t.elem, "item"), indent)
+	g.client.use("strings")
+	return "strings.Join(" + values + ", \",\")"
+}
+
+// formatValue returns the text of a scalar value
+func formatValue(f *file, t goType, value string) string {
+	switch {
+	case t.expr == "string":
+		return value
+	case t.base == "string":
+		return "string(" + value + ")"
+	case t.base == "time.Time":
+		f.use("time")
+		// A dereference binds looser than the method call
+		if strings.HasPrefix(value, "*") {
+			value = "(" + value + ")"
+		}
+		return value + ".Format(time.RFC3339)"
+	}
+	f.use("fmt")
+	return "fmt.Sprint(" + value + ")"
+}
+
+// writeOperationComment writes the description of an operation below the
+// first line of its doc comment
+func writeOperationComment(b *strings.Builder, op operation) {
+	if op.description != "" {
+		b.WriteString("//\n")
+		writeComment(b, op.description, "")
+	}
+	if op.deprecated {
+		b.WriteString("//\n// Deprecated: the definition marks this operation deprecated.\n")
+	}
+}
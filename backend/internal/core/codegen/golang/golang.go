@@ -0,0 +1,232 @@
+// Package golang generates a Go package from an API definition: a struct
+// or other type for every schema, with json tags and a Validate method for
+// the constraints of the schema, an HTTP client with a method per
+// operation, and a server interface with a chi router that parses the
+// parameters and body of each operation before calling it.
+package golang
+
+import (
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/codegen"
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// header marks the generated files, so tools leave them alone
+const header = "// Code generated by swagger-editor from %s %s. DO NOT EDIT.\n\n"
+
+// routerMethods are the methods chi routes without registering them
+var routerMethods = map[string]bool{
+	"GET": true, "HEAD": true, "POST": true, "PUT": true, "PATCH": true,
+	"DELETE": true, "OPTIONS": true, "TRACE": true, "CONNECT": true,
+}
+
+// reservedNames are the package-level names the generated code declares
+// itself
+var reservedNames = []string{
+	"APIError", "Client", "DefaultBaseURL", "Handler", "NewClient", "RegisterHandlers",
+	"ServerInterface", "Unimplemented", "cookieValue", "serverWrapper",
+}
+
+// Generator builds the Go package of one API definition
+type Generator struct {
+	api        *domain.APIDefinition
+	pkg        string
+	schemas    *codegen.Schemas
+	parameters map[string]domain.Parameter
+	bodies     map[string]domain.RequestBody
+	responses  map[string]domain.Response
+
+	idents      codegen.Names
+	schemaNames map[string]string
+	made        map[string]goType
+	enums       map[string]goType
+	decls       map[string]string
+	patterns    map[string]string
+	operations  []operation
+	depth       int
+
+	models, client, server *file
+	// out is the file checks are written to
+	out *file
+}
+
+// file is a Go source file being generated
+type file struct {
+	name    string
+	imports map[string]bool
+	body    strings.Builder
+}
+
+func newFile(name string) *file {
+	return &file{name: name, imports: make(map[string]bool)}
+}
+
+func (f *file) use(path string) {
+	f.imports[path] = true
+}
+
+// useType imports the package a type expression refers to
+func (f *file) useType(expr string) {
+	if strings.Contains(expr, "time.Time") {
+		f.use("time")
+	}
+}
+
+// New creates a generator for an API definition. pkg names the package;
+// when empty, the package is named after the API.
+func New(api *domain.APIDefinition, pkg string) *Generator {
+	if pkg == "" {
+		pkg = api.Metadata.Name
+	}
+	g := &Generator{
+		api:         api,
+		pkg:         packageName(pkg),
+		schemas:     codegen.NewSchemas(api),
+		parameters:  make(map[string]domain.Parameter, len(api.Parameters)),
+		bodies:      make(map[string]domain.RequestBody, len(api.RequestBodies)),
+		responses:   make(map[string]domain.Response, len(api.Responses)),
+		idents:      make(codegen.Names),
+		schemaNames: make(map[string]string, len(api.Schemas)),
+		made:        make(map[string]goType),
+		enums:       make(map[string]goType),
+		decls:       make(map[string]string),
+		patterns:    make(map[string]string),
+		models:      newFile("models.go"),
+		client:      newFile("client.go"),
+		server:      newFile("server.go"),
+	}
+	g.out = g.models
+
+	for _, name := range reservedNames {
+		g.idents[name] = true
+	}
+	// Named schemas keep their own names; types declared for inline
+	// schemas and parameters get the names left over
+	for _, schema := range api.Schemas {
+		g.schemaNames[schema.ID] = g.idents.Unique(exported(schema.Name))
+	}
+	for _, param := range api.Parameters {
+		g.parameters[param.ID] = param
+	}
+	for _, body := range api.RequestBodies {
+		g.bodies[body.ID] = body
+	}
+	for _, response := range api.Responses {
+		g.responses[response.ID] = response
+	}
+	return g
+}
+
+// Package returns the name of the generated package
+func (g *Generator) Package() string {
+	return g.pkg
+}
+
+// Generate returns the files of the package, formatted with gofmt: the
+// types in models.go, the client in client.go and the server interface and
+// router in server.go. Every named schema gets a type, whether or not an
+// operation uses it.
+func (g *Generator) Generate() ([]domain.GeneratedFile, error) {
+	for i := range g.api.Schemas {
+		g.namedType(&g.api.Schemas[i])
+	}
+
+	operationNames := make(codegen.Names)
+	for _, endpoint := range g.api.Endpoints {
+		if routerMethods[strings.ToUpper(endpoint.Method)] {
+			g.operations = append(g.operations, g.operation(endpoint, operationNames))
+		}
+	}
+
+	// The server checks request bodies with the patterns of models.go, so
+	// it is written first
+	g.writeClient()
+	g.writeServer()
+	g.writeModels()
+
+	var files []domain.GeneratedFile
+	for _, f := range []*file{g.models, g.client, g.server} {
+		source, err := g.source(f)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, domain.GeneratedFile{Path: f.name, Content: source})
+	}
+	return files, nil
+}
+
+// writeModels writes the types of the schemas and of the operations'
+// parameters
+func (g *Generator) writeModels() {
+	f := g.models
+	fmt.Fprintf(&f.body, "// Package %s is a client and server for %s %s.\n", g.pkg, g.api.Metadata.Name, g.api.Metadata.Version)
+	if g.api.Metadata.Description != "" {
+		f.body.WriteString("//\n")
+		writeComment(&f.body, g.api.Metadata.Description, "")
+	}
+	fmt.Fprintf(&f.body, "package %s\n", g.pkg)
+
+	if len(g.patterns) > 0 {
+		f.body.WriteString("\n// Patterns the definition requires strings to match\nvar (\n")
+		for _, pattern := range codegen.SortedKeys(g.patterns) {
+			if name := g.patterns[pattern]; name != "" {
+				fmt.Fprintf(&f.body, "\t%s = regexp.MustCompile(%s)\n", name, codegen.Quote(pattern))
+			}
+		}
+		f.body.WriteString(")\n")
+	}
+
+	for _, name := range codegen.SortedKeys(g.decls) {
+		f.body.WriteString("\n" + g.decls[name])
+	}
+}
+
+// source returns the formatted source of a file, with its imports
+func (g *Generator) source(f *file) (string, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, header, g.api.Metadata.Name, g.api.Metadata.Version)
+
+	body := f.body.String()
+	if f != g.models {
+		fmt.Fprintf(&b, "package %s\n", g.pkg)
+	} else {
+		// The package comment comes after the header
+		packageLine := strings.Index(body, "package "+g.pkg+"\n") + len("package "+g.pkg+"\n")
+		b.WriteString(body[:packageLine])
+		body = body[packageLine:]
+	}
+
+	imports := make([]string, 0, len(f.imports))
+	for path := range f.imports {
+		imports = append(imports, path)
+	}
+	sort.Slice(imports, func(i, j int) bool {
+		// Standard library packages come first, in their own group
+		iStd, jStd := !strings.Contains(imports[i], "."), !strings.Contains(imports[j], ".")
+		if iStd != jStd {
+			return iStd
+		}
+		return imports[i] < imports[j]
+	})
+	if len(imports) > 0 {
+		b.WriteString("\nimport (\n")
+		for i, path := range imports {
+			if i > 0 && strings.Contains(path, ".") && !strings.Contains(imports[i-1], ".") {
+				b.WriteString("\n")
+			}
+			b.WriteString("\t" + codegen.Quote(path) + "\n")
+		}
+		b.WriteString(")\n")
+	}
+	b.WriteString(body)
+
+	source, err := format.Source([]byte(b.String()))
+	if err != nil {
+		return "", fmt.Errorf("generated %s does not compile: %w", f.name, err)
+	}
+	return string(source), nil
+}
@@ -0,0 +1,211 @@
+package golang
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/swagger-editor/backend/internal/core/codegen/codegentest"
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// chiStub declares the part of chi the generated server uses
+const chiStub = `package chi
+
+import "net/http"
+
+type Router interface {
+	http.Handler
+	MethodFunc(method, pattern string, handlerFn http.HandlerFunc)
+}
+
+type Mux struct{}
+
+func (*Mux) ServeHTTP(w http.ResponseWriter, r *http.Request)               {}
+func (*Mux) MethodFunc(method, pattern string, handlerFn http.HandlerFunc) {}
+
+func NewRouter() *Mux { return &Mux{} }
+
+func URLParam(r *http.Request, key string) string { return "" }
+`
+
+// typeCheck type-checks the generated files as one package, against a stub
+// of chi
+func typeCheck(t *testing.T, files []domain.GeneratedFile) *types.Package {
+	t.Helper()
+	fset := token.NewFileSet()
+	std := importer.ForCompiler(fset, "source", nil)
+
+	stub, err := parser.ParseFile(fset, "chi.go", chiStub, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chi, err := (&types.Config{Importer: std}).Check("github.com/go-chi/chi/v5", fset, []*ast.File{stub}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var parsed []*ast.File
+	for _, f := range files {
+		file, err := parser.ParseFile(fset, f.Path, f.Content, parser.ParseComments)
+		if err != nil {
+			t.Fatalf("%s does not parse: %v\n%s", f.Path, err, f.Content)
+		}
+		parsed = append(parsed, file)
+	}
+
+	config := &types.Config{Importer: importerFunc(func(path string) (*types.Package, error) {
+		if path == chi.Path() {
+			return chi, nil
+		}
+		return std.Import(path)
+	})}
+	pkg, err := config.Check("petstore", fset, parsed, nil)
+	if err != nil {
+		for _, f := range files {
+			t.Logf("%s:\n%s", f.Path, f.Content)
+		}
+		t.Fatalf("generated package does not type-check: %v", err)
+	}
+	return pkg
+}
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) {
+	return f(path)
+}
+
+func TestGenerateTypeChecks(t *testing.T) {
+	files, err := New(codegentest.PetStore(), "").Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg := typeCheck(t, files)
+	if pkg.Name() != "petstore" {
+		t.Errorf("package = %s, want petstore", pkg.Name())
+	}
+
+	qualifier := types.RelativeTo(pkg)
+	fieldType := func(typeName, field string) string {
+		t.Helper()
+		object := pkg.Scope().Lookup(typeName)
+		if object == nil {
+			t.Fatalf("type %s is missing", typeName)
+		}
+		fields := object.Type().Underlying().(*types.Struct)
+		for i := 0; i < fields.NumFields(); i++ {
+			if fields.Field(i).Name() == field {
+				return types.TypeString(fields.Field(i).Type(), qualifier)
+			}
+		}
+		t.Fatalf("field %s.%s is missing", typeName, field)
+		return ""
+	}
+	fields := []struct {
+		typeName, field, want string
+	}{
+		{"Pet", "ID", "*int64"},
+		{"Pet", "Name", "string"},
+		{"Pet", "Status", "*PetStatus"},
+		{"Pet", "Parent", "*Pet"},
+		{"Pet", "Owner", "*Owner"},
+		{"Pet", "Tags", "[]string"},
+		{"Pet", "Metadata", "map[string]interface{}"},
+		{"Pet", "Born", "*time.Time"},
+		{"ListPetsParams", "Limit", "*int"},
+		{"ListPetsParams", "Tags", "[]string"},
+		{"ListPetsParams", "Since", "*time.Time"},
+		{"ListPetsParams", "XTraceID", "string"},
+	}
+	for _, tt := range fields {
+		if got := fieldType(tt.typeName, tt.field); got != tt.want {
+			t.Errorf("%s.%s: %s, want %s", tt.typeName, tt.field, got, tt.want)
+		}
+	}
+
+	methodType := func(typeName, method string) string {
+		t.Helper()
+		typ := pkg.Scope().Lookup(typeName).Type()
+		if !types.IsInterface(typ) {
+			typ = types.NewPointer(typ)
+		}
+		object, _, _ := types.LookupFieldOrMethod(typ, true, pkg, method)
+		if object == nil {
+			t.Fatalf("method %s.%s is missing", typeName, method)
+		}
+		return types.TypeString(object.Type(), qualifier)
+	}
+	methods := []struct {
+		typeName, method, want string
+	}{
+		{"Client", "ListPets", "func(ctx context.Context, params ListPetsParams) ([]Pet, error)"},
+		{"Client", "GetPetsByPetID", "func(ctx context.Context, petID int64) (*Pet, error)"},
+		{"Client", "CreatePet", "func(ctx context.Context, body Pet) (*Pet, error)"},
+		{"Client", "DeletePet", "func(ctx context.Context, petID int64) error"},
+		{"ServerInterface", "CreatePet", "func(w net/http.ResponseWriter, r *net/http.Request, body Pet)"},
+		{"ServerInterface", "GetPetsByPetID", "func(w net/http.ResponseWriter, r *net/http.Request, petID int64)"},
+		{"Owner", "AsPerson", "func() (Person, error)"},
+		{"Owner", "FromShelter", "func(v Shelter) error"},
+		{"Pet", "Validate", "func() error"},
+	}
+	for _, tt := range methods {
+		if got := methodType(tt.typeName, tt.method); got != tt.want {
+			t.Errorf("%s.%s: %s, want %s", tt.typeName, tt.method, got, tt.want)
+		}
+	}
+
+	unimplemented := types.NewPointer(pkg.Scope().Lookup("Unimplemented").Type())
+	server := pkg.Scope().Lookup("ServerInterface").Type().Underlying().(*types.Interface)
+	if !types.Implements(unimplemented, server) {
+		t.Error("Unimplemented does not implement ServerInterface")
+	}
+}
+
+func TestGenerateValidatesConstraints(t *testing.T) {
+	files, err := New(codegentest.PetStore(), "pets").Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sources := make(map[string]string)
+	for _, f := range files {
+		sources[f.Path] = f.Content
+	}
+
+	for file, checks := range map[string][]string{
+		"models.go": {
+			"pattern1 = regexp.MustCompile(\"^[A-Za-z ]+$\")",
+			"if utf8.RuneCountInString(p.Name) < 1 {",
+			"if !pattern1.MatchString(p.Name) {",
+			"if len(p.Tags) > 5 {",
+			"if p.Owner == nil {",
+			"errs = append(errs, fmt.Errorf(\"owner: is required\"))",
+			"case PetStatusAvailable, PetStatusSold, PetStatusOnHold:",
+		},
+		"client.go": {
+			"const DefaultBaseURL = \"https://pets.example.com/v1\"",
+			"path := \"/pets/\" + url.PathEscape(fmt.Sprint(petID))",
+			"header.Set(\"X-Trace-Id\", params.XTraceID)",
+			"query.Add(\"since\", (*params.Since).Format(time.RFC3339))",
+		},
+		"server.go": {
+			"r.MethodFunc(\"GET\", \"/pets/{petId}\", s.GetPetsByPetID)",
+			"http.Error(w, \"missing header parameter X-Trace-Id\", http.StatusBadRequest)",
+			"for _, raw := range query[\"tags\"] {",
+			"if err := body.Validate(); err != nil {",
+		},
+	} {
+		for _, check := range checks {
+			if !strings.Contains(sources[file], check) {
+				t.Errorf("%s does not contain %s:\n%s", file, check, sources[file])
+			}
+		}
+	}
+	if !strings.HasPrefix(sources["models.go"], "// Code generated by swagger-editor from Pet Store 1.0.0. DO NOT EDIT.\n\n// Package pets") {
+		t.Errorf("models.go does not start with the generated code header and package comment")
+	}
+}
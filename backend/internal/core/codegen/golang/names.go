@@ -0,0 +1,103 @@
+package golang
+
+import (
+	"go/token"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/codegen"
+)
+
+// initialisms are the words Go writes in one case, as in UserID
+var initialisms = map[string]bool{
+	"API": true, "ASCII": true, "CPU": true, "CSS": true, "DNS": true, "EOF": true, "GUID": true,
+	"HTML": true, "HTTP": true, "HTTPS": true, "ID": true, "IP": true, "JSON": true, "JWT": true,
+	"OS": true, "SQL": true, "SSH": true, "TCP": true, "TLS": true, "TTL": true, "UI": true,
+	"UID": true, "URI": true, "URL": true, "UTF8": true, "UUID": true, "XML": true,
+}
+
+// localNames are the names the generated functions use for their own
+// variables, which arguments may not take
+var localNames = map[string]bool{
+	"body": true, "c": true, "ctx": true, "err": true, "errs": true, "header": true,
+	"item": true, "params": true, "parsed": true, "path": true, "payload": true, "query": true,
+	"r": true, "raw": true, "raws": true, "result": true, "s": true, "value": true, "w": true,
+}
+
+// exported returns the exported Go identifier for a name, such as PetID for
+// pet_id. A name without letters becomes X, and one starting with a digit
+// gets an X in front.
+func exported(name string) string {
+	return joinWords(codegen.Words(name))
+}
+
+func joinWords(words []string) string {
+	var b strings.Builder
+	for _, word := range words {
+		upper := strings.ToUpper(word)
+		if initialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		b.WriteString(upper[:1] + strings.ToLower(word[1:]))
+	}
+
+	identifier := b.String()
+	if identifier == "" || identifier[0] < 'A' || identifier[0] > 'Z' {
+		identifier = "X" + identifier
+	}
+	return identifier
+}
+
+// unexported returns the unexported Go identifier for a name, such as petID
+// for pet_id, avoiding keywords and the generated code's own variables
+func unexported(name string) string {
+	words := codegen.Words(name)
+	if len(words) == 0 {
+		return "value"
+	}
+
+	first := strings.ToLower(words[0])
+	identifier := first + strings.TrimPrefix(joinWords(words), joinWords(words[:1]))
+	if first[0] >= '0' && first[0] <= '9' {
+		identifier = "x" + joinWords(words)
+	}
+	if token.IsKeyword(identifier) || localNames[identifier] {
+		identifier += "Param"
+	}
+	return identifier
+}
+
+// packageName returns a package name for a name: its letters and digits in
+// lower case
+func packageName(name string) string {
+	var b strings.Builder
+	for _, word := range codegen.Words(name) {
+		b.WriteString(strings.ToLower(word))
+	}
+
+	pkg := b.String()
+	if pkg == "" || pkg[0] < 'a' || pkg[0] > 'z' || token.IsKeyword(pkg) {
+		pkg = "api" + pkg
+	}
+	return pkg
+}
+
+// escapePercent escapes text for use in a format string
+func escapePercent(text string) string {
+	return strings.ReplaceAll(text, "%", "%%")
+}
+
+// writeComment writes text as a comment, a paragraph per blank line
+func writeComment(b *strings.Builder, text, indent string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimRight(line, " \t\r"); line == "" {
+			b.WriteString(indent + "//\n")
+		} else {
+			b.WriteString(indent + "// " + line + "\n")
+		}
+	}
+}
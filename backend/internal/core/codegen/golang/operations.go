@@ -0,0 +1,211 @@
+package golang
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/codegen"
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// pathParam matches the parameters in a path template
+var pathParam = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// operation is an endpoint as the client and server see it
+type operation struct {
+	name        string
+	method      string
+	path        string
+	description string
+	deprecated  bool
+
+	// pathParams are in the order of the path, and become arguments
+	pathParams []param
+	// params are the query, header and cookie parameters, which become
+	// the fields of paramsType
+	params     []param
+	paramsType string
+
+	body *requestBody
+	// result is the type of the success response's JSON body; raw is set
+	// when its body is not JSON
+	result *goType
+	raw    bool
+}
+
+// param is a parameter of an operation. Parameters that are neither
+// scalars nor lists of scalars are passed as strings.
+type param struct {
+	name        string
+	in          string
+	description string
+	arg         string
+	field       string
+	t           goType
+	required    bool
+}
+
+// requestBody is the body of an operation. A body that is not JSON has no
+// type and is passed as an io.Reader.
+type requestBody struct {
+	mediaType string
+	t         *goType
+	object    map[string]interface{}
+	required  bool
+}
+
+// operation builds the operation of an endpoint, declaring the types of its
+// parameters, body and result
+func (g *Generator) operation(endpoint domain.Endpoint, operationNames codegen.Names) operation {
+	name := operationNames.Unique(joinWords(codegen.OperationWords(endpoint)))
+	op := operation{
+		name:        name,
+		method:      strings.ToUpper(endpoint.Method),
+		path:        endpoint.Path,
+		description: codegen.Describe(endpoint.Summary, endpoint.Description),
+		deprecated:  endpoint.Deprecated,
+	}
+
+	args := make(codegen.Names)
+	fields := make(codegen.Names)
+	declared := make(map[string]domain.Parameter)
+	for _, id := range endpoint.Parameters {
+		p, ok := g.parameters[id]
+		if !ok {
+			continue
+		}
+		switch p.In {
+		case "path":
+			declared[p.Name] = p
+		case "query", "header", "cookie":
+			op.params = append(op.params, param{
+				name:        p.Name,
+				in:          p.In,
+				description: p.Description,
+				field:       fields.Unique(exported(p.Name)),
+				t:           g.paramType(p, name+exported(p.Name)),
+				required:    p.Required,
+			})
+		}
+	}
+
+	// The path decides the path parameters, declared or not
+	for _, match := range pathParam.FindAllStringSubmatch(endpoint.Path, -1) {
+		p, ok := declared[match[1]]
+		t := goType{expr: "string", base: "string"}
+		if ok {
+			t = g.paramType(p, name+exported(p.Name))
+		}
+		op.pathParams = append(op.pathParams, param{
+			name:        match[1],
+			in:          "path",
+			description: p.Description,
+			arg:         args.Unique(unexported(match[1])),
+			t:           t,
+			required:    true,
+		})
+	}
+
+	if len(op.params) > 0 {
+		op.paramsType = g.idents.Unique(name + "Params")
+		g.declareParams(op)
+	}
+
+	if body, ok := g.bodies[endpoint.RequestBody]; ok && endpoint.RequestBody != "" {
+		if mediaType, media, ok := codegen.PickMedia(body.Content); ok {
+			op.body = &requestBody{mediaType: mediaType, required: body.Required}
+			if codegen.IsJSON(mediaType) {
+				t := anyType
+				if media.Schema != nil {
+					t = g.typeRef(media.Schema, name+"Request")
+					op.body.object = g.schemas.Object(media.Schema)
+				}
+				op.body.t = &t
+			}
+		}
+	}
+
+	if id, ok := codegen.SuccessResponse(endpoint.Responses); ok {
+		if mediaType, media, ok := codegen.PickMedia(g.responses[id].Content); ok {
+			if codegen.IsJSON(mediaType) {
+				t := anyType
+				if media.Schema != nil {
+					t = g.typeRef(media.Schema, name+"Response")
+				}
+				op.result = &t
+			} else {
+				op.raw = true
+			}
+		}
+	}
+	return op
+}
+
+// paramType returns the type of a parameter: the type of its schema when
+// that is a scalar, an enum or a list of them, and string otherwise
+func (g *Generator) paramType(p domain.Parameter, hint string) goType {
+	stringType := goType{expr: "string", base: "string"}
+	if p.Schema == nil {
+		return stringType
+	}
+
+	object := g.schemas.Object(p.Schema)
+	switch g.kind(object) {
+	case kindScalar, kindEnum:
+		return g.typeRef(p.Schema, hint)
+	case kindList:
+		switch g.kind(g.schemas.Object(object["items"])) {
+		case kindScalar, kindEnum:
+			if t := g.typeRef(p.Schema, hint); t.elem != nil && t.elem.base != "" && !strings.HasPrefix(t.elem.expr, "*") {
+				return t
+			}
+		}
+	}
+	return stringType
+}
+
+// declareParams declares the struct of the query, header and cookie
+// parameters of an operation. Optional parameters are pointers, or nil
+// slices.
+func (g *Generator) declareParams(op operation) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "// %s holds the query, header and cookie parameters of %s\n", op.paramsType, op.name)
+	fmt.Fprintf(&b, "type %s struct {\n", op.paramsType)
+	for _, p := range op.params {
+		writeComment(&b, p.description, "\t")
+		fmt.Fprintf(&b, "\t%s %s\n", p.field, p.fieldType())
+		g.models.useType(p.t.expr)
+	}
+	b.WriteString("}\n")
+	g.decls[op.paramsType] = b.String()
+}
+
+// pointer reports whether a parameter's field is a pointer
+func (p param) pointer() bool {
+	return !p.required && !p.t.nilable
+}
+
+func (p param) fieldType() string {
+	if p.pointer() {
+		return "*" + p.t.expr
+	}
+	return p.t.expr
+}
+
+// bodyType returns the type a body is passed as: its own type when it is
+// required or can be nil, and a pointer to it otherwise
+func (b *requestBody) bodyType() string {
+	switch {
+	case b.t == nil:
+		return "io.Reader"
+	case b.required || b.t.nilable:
+		return b.t.expr
+	}
+	return "*" + b.t.expr
+}
+
+// optional reports whether a body is passed as a pointer
+func (b *requestBody) optional() bool {
+	return b.t != nil && !b.required && !b.t.nilable
+}
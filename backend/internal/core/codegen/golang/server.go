@@ -0,0 +1,239 @@
+package golang
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/codegen"
+)
+
+// writeServer writes the server interface, a stub that implements it and
+// the chi router that serves it
+func (g *Generator) writeServer() {
+	f := g.server
+	b := &f.body
+	g.out = g.server
+	defer func() { g.out = g.models }()
+	f.use("net/http")
+	f.use("github.com/go-chi/chi/v5")
+
+	b.WriteString("\n// ServerInterface serves the operations of the API. Each method gets the\n")
+	b.WriteString("// parameters and JSON body of its request parsed and validated, and writes\n// the response itself.\n")
+	b.WriteString("type ServerInterface interface {\n")
+	for i, op := range g.operations {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(b, "\t// %s serves %s %s\n", op.name, op.method, op.path)
+		fmt.Fprintf(b, "\t%s(%s)\n", op.name, g.serverArgs(op))
+	}
+	b.WriteString("}\n")
+
+	b.WriteString("\n// Unimplemented answers every operation with 501 Not Implemented. Embed it\n")
+	b.WriteString("// in a server to implement the operations one at a time.\ntype Unimplemented struct{}\n")
+	for _, op := range g.operations {
+		fmt.Fprintf(b, "\n// %s answers 501 Not Implemented\nfunc (Unimplemented) %s(%s) {\n", op.name, op.name, g.serverArgs(op))
+		b.WriteString("\thttp.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)\n}\n")
+	}
+
+	b.WriteString("\n// Handler returns a chi router that serves the operations of si\n")
+	b.WriteString("func Handler(si ServerInterface) http.Handler {\n\tr := chi.NewRouter()\n\tRegisterHandlers(r, si)\n\treturn r\n}\n")
+	b.WriteString("\n// RegisterHandlers adds a route for every operation of si to r\nfunc RegisterHandlers(r chi.Router, si ServerInterface) {\n")
+	if len(g.operations) > 0 {
+		b.WriteString("\ts := serverWrapper{handler: si}\n")
+	}
+	for _, op := range g.operations {
+		fmt.Fprintf(b, "\tr.MethodFunc(%s, %s, s.%s)\n", codegen.Quote(op.method), codegen.Quote(op.path), op.name)
+	}
+	b.WriteString("}\n")
+
+	b.WriteString("\n// serverWrapper parses the requests of a ServerInterface\ntype serverWrapper struct {\n\thandler ServerInterface\n}\n")
+	for _, op := range g.operations {
+		g.writeServerMethod(op)
+	}
+
+	if g.usesCookies() {
+		b.WriteString(`
+// cookieValue returns the value of a cookie, or nothing when it is not set
+func cookieValue(r *http.Request, name string) string {
+	cookie, err := r.Cookie(name)
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
+`)
+	}
+}
+
+// serverArgs returns the parameters of the server method of an operation
+func (g *Generator) serverArgs(op operation) string {
+	args := []string{"w http.ResponseWriter", "r *http.Request"}
+	for _, p := range op.pathParams {
+		args = append(args, p.arg+" "+p.t.expr)
+		g.server.useType(p.t.expr)
+	}
+	if op.paramsType != "" {
+		args = append(args, "params "+op.paramsType)
+	}
+	if op.body != nil && op.body.t != nil {
+		args = append(args, "body "+op.body.bodyType())
+		g.server.useType(op.body.bodyType())
+	}
+	return strings.Join(args, ", ")
+}
+
+// writeServerMethod writes the handler that parses the parameters and body
+// of an operation's request, answering 400 Bad Request when they are
+// missing or invalid, and calls the server
+func (g *Generator) writeServerMethod(op operation) {
+	f := g.server
+	b := &f.body
+
+	fmt.Fprintf(b, "\nfunc (s serverWrapper) %s(w http.ResponseWriter, r *http.Request) {\n", op.name)
+	call := []string{"w", "r"}
+
+	for _, p := range op.pathParams {
+		fmt.Fprintf(b, "\tvar %s %s\n", p.arg, p.t.expr)
+		g.writeParse(b, p, fmt.Sprintf("chi.URLParam(r, %s)", codegen.Quote(p.name)), p.arg)
+		call = append(call, p.arg)
+	}
+
+	if op.paramsType != "" {
+		fmt.Fprintf(b, "\tvar params %s\n", op.paramsType)
+		for _, p := range op.params {
+			if p.in == "query" {
+				b.WriteString("\tquery := r.URL.Query()\n")
+				break
+			}
+		}
+		for _, p := range op.params {
+			var raw string
+			switch p.in {
+			case "query":
+				raw = fmt.Sprintf("query.Get(%s)", codegen.Quote(p.name))
+			case "header":
+				raw = fmt.Sprintf("r.Header.Get(%s)", codegen.Quote(p.name))
+			default:
+				raw = fmt.Sprintf("cookieValue(r, %s)", codegen.Quote(p.name))
+			}
+			g.writeParse(b, p, raw, "params."+p.field)
+		}
+		call = append(call, "params")
+	}
+
+	if op.body != nil && op.body.t != nil {
+		f.use("encoding/json")
+		f.use("fmt")
+		fmt.Fprintf(b, "\tvar body %s\n", op.body.bodyType())
+		if op.body.required {
+			b.WriteString("\tif err := json.NewDecoder(r.Body).Decode(&body); err != nil {\n")
+		} else {
+			// An empty body leaves an optional one nil
+			f.use("io")
+			b.WriteString("\tif err := json.NewDecoder(r.Body).Decode(&body); err != nil && err != io.EOF {\n")
+		}
+		b.WriteString("\t\thttp.Error(w, fmt.Sprintf(\"invalid request body: %v\", err), http.StatusBadRequest)\n\t\treturn\n\t}\n")
+
+		var checks strings.Builder
+		g.fieldChecks(&checks, "body", op.body.optional(), op.body.required, op.body.object, *op.body.t, path{format: "body"})
+		if checks.Len() > 0 {
+			f.use("errors")
+			fmt.Fprintf(b, "\tvar errs []error\n%s\tif err := errors.Join(errs...); err != nil {\n", checks.String())
+			b.WriteString("\t\thttp.Error(w, err.Error(), http.StatusBadRequest)\n\t\treturn\n\t}\n")
+		}
+		call = append(call, "body")
+	}
+
+	fmt.Fprintf(b, "\ts.handler.%s(%s)\n}\n", op.name, strings.Join(call, ", "))
+}
+
+// writeParse writes the code that parses a parameter from the text raw
+// evaluates to and stores it in target. Lists are sent as one query
+// parameter per item and as comma-separated items elsewhere.
+func (g *Generator) writeParse(b *strings.Builder, p param, raw, target string) {
+	label := p.in + " parameter " + p.name
+	fmt.Fprintf(b, "\tif raw := %s; raw != \"\" {\n", raw)
+
+	if p.t.elem != nil {
+		raws := "strings.Split(raw, \",\")"
+		if p.in == "query" {
+			raws = fmt.Sprintf("query[%s]", codegen.Quote(p.name))
+		} else {
+			g.server.use("strings")
+		}
+		fmt.Fprintf(b, "\t\tfor _, raw := range %s {\n", raws)
+		g.writeConvert(b, *p.t.elem, label, "\t\t\t")
+		fmt.Fprintf(b, "\t\t\t%s = append(%s, value)\n\t\t}\n", target, target)
+	} else {
+		g.writeConvert(b, p.t, label, "\t\t")
+		if p.pointer() {
+			fmt.Fprintf(b, "\t\t%s = &value\n", target)
+		} else {
+			fmt.Fprintf(b, "\t\t%s = value\n", target)
+		}
+	}
+
+	if p.required {
+		g.server.use("fmt")
+		b.WriteString("\t} else {\n")
+		fmt.Fprintf(b, "\t\thttp.Error(w, %s, http.StatusBadRequest)\n\t\treturn\n", codegen.Quote("missing "+label))
+	}
+	b.WriteString("\t}\n")
+}
+
+// writeConvert writes the code that converts raw to value, a scalar of
+// type t, answering 400 Bad Request when it does not parse or, for an enum,
+// is not one of its values
+func (g *Generator) writeConvert(b *strings.Builder, t goType, label, indent string) {
+	f := g.server
+	fail := func() {
+		f.use("fmt")
+		fmt.Fprintf(b, "%sif err != nil {\n%s\thttp.Error(w, fmt.Sprintf(%s, err), http.StatusBadRequest)\n%s\treturn\n%s}\n",
+			indent, indent, codegen.Quote("invalid "+escapePercent(label)+": %v"), indent, indent)
+	}
+
+	switch t.base {
+	case "string":
+		if t.expr == "string" {
+			fmt.Fprintf(b, "%svalue := raw\n", indent)
+		} else {
+			fmt.Fprintf(b, "%svalue := %s(raw)\n", indent, t.expr)
+		}
+		if t.named {
+			fmt.Fprintf(b, "%serr := value.Validate()\n", indent)
+			fail()
+		}
+		return
+	case "int", "int32", "int64":
+		f.use("strconv")
+		bits := strings.TrimPrefix(t.base, "int")
+		if bits == "" {
+			bits = "0"
+		}
+		fmt.Fprintf(b, "%sparsed, err := strconv.ParseInt(raw, 10, %s)\n", indent, bits)
+	case "float32", "float64":
+		f.use("strconv")
+		fmt.Fprintf(b, "%sparsed, err := strconv.ParseFloat(raw, %s)\n", indent, strings.TrimPrefix(t.base, "float"))
+	case "bool":
+		f.use("strconv")
+		fmt.Fprintf(b, "%sparsed, err := strconv.ParseBool(raw)\n", indent)
+	case "time.Time":
+		f.use("time")
+		fmt.Fprintf(b, "%sparsed, err := time.Parse(time.RFC3339, raw)\n", indent)
+	}
+	fail()
+	fmt.Fprintf(b, "%svalue := %s(parsed)\n", indent, t.expr)
+}
+
+// usesCookies reports whether any operation has a cookie parameter
+func (g *Generator) usesCookies() bool {
+	for _, op := range g.operations {
+		for _, p := range op.params {
+			if p.in == "cookie" {
+				return true
+			}
+		}
+	}
+	return false
+}
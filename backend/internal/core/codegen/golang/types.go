@@ -0,0 +1,651 @@
+package golang
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/codegen"
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// Kinds of Go type a schema maps to
+const (
+	kindStruct = "struct"
+	kindUnion  = "union"
+	kindEnum   = "enum"
+	kindList   = "list"
+	kindMap    = "map"
+	kindScalar = "scalar"
+	kindAny    = "any"
+)
+
+// goType is the Go type a schema maps to
+type goType struct {
+	// expr is the type as written, such as []Pet
+	expr string
+	// named is set for the types the package declares with a Validate
+	// method: structs, unions, enums and named lists and maps
+	named bool
+	// base is the predeclared type, or time.Time, of a scalar
+	base string
+	// elem is the item type of a slice or the value type of a map
+	elem *goType
+	// isMap tells a map from a slice
+	isMap bool
+	// nilable is set for slices, maps and interface{}, which need no
+	// pointer to be left out
+	nilable bool
+	// pointer is set for the structs fields hold by pointer, so that
+	// recursive schemas have a Go type and a missing object can be told
+	pointer bool
+}
+
+var anyType = goType{expr: "interface{}", nilable: true}
+
+// typeRef returns the Go type for a schema, declaring the types it needs.
+// hint names the types declared for inline schemas.
+func (g *Generator) typeRef(schema interface{}, hint string) goType {
+	g.depth++
+	defer func() { g.depth-- }()
+	if g.depth > 4*codegen.MaxDepth {
+		return anyType
+	}
+
+	object, named := g.schemas.Resolve(schema)
+	if named != nil {
+		return g.namedType(named)
+	}
+	if object == nil {
+		return anyType
+	}
+
+	// allOf with a single member, and nothing else, often only adds a
+	// description to a reference
+	if members, ok := object["allOf"].([]interface{}); ok && len(members) == 1 && object["properties"] == nil {
+		return g.typeRef(members[0], hint)
+	}
+	return g.inlineType(object, hint)
+}
+
+// namedType returns the type declared for a named schema, declaring it on
+// first use
+func (g *Generator) namedType(schema *domain.Schema) goType {
+	if t, ok := g.made[schema.ID]; ok {
+		return t
+	}
+	object := g.schemas.Map(schema)
+	name := g.schemaNames[schema.ID]
+
+	kind := g.kind(object)
+	switch kind {
+	case kindScalar:
+		t := g.scalarType(object)
+		g.made[schema.ID] = goType{expr: name, base: t.base}
+		g.declareAlias(name, object, t.expr)
+	case kindAny:
+		g.made[schema.ID] = goType{expr: name, nilable: true}
+		g.declareAlias(name, object, anyType.expr)
+	default:
+		// The type is recorded before it is declared, so that the schemas
+		// it refers to can refer back to it
+		g.made[schema.ID] = g.declaredType(name, kind)
+		g.declare(name, name, object, kind)
+	}
+	return g.made[schema.ID]
+}
+
+// inlineType returns the type for a schema declared in place, declaring a
+// type named after hint when it needs one
+func (g *Generator) inlineType(object map[string]interface{}, hint string) goType {
+	switch kind := g.kind(object); kind {
+	case kindEnum:
+		// Enums with the same values in the same place are one type
+		key := hint + "\x00" + strings.Join(codegen.EnumValues(object), "\x00")
+		if t, ok := g.enums[key]; ok {
+			return t
+		}
+		name := g.idents.Unique(hint)
+		g.enums[key] = g.declaredType(name, kind)
+		g.declare(name, hint, object, kind)
+		return g.enums[key]
+	case kindStruct, kindUnion:
+		name := g.idents.Unique(hint)
+		g.declare(name, hint, object, kind)
+		return g.declaredType(name, kind)
+	case kindList:
+		elem := g.itemType(object, hint)
+		return goType{expr: "[]" + elem.expr, elem: &elem, nilable: true}
+	case kindMap:
+		elem := g.valueType(object, hint)
+		return goType{expr: "map[string]" + elem.expr, elem: &elem, isMap: true, nilable: true}
+	case kindScalar:
+		return g.scalarType(object)
+	}
+	return anyType
+}
+
+func (g *Generator) declaredType(name, kind string) goType {
+	t := goType{expr: name, named: true}
+	switch kind {
+	case kindStruct, kindUnion:
+		t.pointer = true
+	case kindList, kindMap:
+		t.nilable = true
+	case kindEnum:
+		t.base = "string"
+	}
+	return t
+}
+
+// itemType returns the type of the items of an array schema. Items that
+// may be null are held by pointer.
+func (g *Generator) itemType(object map[string]interface{}, hint string) goType {
+	items, ok := object["items"]
+	if !ok {
+		return anyType
+	}
+	t := g.typeRef(items, hint+"Item")
+	if resolved := g.schemas.Object(items); resolved != nil && codegen.Nullable(resolved) && !t.nilable {
+		t.expr = "*" + t.expr
+		t.pointer = true
+	}
+	return t
+}
+
+// valueType returns the type of the values of a map schema
+func (g *Generator) valueType(object map[string]interface{}, hint string) goType {
+	switch values := object["additionalProperties"].(type) {
+	case map[string]interface{}, string:
+		if len(g.schemas.Object(values)) > 0 {
+			return g.typeRef(values, hint+"Value")
+		}
+	}
+	return anyType
+}
+
+// kind decides what Go type a schema maps to. oneOf and anyOf are unions
+// when every branch is an object and interface{} otherwise; objects without
+// properties are maps.
+func (g *Generator) kind(object map[string]interface{}) string {
+	if len(codegen.EnumValues(object)) > 0 {
+		return kindEnum
+	}
+	if branches := codegen.Branches(object); len(branches) > 0 {
+		for _, branch := range branches {
+			resolved := g.schemas.Object(branch)
+			if resolved == nil || g.kind(resolved) != kindStruct {
+				return kindAny
+			}
+		}
+		return kindUnion
+	}
+
+	switch codegen.SchemaType(object) {
+	case "object":
+		if properties, _ := g.schemas.Properties(object); len(properties) > 0 {
+			return kindStruct
+		}
+		return kindMap
+	case "array":
+		return kindList
+	case "string", "integer", "number", "boolean":
+		return kindScalar
+	}
+	return kindAny
+}
+
+// scalarType returns the predeclared type for a primitive schema, or
+// time.Time for a date-time string
+func (g *Generator) scalarType(object map[string]interface{}) goType {
+	base := "string"
+	switch codegen.SchemaType(object) {
+	case "integer":
+		base = "int"
+		switch object["format"] {
+		case "int32":
+			base = "int32"
+		case "int64":
+			base = "int64"
+		}
+	case "number":
+		base = "float64"
+		if object["format"] == "float" {
+			base = "float32"
+		}
+	case "boolean":
+		base = "bool"
+	case "string":
+		if object["format"] == "date-time" {
+			base = "time.Time"
+		}
+	}
+	return goType{expr: base, base: base}
+}
+
+// declareAlias declares a name for a scalar or free-form schema
+func (g *Generator) declareAlias(name string, object map[string]interface{}, expr string) {
+	var b strings.Builder
+	writeTypeComment(&b, name, object)
+	fmt.Fprintf(&b, "type %s = %s\n", name, expr)
+	g.models.useType(expr)
+	g.decls[name] = b.String()
+}
+
+// declare writes the declaration of a struct, union, enum, slice or map
+// type with its Validate method
+func (g *Generator) declare(name, hint string, object map[string]interface{}, kind string) {
+	var b strings.Builder
+	writeTypeComment(&b, name, object)
+
+	switch kind {
+	case kindStruct:
+		g.declareStruct(&b, name, hint, object)
+	case kindUnion:
+		g.declareUnion(&b, name, hint, object)
+	case kindEnum:
+		g.declareEnum(&b, name, object)
+	default:
+		var t goType
+		if kind == kindList {
+			elem := g.itemType(object, hint)
+			t = goType{expr: "[]" + elem.expr, elem: &elem}
+		} else {
+			elem := g.valueType(object, hint)
+			t = goType{expr: "map[string]" + elem.expr, elem: &elem, isMap: true}
+		}
+		fmt.Fprintf(&b, "type %s %s\n", name, t.expr)
+		g.models.useType(t.expr)
+
+		recv := receiver(name)
+		var checks strings.Builder
+		g.checks(&checks, recv, false, object, t, path{}, 1)
+		g.writeValidate(&b, name, recv, checks.String())
+	}
+
+	g.decls[name] = b.String()
+}
+
+// declareStruct writes a struct with a field for each property. Required
+// properties are held by value and optional ones by pointer or as nil
+// slices and maps; readOnly and writeOnly properties are optional, as only
+// one direction carries them.
+func (g *Generator) declareStruct(b *strings.Builder, name, hint string, object map[string]interface{}) {
+	properties, required := g.schemas.Properties(object)
+	fields := codegen.Names{"Validate": true}
+	recv := receiver(name)
+	var checks strings.Builder
+
+	b.WriteString("type " + name + " struct {\n")
+	for _, property := range codegen.SortedKeys(properties) {
+		schema := properties[property]
+		resolved := g.schemas.Object(schema)
+		if resolved == nil {
+			resolved = map[string]interface{}{}
+		}
+
+		t := g.typeRef(schema, hint+exported(property))
+		oneWay := schema["readOnly"] == true || schema["writeOnly"] == true || resolved["readOnly"] == true || resolved["writeOnly"] == true
+		isRequired := required[property] && !oneWay
+		pointer := !t.nilable && (t.pointer || !isRequired || codegen.Nullable(resolved))
+
+		field := fields.Unique(exported(property))
+		description, _ := schema["description"].(string)
+		writeComment(b, description, "\t")
+		if schema["deprecated"] == true || resolved["deprecated"] == true {
+			if description != "" {
+				b.WriteString("\t//\n")
+			}
+			b.WriteString("\t// Deprecated: the definition marks this property deprecated.\n")
+		}
+
+		expr := t.expr
+		if pointer {
+			expr = "*" + expr
+		}
+		tag := property
+		if !isRequired {
+			tag += ",omitempty"
+		}
+		fmt.Fprintf(b, "\t%s %s %s\n", field, expr, structTag("json:"+codegen.Quote(tag)))
+		g.models.useType(expr)
+
+		g.fieldChecks(&checks, recv+"."+field, pointer, isRequired, resolved, t, path{format: escapePercent(property)})
+	}
+	b.WriteString("}\n")
+
+	g.writeValidate(b, name, recv, checks.String())
+}
+
+// declareUnion writes a type that holds the raw JSON of one of the objects
+// of a oneOf or anyOf, with methods to read and write each of them
+func (g *Generator) declareUnion(b *strings.Builder, name, hint string, object map[string]interface{}) {
+	var members []goType
+	seen := make(map[string]bool)
+	for i, branch := range codegen.Branches(object) {
+		member := g.typeRef(branch, hint+"Option"+string(rune('A'+i%26)))
+		if !seen[member.expr] {
+			seen[member.expr] = true
+			members = append(members, member)
+		}
+	}
+	g.models.use("encoding/json")
+	g.models.use("errors")
+	g.models.use("fmt")
+
+	fmt.Fprintf(b, "type %s struct {\n\tjson.RawMessage\n}\n", name)
+	for _, member := range members {
+		fmt.Fprintf(b, `
+// As%[2]s decodes the value as a %[2]s
+func (u %[1]s) As%[2]s() (%[2]s, error) {
+	var v %[2]s
+	err := json.Unmarshal(u.RawMessage, &v)
+	return v, err
+}
+
+// From%[2]s sets the value to a %[2]s
+func (u *%[1]s) From%[2]s(v %[2]s) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	u.RawMessage = data
+	return nil
+}
+`, name, member.expr)
+	}
+
+	fmt.Fprintf(b, "\n// Validate checks that the value is one of the types %s may hold and\n// meets its constraints\n", name)
+	fmt.Fprintf(b, "func (u %s) Validate() error {\n\tif len(u.RawMessage) == 0 {\n\t\treturn nil\n\t}\n\n\tvar errs []error\n", name)
+	for _, member := range members {
+		fmt.Fprintf(b, `	if v, err := u.As%[1]s(); err != nil {
+		errs = append(errs, fmt.Errorf("as %[1]s: %%w", err))
+	} else if err := v.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("as %[1]s: %%w", err))
+	} else {
+		return nil
+	}
+`, member.expr)
+	}
+	b.WriteString("\treturn errors.Join(errs...)\n}\n")
+}
+
+// declareEnum writes a string type with a constant for each value
+func (g *Generator) declareEnum(b *strings.Builder, name string, object map[string]interface{}) {
+	var values, constants []string
+	seen := make(map[string]bool)
+	for _, value := range codegen.EnumValues(object) {
+		if !seen[value] {
+			seen[value] = true
+			values = append(values, value)
+			constants = append(constants, g.idents.Unique(name+exported(value)))
+		}
+	}
+	g.models.use("fmt")
+
+	fmt.Fprintf(b, "type %s string\n\n// The values of %s\nconst (\n", name, name)
+	for i, value := range values {
+		fmt.Fprintf(b, "\t%s %s = %s\n", constants[i], name, codegen.Quote(value))
+	}
+	b.WriteString(")\n")
+
+	fmt.Fprintf(b, "\n// Valid reports whether the value is one the definition allows\nfunc (e %s) Valid() bool {\n\tswitch e {\n\tcase %s:\n\t\treturn true\n\t}\n\treturn false\n}\n",
+		name, strings.Join(constants, ", "))
+	fmt.Fprintf(b, "\n// Validate checks that the value is one the definition allows\nfunc (e %s) Validate() error {\n\tif !e.Valid() {\n\t\treturn fmt.Errorf(%s, string(e))\n\t}\n\treturn nil\n}\n",
+		name, codegen.Quote("%q is not one of "+escapePercent(strings.Join(values, ", "))))
+}
+
+// writeValidate writes the Validate method of a type from its checks
+func (g *Generator) writeValidate(b *strings.Builder, name, recv, checks string) {
+	fmt.Fprintf(b, "\n// Validate checks the constraints the definition puts on %s\n", name)
+	if checks == "" {
+		fmt.Fprintf(b, "func (%s) Validate() error {\n\treturn nil\n}\n", name)
+		return
+	}
+	g.out.use("errors")
+	fmt.Fprintf(b, "func (%s %s) Validate() error {\n\tvar errs []error\n%s\treturn errors.Join(errs...)\n}\n", recv, name, checks)
+}
+
+// path is where a check is made, as a format string and its arguments, such
+// as tags[%d] and i
+type path struct {
+	format string
+	args   []string
+}
+
+func (p path) with(format string, arg string) path {
+	return path{format: p.format + format, args: append(append([]string(nil), p.args...), arg)}
+}
+
+// fail writes the statement that records a failed check
+func (g *Generator) fail(b *strings.Builder, indent string, p path, message string, args ...string) {
+	g.out.use("fmt")
+	text := message
+	if p.format != "" {
+		text = p.format + ": " + message
+	}
+	fmt.Fprintf(b, "%serrs = append(errs, fmt.Errorf(%s))\n", indent, strings.Join(append([]string{codegen.Quote(text)}, append(p.args, args...)...), ", "))
+}
+
+// fieldChecks writes the checks of a struct field: a required field that
+// can be nil must be set, and the value of one that is set must meet the
+// constraints of its schema
+func (g *Generator) fieldChecks(b *strings.Builder, value string, pointer, required bool, object map[string]interface{}, t goType, p path) {
+	var inner strings.Builder
+	g.checks(&inner, value, pointer, object, t, p, 1)
+
+	switch {
+	case required && (pointer || t.nilable):
+		fmt.Fprintf(b, "\tif %s == nil {\n", value)
+		g.fail(b, "\t\t", p, "is required")
+		if inner.Len() > 0 {
+			b.WriteString("\t} else {\n" + indent(inner.String()))
+		}
+		b.WriteString("\t}\n")
+	case pointer && inner.Len() > 0:
+		fmt.Fprintf(b, "\tif %s != nil {\n%s\t}\n", value, indent(inner.String()))
+	default:
+		b.WriteString(inner.String())
+	}
+}
+
+// checks writes the checks a value of type t must pass to meet the
+// constraints of its schema. deref is set when value is a pointer to one.
+func (g *Generator) checks(b *strings.Builder, value string, deref bool, object map[string]interface{}, t goType, p path, depth int) {
+	if t.named {
+		g.out.use("fmt")
+		fmt.Fprintf(b, "\tif err := %s.Validate(); err != nil {\n", value)
+		fmt.Fprintf(b, "\t\terrs = append(errs, fmt.Errorf(%s))\n\t}\n", strings.Join(append([]string{codegen.Quote(p.format + ": %w")}, append(p.args, "err")...), ", "))
+		return
+	}
+	if deref {
+		value = "*" + value
+	}
+
+	switch {
+	case t.elem != nil:
+		g.sizeChecks(b, value, object, p, "minItems", "maxItems", "items")
+		if t.isMap {
+			g.sizeChecks(b, value, object, p, "minProperties", "maxProperties", "properties")
+		}
+
+		index, item := "i", "item"
+		if t.isMap {
+			index = "key"
+		}
+		if depth > 1 {
+			index, item = index+strconv.Itoa(depth), item+strconv.Itoa(depth)
+		}
+		var itemObject map[string]interface{}
+		if t.isMap {
+			itemObject = g.schemas.Object(object["additionalProperties"])
+		} else {
+			itemObject = g.schemas.Object(object["items"])
+		}
+		itemPath := p.with("[%d]", index)
+		if t.isMap {
+			itemPath = p.with("[%q]", index)
+		}
+
+		// Items that may be null are pointers, skipped when nil
+		nullable := strings.HasPrefix(t.elem.expr, "*")
+		var inner strings.Builder
+		g.checks(&inner, item, nullable && !t.elem.named, itemObject, *t.elem, itemPath, depth+1)
+		if inner.Len() > 0 {
+			body := indent(inner.String())
+			if nullable {
+				body = fmt.Sprintf("\t\tif %s == nil {\n\t\t\tcontinue\n\t\t}\n", item) + body
+			}
+			fmt.Fprintf(b, "\tfor %s, %s := range %s {\n%s\t}\n", index, item, value, body)
+		}
+	case t.base == "string":
+		g.stringChecks(b, value, object, p)
+	case t.base == "int" || t.base == "int32" || t.base == "int64" || t.base == "float32" || t.base == "float64":
+		g.numberChecks(b, value, t.base, object, p)
+	}
+}
+
+// sizeChecks writes the checks of the length of a slice or map
+func (g *Generator) sizeChecks(b *strings.Builder, value string, object map[string]interface{}, p path, minKey, maxKey, noun string) {
+	if n, ok := codegen.Number(object, minKey); ok && n > 0 {
+		fmt.Fprintf(b, "\tif len(%s) < %d {\n", value, int(n))
+		g.fail(b, "\t\t", p, fmt.Sprintf("must have at least %d %s", int(n), noun))
+		b.WriteString("\t}\n")
+	}
+	if n, ok := codegen.Number(object, maxKey); ok {
+		fmt.Fprintf(b, "\tif len(%s) > %d {\n", value, int(n))
+		g.fail(b, "\t\t", p, fmt.Sprintf("must have at most %d %s", int(n), noun))
+		b.WriteString("\t}\n")
+	}
+}
+
+// stringChecks writes the length and pattern checks of a string. Patterns
+// Go's regexp package cannot compile are left unchecked.
+func (g *Generator) stringChecks(b *strings.Builder, value string, object map[string]interface{}, p path) {
+	if n, ok := codegen.Number(object, "minLength"); ok && n > 0 {
+		g.out.use("unicode/utf8")
+		fmt.Fprintf(b, "\tif utf8.RuneCountInString(%s) < %d {\n", value, int(n))
+		g.fail(b, "\t\t", p, fmt.Sprintf("must be at least %d characters long", int(n)))
+		b.WriteString("\t}\n")
+	}
+	if n, ok := codegen.Number(object, "maxLength"); ok {
+		g.out.use("unicode/utf8")
+		fmt.Fprintf(b, "\tif utf8.RuneCountInString(%s) > %d {\n", value, int(n))
+		g.fail(b, "\t\t", p, fmt.Sprintf("must be at most %d characters long", int(n)))
+		b.WriteString("\t}\n")
+	}
+	if pattern, ok := object["pattern"].(string); ok {
+		if name := g.pattern(pattern); name != "" {
+			fmt.Fprintf(b, "\tif !%s.MatchString(%s) {\n", name, value)
+			g.fail(b, "\t\t", p, "must match "+escapePercent(pattern))
+			b.WriteString("\t}\n")
+		}
+	}
+}
+
+// numberChecks writes the bound checks of a number. Bounds that are not
+// constants of the value's type are compared as float64.
+func (g *Generator) numberChecks(b *strings.Builder, value, base string, object map[string]interface{}, p path) {
+	bound := func(key, exclusiveKey, op, exclusiveOp, inclusiveText, exclusiveText string) {
+		n, ok := codegen.Number(object, key)
+		exclusive := object[exclusiveKey] == true
+		if limit, isNumber := codegen.Number(object, exclusiveKey); isNumber {
+			n, ok, exclusive = limit, true, true
+		}
+		if !ok {
+			return
+		}
+
+		compared := value
+		if !fits(n, base) {
+			compared = "float64(" + value + ")"
+		}
+		literal := strconv.FormatFloat(n, 'f', -1, 64)
+		if exclusive {
+			fmt.Fprintf(b, "\tif %s %s %s {\n", compared, exclusiveOp, literal)
+			g.fail(b, "\t\t", p, exclusiveText+" "+literal)
+		} else {
+			fmt.Fprintf(b, "\tif %s %s %s {\n", compared, op, literal)
+			g.fail(b, "\t\t", p, inclusiveText+" "+literal)
+		}
+		b.WriteString("\t}\n")
+	}
+	bound("minimum", "exclusiveMinimum", "<", "<=", "must be at least", "must be greater than")
+	bound("maximum", "exclusiveMaximum", ">", ">=", "must be at most", "must be less than")
+}
+
+// fits reports whether n is a constant of a numeric type
+func fits(n float64, base string) bool {
+	switch base {
+	case "int32":
+		return n == math.Trunc(n) && n >= math.MinInt32 && n <= math.MaxInt32
+	case "int", "int64":
+		return n == math.Trunc(n) && math.Abs(n) <= 1<<53
+	case "float32":
+		return math.Abs(n) <= math.MaxFloat32
+	}
+	return true
+}
+
+// pattern returns the variable that holds a compiled pattern, or nothing
+// when the pattern does not compile
+func (g *Generator) pattern(pattern string) string {
+	if name, ok := g.patterns[pattern]; ok {
+		return name
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		g.patterns[pattern] = ""
+		return ""
+	}
+	g.models.use("regexp")
+	name := g.idents.Unique("pattern" + strconv.Itoa(len(g.patterns)+1))
+	g.patterns[pattern] = name
+	return name
+}
+
+// writeTypeComment writes the doc comment of a type declared for a schema
+func writeTypeComment(b *strings.Builder, name string, object map[string]interface{}) {
+	description, _ := object["description"].(string)
+	if title, ok := object["title"].(string); ok && description == "" {
+		description = title
+	}
+	if description == "" {
+		fmt.Fprintf(b, "// %s is generated from the definition.\n", name)
+	} else {
+		writeComment(b, name+": "+description, "")
+	}
+	if object["deprecated"] == true {
+		b.WriteString("//\n// Deprecated: the definition marks this schema deprecated.\n")
+	}
+}
+
+// receiver returns the receiver name of a type's methods: its first
+// letter, unless the checks use that name themselves
+func receiver(name string) string {
+	recv := strings.ToLower(name[:1])
+	if recv == "i" || recv == "e" || recv == "u" {
+		return "v"
+	}
+	return recv
+}
+
+// structTag returns a struct tag literal
+func structTag(tag string) string {
+	if strings.Contains(tag, "`") {
+		return codegen.Quote(tag)
+	}
+	return "`" + tag + "`"
+}
+
+// indent indents lines of code by one tab
+func indent(code string) string {
+	lines := strings.SplitAfter(code, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			lines[i] = "\t" + line
+		}
+	}
+	return strings.Join(lines, "")
+}
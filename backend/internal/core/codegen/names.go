@@ -0,0 +1,45 @@
+package codegen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Names hands out names that are unique within one scope, such as the types
+// of a module or the fields of a type
+type Names map[string]bool
+
+// Unique returns name, or name with a number appended when it is taken
+func (n Names) Unique(name string) string {
+	candidate := name
+	for i := 2; n[candidate]; i++ {
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+	n[candidate] = true
+	return candidate
+}
+
+// Describe joins the summary and description of something, either of which
+// may be empty
+func Describe(summary, description string) string {
+	summary, description = strings.TrimSpace(summary), strings.TrimSpace(description)
+	switch {
+	case summary == "":
+		return description
+	case description == "" || description == summary:
+		return summary
+	}
+	return summary + "\n\n" + description
+}
+
+// Quote returns a double-quoted string literal, escaped as JSON escapes it,
+// which Go, TypeScript and GraphQL all read
+func Quote(text string) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(text)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
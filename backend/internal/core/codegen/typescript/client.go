@@ -0,0 +1,382 @@
+package typescript
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/codegen"
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// pathParam matches the parameters in a path template
+var pathParam = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// clientCode is the part of the client every API shares
+const clientCode = `
+export interface ClientOptions {
+  /** The URL the operation paths are relative to */
+  baseUrl?: string;
+  /** Headers sent with every request, such as credentials */
+  headers?: Record<string, string>;
+  /** The fetch implementation; the global fetch by default */
+  fetch?: typeof fetch;
+}
+
+/** ApiError is thrown for responses outside the 2xx range */
+export class ApiError extends Error {
+  readonly status: number;
+  readonly body: string;
+
+  constructor(status: number, body: string) {
+    super(` + "`unexpected status ${status}: ${body}`" + `);
+    this.name = 'ApiError';
+    this.status = status;
+    this.body = body;
+  }
+}
+
+interface RequestOptions {
+  query?: Record<string, unknown>;
+  headers?: Record<string, unknown>;
+  body?: unknown;
+  json?: boolean;
+  contentType?: string;
+  responseType: 'json' | 'text' | 'blob' | 'none';
+}
+`
+
+// requestCode is the method that sends the requests of every operation
+const requestCode = `
+  private async request<T>(method: string, path: string, options: RequestOptions, init?: RequestInit): Promise<T> {
+    const search = new URLSearchParams();
+    for (const [name, value] of Object.entries(options.query ?? {})) {
+      // Lists are sent as one query parameter per item
+      for (const item of Array.isArray(value) ? value : [value]) {
+        if (item !== undefined && item !== null) {
+          search.append(name, paramText(item));
+        }
+      }
+    }
+
+    const headers = new Headers(this.headers);
+    for (const [name, value] of Object.entries(options.headers ?? {})) {
+      if (value !== undefined && value !== null) {
+        headers.set(name, Array.isArray(value) ? value.map(paramText).join(',') : paramText(value));
+      }
+    }
+    new Headers(init?.headers).forEach((value, name) => headers.set(name, value));
+
+    let body: BodyInit | undefined;
+    if (options.body !== undefined) {
+      body = options.json ? JSON.stringify(options.body) : (options.body as BodyInit);
+      if (options.contentType) {
+        headers.set('Content-Type', options.contentType);
+      }
+    }
+
+    const query = search.toString();
+    const response = await this.fetch(this.baseUrl + path + (query ? ` + "`?${query}`" + ` : ''), {
+      ...init,
+      method,
+      headers,
+      body,
+    });
+    if (!response.ok) {
+      throw new ApiError(response.status, await response.text());
+    }
+
+    switch (options.responseType) {
+      case 'json': {
+        const text = await response.text();
+        return (text ? JSON.parse(text) : undefined) as T;
+      }
+      case 'text':
+        return (await response.text()) as T;
+      case 'blob':
+        return (await response.blob()) as T;
+    }
+    return undefined as T;
+  }
+}
+
+function paramText(value: unknown): string {
+  return typeof value === 'object' ? JSON.stringify(value) : String(value);
+}
+`
+
+// operation is an endpoint as the client sees it
+type operation struct {
+	method      string
+	verb        string
+	path        string
+	description string
+	deprecated  bool
+
+	pathParams []param
+	// params are the query and header parameters, given as the fields
+	// of paramsType. Cookies are left to the browser.
+	params     []param
+	paramsType string
+
+	body     string
+	bodyJSON bool
+	bodyType string
+	required bool
+
+	result       string
+	responseType string
+}
+
+// param is a parameter of an operation
+type param struct {
+	name     string
+	in       string
+	arg      string
+	t        string
+	required bool
+}
+
+// operation builds the client method of an endpoint, declaring the types
+// of its parameters and of the bodies it sends and receives
+func (g *Generator) operation(endpoint domain.Endpoint, methodNames codegen.Names) operation {
+	method := camel(endpoint.OperationID)
+	if endpoint.OperationID == "" {
+		method = camelWords(codegen.OperationWords(endpoint))
+	}
+	method = methodNames.Unique(method)
+	typePrefix := pascal(codegen.Words(method))
+
+	op := operation{
+		method:       method,
+		verb:         strings.ToUpper(endpoint.Method),
+		path:         endpoint.Path,
+		description:  codegen.Describe(endpoint.Summary, endpoint.Description),
+		deprecated:   endpoint.Deprecated,
+		result:       "void",
+		responseType: "none",
+	}
+
+	args := make(codegen.Names)
+	declared := make(map[string]domain.Parameter)
+	for _, id := range endpoint.Parameters {
+		p, ok := g.parameters[id]
+		if !ok {
+			continue
+		}
+		switch p.In {
+		case "path":
+			declared[p.Name] = p
+		case "query", "header":
+			op.params = append(op.params, param{name: p.Name, in: p.In, t: g.paramType(p), required: p.Required})
+		}
+	}
+	for _, match := range pathParam.FindAllStringSubmatch(endpoint.Path, -1) {
+		t := "string"
+		if p, ok := declared[match[1]]; ok {
+			t = g.paramType(p)
+		}
+		op.pathParams = append(op.pathParams, param{name: match[1], in: "path", arg: args.Unique(camel(match[1])), t: t, required: true})
+	}
+	if len(op.params) > 0 {
+		op.paramsType = g.idents.Unique(typePrefix + "Params")
+		g.declareParams(op)
+	}
+
+	if body, ok := g.bodies[endpoint.RequestBody]; ok && endpoint.RequestBody != "" {
+		if mediaType, media, ok := codegen.PickMedia(body.Content); ok {
+			op.body = mediaType
+			op.required = body.Required
+			op.bodyJSON = codegen.IsJSON(mediaType)
+			op.bodyType = "BodyInit"
+			if op.bodyJSON {
+				op.bodyType = g.operationType(media.Schema, typePrefix+"Request")
+			}
+		}
+	}
+
+	if id, ok := codegen.SuccessResponse(endpoint.Responses); ok {
+		if mediaType, media, ok := codegen.PickMedia(g.responses[id].Content); ok {
+			switch {
+			case codegen.IsJSON(mediaType):
+				op.result, op.responseType = g.operationType(media.Schema, typePrefix+"Response"), "json"
+			case strings.HasPrefix(mediaType, "text/"):
+				op.result, op.responseType = "string", "text"
+			default:
+				op.result, op.responseType = "Blob", "blob"
+			}
+		}
+	}
+	return op
+}
+
+// paramType returns the type of a parameter's schema
+func (g *Generator) paramType(p domain.Parameter) string {
+	if p.Schema == nil {
+		return "string"
+	}
+	return g.typeOf(p.Schema, "  ")
+}
+
+// operationType returns the type of a body an operation sends or receives.
+// Object types written in place are declared under name, to keep the
+// client's signatures short.
+func (g *Generator) operationType(schema interface{}, name string) string {
+	if schema == nil {
+		return "unknown"
+	}
+	t := g.typeOf(schema, "")
+	if !strings.Contains(t, "\n") {
+		return t
+	}
+	name = g.idents.Unique(name)
+	g.decls[name] = "export type " + name + " = " + t + ";\n"
+	return name
+}
+
+// declareParams declares the interface of the query and header parameters
+// of an operation, keyed by their names in the definition
+func (g *Generator) declareParams(op operation) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "/** The query and header parameters of %s */\n", op.method)
+	fmt.Fprintf(&b, "export interface %s {\n", op.paramsType)
+	for _, p := range op.params {
+		b.WriteString("  " + propertyKey(p.name))
+		if !p.required {
+			b.WriteString("?")
+		}
+		b.WriteString(": " + p.t + ";\n")
+	}
+	b.WriteString("}\n")
+	g.decls[op.paramsType] = b.String()
+}
+
+// client writes client.ts: the Client class with a method per operation
+func (g *Generator) client(operations []operation) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, header, g.api.Metadata.Name, g.api.Metadata.Version)
+
+	var methods strings.Builder
+	for _, op := range operations {
+		g.writeMethod(&methods, op)
+	}
+
+	// The client refers to the types it uses by name
+	var imports []string
+	for _, name := range codegen.SortedKeys(g.decls) {
+		if regexp.MustCompile(`\b` + regexp.QuoteMeta(name) + `\b`).MatchString(methods.String()) {
+			imports = append(imports, name)
+		}
+	}
+	if len(imports) > 0 {
+		fmt.Fprintf(&b, "\nimport type { %s } from './types';\n", strings.Join(imports, ", "))
+	}
+
+	baseURL := "''"
+	if g.api.Metadata.BaseURL != "" {
+		fmt.Fprintf(&b, "\n/** The URL of the first server of the definition */\nexport const defaultBaseUrl = %s;\n", literal(g.api.Metadata.BaseURL))
+		baseURL = "defaultBaseUrl"
+	}
+	b.WriteString(clientCode)
+
+	fmt.Fprintf(&b, "\n/** Client calls the operations of %s over HTTP */\n", strings.ReplaceAll(g.api.Metadata.Name, "*/", `*\/`))
+	b.WriteString("export class Client {\n  private readonly baseUrl: string;\n  private readonly headers: Record<string, string>;\n  private readonly fetch: typeof fetch;\n\n")
+	b.WriteString("  constructor(options: ClientOptions = {}) {\n")
+	fmt.Fprintf(&b, "    this.baseUrl = (options.baseUrl ?? %s).replace(/\\/$/, '');\n", baseURL)
+	b.WriteString("    this.headers = options.headers ?? {};\n    this.fetch = options.fetch ?? globalThis.fetch.bind(globalThis);\n  }\n")
+	b.WriteString(methods.String())
+	b.WriteString(requestCode)
+	return b.String()
+}
+
+// writeMethod writes the client method of an operation. Path parameters
+// are arguments of their own; the others are given in a params object,
+// which may be left out when none of them is required.
+func (g *Generator) writeMethod(b *strings.Builder, op operation) {
+	var required, optional []string
+	for _, p := range op.pathParams {
+		required = append(required, p.arg+": "+p.t)
+	}
+	if op.paramsType != "" {
+		paramsRequired := false
+		for _, p := range op.params {
+			paramsRequired = paramsRequired || p.required
+		}
+		if paramsRequired {
+			required = append(required, "params: "+op.paramsType)
+		} else {
+			optional = append(optional, "params: "+op.paramsType+" = {}")
+		}
+	}
+	if op.body != "" {
+		if op.required {
+			required = append(required, "body: "+op.bodyType)
+		} else {
+			optional = append(optional, "body?: "+op.bodyType)
+		}
+	}
+	args := append(append(required, optional...), "init?: RequestInit")
+
+	doc := op.verb + " " + op.path
+	if op.description != "" {
+		doc += "\n\n" + op.description
+	}
+	b.WriteString("\n")
+	writeDoc(b, doc, "  ", op.deprecated)
+	fmt.Fprintf(b, "  %s(%s): Promise<%s> {\n", op.method, strings.Join(args, ", "), op.result)
+
+	var options []string
+	for _, in := range []struct{ name, option string }{{"query", "query"}, {"header", "headers"}} {
+		var values []string
+		for _, p := range op.params {
+			if p.in == in.name {
+				values = append(values, propertyKey(p.name)+": params"+access(p.name))
+			}
+		}
+		if len(values) > 0 {
+			options = append(options, fmt.Sprintf("%s: { %s }", in.option, strings.Join(values, ", ")))
+		}
+	}
+	if op.body != "" {
+		options = append(options, "body")
+		if op.bodyJSON {
+			options = append(options, "json: true")
+		}
+		if op.body != "multipart/form-data" {
+			// fetch sets the boundary of multipart bodies itself
+			options = append(options, "contentType: "+literal(op.body))
+		}
+	}
+	options = append(options, "responseType: "+literal(op.responseType))
+
+	fmt.Fprintf(b, "    return this.request<%s>(%s, %s, { %s }, init);\n  }\n",
+		op.result, literal(op.verb), g.pathExpr(op), strings.Join(options, ", "))
+}
+
+// pathExpr returns the path of an operation with its parameters filled in
+func (g *Generator) pathExpr(op operation) string {
+	if len(op.pathParams) == 0 {
+		return literal(op.path)
+	}
+
+	escape := strings.NewReplacer("\\", "\\\\", "`", "\\`", "${", "\\${")
+	var b strings.Builder
+	b.WriteString("`")
+	rest := op.path
+	for _, p := range op.pathParams {
+		before, after, _ := strings.Cut(rest, "{"+p.name+"}")
+		b.WriteString(escape.Replace(before))
+		b.WriteString("${encodeURIComponent(String(" + p.arg + "))}")
+		rest = after
+	}
+	b.WriteString(escape.Replace(rest) + "`")
+	return b.String()
+}
+
+// access returns the expression that reads a property of an object
+func access(name string) string {
+	if identifier.MatchString(name) {
+		return "." + name
+	}
+	return "[" + literal(name) + "]"
+}
@@ -0,0 +1,120 @@
+package typescript
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/codegen"
+)
+
+// identifier matches the names TypeScript takes without quotes
+var identifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// reservedWords cannot name arguments
+var reservedWords = map[string]bool{
+	"await": true, "break": true, "case": true, "catch": true, "class": true, "const": true,
+	"continue": true, "debugger": true, "default": true, "delete": true, "do": true, "else": true,
+	"enum": true, "export": true, "extends": true, "false": true, "finally": true, "for": true,
+	"function": true, "if": true, "implements": true, "import": true, "in": true,
+	"instanceof": true, "interface": true, "let": true, "new": true, "null": true,
+	"package": true, "private": true, "protected": true, "public": true, "return": true,
+	"static": true, "super": true, "switch": true, "this": true, "throw": true, "true": true,
+	"try": true, "typeof": true, "var": true, "void": true, "while": true, "with": true,
+	"yield": true,
+	// The generated methods' own arguments
+	"body": true, "init": true, "params": true,
+}
+
+// typeName returns the name of a type: the name itself when TypeScript
+// takes it, or else its words in PascalCase
+func typeName(name string) string {
+	if identifier.MatchString(name) && !reservedWords[name] {
+		return name
+	}
+	return pascal(codegen.Words(name))
+}
+
+// camel returns the name of a method or argument: the name itself when
+// TypeScript takes it, or else its words in camelCase
+func camel(name string) string {
+	if identifier.MatchString(name) && !reservedWords[name] {
+		return name
+	}
+	return camelWords(codegen.Words(name))
+}
+
+func camelWords(words []string) string {
+	if len(words) == 0 {
+		return "value"
+	}
+	name := strings.ToLower(words[0]) + strings.TrimPrefix(pascal(words), pascal(words[:1]))
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	if reservedWords[name] {
+		name += "Value"
+	}
+	return name
+}
+
+// pascal joins words in PascalCase. A name that does not start with a
+// letter gets a leading underscore.
+func pascal(words []string) string {
+	var b strings.Builder
+	for _, word := range words {
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+
+	name := b.String()
+	if name == "" || !(name[0] >= 'A' && name[0] <= 'Z') {
+		name = "_" + name
+	}
+	return name
+}
+
+// propertyKey returns a property name as an object key, quoted when it is
+// not an identifier
+func propertyKey(name string) string {
+	if identifier.MatchString(name) {
+		return name
+	}
+	return literal(name)
+}
+
+// literal returns a single-quoted string literal
+func literal(text string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`, "\r", `\r`, "\u2028", `\u2028`, "\u2029", `\u2029`)
+	return "'" + replacer.Replace(text) + "'"
+}
+
+// writeDoc writes text as a JSDoc comment
+func writeDoc(b *strings.Builder, text, indent string, deprecated bool) {
+	text = strings.ReplaceAll(strings.TrimSpace(text), "*/", `*\/`)
+	var lines []string
+	if text != "" {
+		lines = strings.Split(text, "\n")
+	}
+	if deprecated {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, "@deprecated")
+	}
+
+	switch len(lines) {
+	case 0:
+		return
+	case 1:
+		b.WriteString(indent + "/** " + lines[0] + " */\n")
+		return
+	}
+	b.WriteString(indent + "/**\n")
+	for _, line := range lines {
+		if line = strings.TrimRight(line, " \t\r"); line == "" {
+			b.WriteString(indent + " *\n")
+		} else {
+			b.WriteString(indent + " * " + line + "\n")
+		}
+	}
+	b.WriteString(indent + " */\n")
+}
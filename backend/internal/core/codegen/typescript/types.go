@@ -0,0 +1,355 @@
+package typescript
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/codegen"
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// declare writes the declaration of a named schema: an interface for an
+// object, extending the named objects of its allOf, and a type alias for
+// anything else
+func (g *Generator) declare(schema *domain.Schema) {
+	object := g.schemas.Map(schema)
+	name := g.schemaNames[schema.ID]
+
+	var b strings.Builder
+	description, _ := object["description"].(string)
+	if title, ok := object["title"].(string); ok && description == "" {
+		description = title
+	}
+	writeDoc(&b, description, "", object["deprecated"] == true)
+
+	if g.isInterface(object, 0) {
+		var extends []string
+		properties := make(map[string]map[string]interface{})
+		required := make(map[string]bool)
+		g.interfaceParts(object, &extends, properties, required, 0)
+
+		b.WriteString("export interface " + name)
+		if len(extends) > 0 {
+			b.WriteString(" extends " + strings.Join(extends, ", "))
+		}
+		b.WriteString(" {\n")
+		g.writeMembers(&b, properties, required, additional(object), "  ")
+		b.WriteString("}\n")
+	} else {
+		b.WriteString("export type " + name + " = " + g.inline(object, "") + ";\n")
+	}
+
+	g.decls[name] = b.String()
+}
+
+// isInterface reports whether a schema is an object TypeScript declares as
+// an interface: one with properties or allOf members, all of them such
+// objects themselves, and without unions, enums or null
+func (g *Generator) isInterface(object map[string]interface{}, depth int) bool {
+	if depth > codegen.MaxDepth || object["enum"] != nil || object["const"] != nil ||
+		len(codegen.Branches(object)) > 0 || codegen.Nullable(object) || codegen.SchemaType(object) != "object" {
+		return false
+	}
+	if _, ok := object["additionalProperties"].(map[string]interface{}); ok {
+		return false
+	}
+	members, _ := object["allOf"].([]interface{})
+	for _, member := range members {
+		resolved := g.schemas.Object(member)
+		if resolved == nil || !g.isInterface(resolved, depth+1) {
+			return false
+		}
+	}
+	return object["properties"] != nil || len(members) > 0
+}
+
+// interfaceParts collects the named objects an interface extends and the
+// properties it declares itself, which include those of inline allOf
+// members
+func (g *Generator) interfaceParts(object map[string]interface{}, extends *[]string, properties map[string]map[string]interface{}, required map[string]bool, depth int) {
+	if depth > codegen.MaxDepth {
+		return
+	}
+	members, _ := object["allOf"].([]interface{})
+	for _, member := range members {
+		resolved, named := g.schemas.Resolve(member)
+		switch {
+		case named != nil:
+			*extends = append(*extends, g.schemaNames[named.ID])
+		case resolved != nil:
+			g.interfaceParts(resolved, extends, properties, required, depth+1)
+		}
+	}
+
+	own, _ := object["properties"].(map[string]interface{})
+	for name, property := range own {
+		switch p := property.(type) {
+		case map[string]interface{}:
+			properties[name] = p
+		case string:
+			properties[name] = map[string]interface{}{"$ref": p}
+		}
+	}
+	for _, name := range codegen.Strings(object["required"]) {
+		required[name] = true
+	}
+}
+
+// writeMembers writes the properties of an object type, one per line.
+// additionalProperties adds an index signature.
+func (g *Generator) writeMembers(b *strings.Builder, properties map[string]map[string]interface{}, required map[string]bool, indexed bool, indent string) {
+	for _, name := range codegen.SortedKeys(properties) {
+		property := properties[name]
+		resolved := g.schemas.Object(property)
+		if resolved == nil {
+			resolved = map[string]interface{}{}
+		}
+
+		description, _ := property["description"].(string)
+		writeDoc(b, description, indent, property["deprecated"] == true || resolved["deprecated"] == true)
+
+		b.WriteString(indent)
+		if property["readOnly"] == true || resolved["readOnly"] == true {
+			b.WriteString("readonly ")
+		}
+		b.WriteString(propertyKey(name))
+		if !required[name] {
+			b.WriteString("?")
+		}
+		b.WriteString(": " + g.typeOf(property, indent) + ";\n")
+	}
+	if indexed {
+		b.WriteString(indent + "[key: string]: unknown;\n")
+	}
+}
+
+// typeOf returns the TypeScript type of a schema: the name of a named
+// schema, and the type written in place otherwise. indent is that of the
+// line the type starts on.
+func (g *Generator) typeOf(schema interface{}, indent string) string {
+	g.depth++
+	defer func() { g.depth-- }()
+	if g.depth > 4*codegen.MaxDepth {
+		return "unknown"
+	}
+
+	object, named := g.schemas.Resolve(schema)
+	switch {
+	case named != nil:
+		return g.schemaNames[named.ID]
+	case object == nil:
+		return "unknown"
+	}
+	return g.inline(object, indent)
+}
+
+// inline writes the type of a schema object in place
+func (g *Generator) inline(object map[string]interface{}, indent string) string {
+	t := g.inlineBase(object, indent)
+	if codegen.Nullable(object) && t != "unknown" && t != "null" && !strings.HasSuffix(t, " | null") {
+		t += " | null"
+	}
+	return t
+}
+
+func (g *Generator) inlineBase(object map[string]interface{}, indent string) string {
+	if value, ok := object["const"]; ok {
+		return valueLiteral(value)
+	}
+	if enum, ok := object["enum"].([]interface{}); ok && len(enum) > 0 {
+		var values []string
+		seen := make(map[string]bool)
+		for _, value := range enum {
+			if v := valueLiteral(value); !seen[v] {
+				seen[v] = true
+				values = append(values, v)
+			}
+		}
+		return strings.Join(values, " | ")
+	}
+	if branches := codegen.Branches(object); len(branches) > 0 {
+		return g.union(object, branches, indent)
+	}
+
+	// allOf joins its members, and the object's own properties, as an
+	// intersection
+	if members, ok := object["allOf"].([]interface{}); ok && len(members) > 0 {
+		var parts []string
+		for _, member := range members {
+			parts = append(parts, g.typeOf(member, indent))
+		}
+		if properties, ok := object["properties"].(map[string]interface{}); ok && len(properties) > 0 {
+			own := make(map[string]interface{}, len(object))
+			for key, value := range object {
+				if key != "allOf" {
+					own[key] = value
+				}
+			}
+			parts = append(parts, g.inlineBase(own, indent))
+		}
+		if len(parts) == 1 {
+			return parts[0]
+		}
+		for i, part := range parts {
+			parts[i] = group(part)
+		}
+		return strings.Join(parts, " & ")
+	}
+
+	switch t := object["type"].(type) {
+	case []interface{}:
+		var types []string
+		for _, item := range t {
+			name, _ := item.(string)
+			variant := make(map[string]interface{}, len(object))
+			for key, value := range object {
+				variant[key] = value
+			}
+			variant["type"] = name
+			types = append(types, g.inlineBase(variant, indent))
+		}
+		return strings.Join(types, " | ")
+	}
+
+	switch codegen.SchemaType(object) {
+	case "object":
+		return g.objectLiteral(object, indent)
+	case "array":
+		items, ok := object["items"]
+		if !ok {
+			return "unknown[]"
+		}
+		return group(g.typeOf(items, indent)) + "[]"
+	case "string":
+		return "string"
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "null":
+		return "null"
+	}
+	return "unknown"
+}
+
+// objectLiteral writes an object type in place: its properties, or a
+// record of its additionalProperties
+func (g *Generator) objectLiteral(object map[string]interface{}, indent string) string {
+	properties, required := g.schemas.Properties(object)
+	if len(properties) == 0 {
+		switch values := object["additionalProperties"].(type) {
+		case map[string]interface{}, string:
+			if len(g.schemas.Object(values)) > 0 {
+				return "Record<string, " + g.typeOf(values, indent) + ">"
+			}
+		}
+		return "Record<string, unknown>"
+	}
+
+	var b strings.Builder
+	b.WriteString("{\n")
+	g.writeMembers(&b, properties, required, additional(object), indent+"  ")
+	b.WriteString(indent + "}")
+	return b.String()
+}
+
+// union writes the union of the branches of a oneOf or anyOf. With a
+// discriminator, each branch is narrowed to its value of the discriminating
+// property, so the union is a discriminated union.
+func (g *Generator) union(object map[string]interface{}, branches []interface{}, indent string) string {
+	property, values := g.discriminator(object, branches)
+
+	var types []string
+	seen := make(map[string]bool)
+	for i, branch := range branches {
+		t := g.typeOf(branch, indent)
+		if values[i] != "" {
+			t = group(t) + " & { " + propertyKey(property) + ": " + literal(values[i]) + " }"
+		}
+		if t = group(t); !seen[t] {
+			seen[t] = true
+			types = append(types, t)
+		}
+	}
+	return strings.Join(types, " | ")
+}
+
+// discriminator returns the discriminating property of a union and the
+// value each branch has for it: the one its mapping gives, or else the name
+// of the branch's schema
+func (g *Generator) discriminator(object map[string]interface{}, branches []interface{}) (string, []string) {
+	values := make([]string, len(branches))
+	discriminator, _ := object["discriminator"].(map[string]interface{})
+	property, _ := discriminator["propertyName"].(string)
+	if property == "" {
+		return "", values
+	}
+
+	mapping, _ := discriminator["mapping"].(map[string]interface{})
+	for i, branch := range branches {
+		_, named := g.schemas.Resolve(branch)
+		if named == nil {
+			continue
+		}
+		values[i] = named.Name
+		// A schema mapped by several values gets the first in order
+		for _, value := range codegen.SortedKeys(mapping) {
+			if ref, ok := mapping[value].(string); ok {
+				if _, target := g.schemas.Resolve(ref); target != nil && target.ID == named.ID {
+					values[i] = value
+					break
+				}
+			}
+		}
+	}
+	return property, values
+}
+
+// additional reports whether an object declares that it allows properties
+// besides its own
+func additional(object map[string]interface{}) bool {
+	switch values := object["additionalProperties"].(type) {
+	case bool:
+		return values
+	case map[string]interface{}, string:
+		return true
+	}
+	return false
+}
+
+// group wraps a union or intersection in parentheses, so that it can be
+// made an array or joined with other types
+func group(t string) string {
+	depth := 0
+	quoted := false
+	for i := 0; i < len(t); i++ {
+		switch c := t[i]; {
+		case quoted && c == '\\':
+			i++
+		case c == '\'':
+			quoted = !quoted
+		case quoted:
+		case c == '(' || c == '{' || c == '[' || c == '<':
+			depth++
+		case c == ')' || c == '}' || c == ']' || c == '>':
+			depth--
+		case depth == 0 && (c == '|' || c == '&'):
+			return "(" + t + ")"
+		}
+	}
+	return t
+}
+
+// valueLiteral returns the literal type of a JSON value
+func valueLiteral(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return literal(v)
+	case nil:
+		return "null"
+	case float64, int, bool:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+	return "unknown"
+}
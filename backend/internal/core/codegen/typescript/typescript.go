@@ -0,0 +1,116 @@
+// Package typescript generates TypeScript for the consumers of an API
+// definition: an interface or type for every schema, with oneOf and anyOf
+// as unions that a discriminator turns into discriminated unions, and a
+// client with a typed method per operation that calls the API with fetch.
+package typescript
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/codegen"
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// header marks the generated files, so tools leave them alone
+const header = "// Code generated by swagger-editor from %s %s. DO NOT EDIT.\n"
+
+// reservedNames are the names the generated client declares itself, and
+// the globals it uses, which an imported type of the same name would hide
+var reservedNames = []string{
+	"ApiError", "Client", "ClientOptions", "RequestOptions", "defaultBaseUrl", "paramText",
+	"Array", "Blob", "BodyInit", "Error", "Headers", "JSON", "Object", "Promise", "Record",
+	"RequestInit", "String", "URLSearchParams",
+}
+
+// Generator builds the TypeScript of one API definition
+type Generator struct {
+	api        *domain.APIDefinition
+	schemas    *codegen.Schemas
+	parameters map[string]domain.Parameter
+	bodies     map[string]domain.RequestBody
+	responses  map[string]domain.Response
+
+	idents      codegen.Names
+	schemaNames map[string]string
+	decls       map[string]string
+	depth       int
+}
+
+// New creates a generator for an API definition
+func New(api *domain.APIDefinition) *Generator {
+	g := &Generator{
+		api:         api,
+		schemas:     codegen.NewSchemas(api),
+		parameters:  make(map[string]domain.Parameter, len(api.Parameters)),
+		bodies:      make(map[string]domain.RequestBody, len(api.RequestBodies)),
+		responses:   make(map[string]domain.Response, len(api.Responses)),
+		idents:      make(codegen.Names),
+		schemaNames: make(map[string]string, len(api.Schemas)),
+		decls:       make(map[string]string),
+	}
+
+	for _, name := range reservedNames {
+		g.idents[name] = true
+	}
+	for _, schema := range api.Schemas {
+		g.schemaNames[schema.ID] = g.idents.Unique(typeName(schema.Name))
+	}
+	for _, param := range api.Parameters {
+		g.parameters[param.ID] = param
+	}
+	for _, body := range api.RequestBodies {
+		g.bodies[body.ID] = body
+	}
+	for _, response := range api.Responses {
+		g.responses[response.ID] = response
+	}
+	return g
+}
+
+// Name returns the name of the directory the files go in: the API's name
+// in kebab case
+func (g *Generator) Name() string {
+	words := codegen.Words(g.api.Metadata.Name)
+	for i, word := range words {
+		words[i] = strings.ToLower(word)
+	}
+	if len(words) == 0 {
+		return "api-client"
+	}
+	return strings.Join(words, "-")
+}
+
+// Generate returns the files: the types in types.ts, the client in
+// client.ts and index.ts, which exports both. Every named schema gets a
+// type, whether or not an operation uses it.
+func (g *Generator) Generate() ([]domain.GeneratedFile, error) {
+	for i := range g.api.Schemas {
+		g.declare(&g.api.Schemas[i])
+	}
+
+	var operations []operation
+	methodNames := make(codegen.Names)
+	for _, endpoint := range g.api.Endpoints {
+		operations = append(operations, g.operation(endpoint, methodNames))
+	}
+
+	var types strings.Builder
+	fmt.Fprintf(&types, header, g.api.Metadata.Name, g.api.Metadata.Version)
+	for _, name := range codegen.SortedKeys(g.decls) {
+		types.WriteString("\n" + g.decls[name])
+	}
+	if len(g.decls) == 0 {
+		types.WriteString("\nexport {};\n")
+	}
+
+	var index strings.Builder
+	fmt.Fprintf(&index, header, g.api.Metadata.Name, g.api.Metadata.Version)
+	index.WriteString("\nexport * from './types';\nexport * from './client';\n")
+
+	return []domain.GeneratedFile{
+		{Path: "types.ts", Content: types.String()},
+		{Path: "client.ts", Content: g.client(operations)},
+		{Path: "index.ts", Content: index.String()},
+	}, nil
+}
@@ -0,0 +1,82 @@
+package typescript
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/swagger-editor/backend/internal/core/codegen/codegentest"
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// generate returns the generated files by path
+func generate(t *testing.T, api *domain.APIDefinition) map[string]string {
+	t.Helper()
+	files, err := New(api).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	contents := make(map[string]string, len(files))
+	for _, file := range files {
+		contents[file.Path] = file.Content
+	}
+	return contents
+}
+
+func TestGenerateTypes(t *testing.T) {
+	types := generate(t, codegentest.PetStore())["types.ts"]
+
+	for _, want := range []string{
+		"export interface Pet {\n",
+		"  readonly id: number;\n",
+		"  /** What the pet answers to */\n  name: string;\n",
+		"  status?: 'available' | 'sold' | 'on-hold';\n",
+		"  parent?: Pet;\n",
+		"  owner: Owner;\n",
+		"  tags?: string[];\n",
+		"  metadata?: Record<string, unknown>;\n",
+		"export type Owner = (Person & { kind: 'person' }) | (Shelter & { kind: 'Shelter' });\n",
+		"export interface Shelter extends Place {\n  kind?: string;\n}\n",
+		"  city: string | null;\n",
+		"export interface ListPetsParams {\n  limit?: number;\n  tags?: string[];\n  since?: string;\n  'X-Trace-Id': string;\n}\n",
+	} {
+		if !strings.Contains(types, want) {
+			t.Errorf("types.ts does not contain %q:\n%s", want, types)
+		}
+	}
+}
+
+func TestGenerateClient(t *testing.T) {
+	client := generate(t, codegentest.PetStore())["client.ts"]
+
+	for _, want := range []string{
+		"import type { ListPetsParams, Pet } from './types';\n",
+		"export const defaultBaseUrl = 'https://pets.example.com/v1';\n",
+		"  listPets(params: ListPetsParams, init?: RequestInit): Promise<Pet[]> {\n",
+		"query: { limit: params.limit, tags: params.tags, since: params.since }, headers: { 'X-Trace-Id': params['X-Trace-Id'] }",
+		"  getPetsByPetId(petId: number, init?: RequestInit): Promise<Pet> {\n",
+		"this.request<Pet>('GET', `/pets/${encodeURIComponent(String(petId))}`, { responseType: 'json' }, init);\n",
+		"  createPet(body: Pet, init?: RequestInit): Promise<Pet> {\n",
+		"{ body, json: true, contentType: 'application/json', responseType: 'json' }",
+		"   * @deprecated\n   */\n  deletePet(petId: number, init?: RequestInit): Promise<void> {\n",
+	} {
+		if !strings.Contains(client, want) {
+			t.Errorf("client.ts does not contain %q:\n%s", want, client)
+		}
+	}
+}
+
+func TestGenerateOptionalArguments(t *testing.T) {
+	api := codegentest.PetStore()
+	api.Parameters[3].Required = false
+	api.RequestBodies[0].Required = false
+	client := generate(t, api)["client.ts"]
+
+	for _, want := range []string{
+		"  listPets(params: ListPetsParams = {}, init?: RequestInit): Promise<Pet[]> {\n",
+		"  createPet(body?: Pet, init?: RequestInit): Promise<Pet> {\n",
+	} {
+		if !strings.Contains(client, want) {
+			t.Errorf("client.ts does not contain %q:\n%s", want, client)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Package contract checks a running service against an API definition. Every
+// endpoint is called with generated parameters and request bodies, and the
+// status, headers and body of each response are checked against the
+// responses the endpoint declares.
+package contract
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/example"
+	"github.com/swagger-editor/backend/internal/core/jsonschema"
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// maxBodySize limits the response bodies read from the service
+const maxBodySize = 10 << 20
+
+// Runner calls the endpoints of one API definition
+type Runner struct {
+	api        *domain.APIDefinition
+	client     *http.Client
+	refs       *resolver.SchemaResolver
+	validator  *jsonschema.Validator
+	generator  *example.Generator
+	parameters map[string]domain.Parameter
+	bodies     map[string]domain.RequestBody
+	responses  map[string]domain.Response
+}
+
+// New creates a runner for an API definition that sends its requests with
+// client and generates values from seed. client may be nil for a runner
+// that only checks responses.
+func New(api *domain.APIDefinition, client *http.Client, seed uint64) *Runner {
+	refs := resolver.NewSchemaResolver(api)
+	r := &Runner{
+		api:        api,
+		client:     client,
+		refs:       refs,
+		validator:  jsonschema.New(refs),
+		generator:  example.New(refs, seed),
+		parameters: make(map[string]domain.Parameter, len(api.Parameters)),
+		bodies:     make(map[string]domain.RequestBody, len(api.RequestBodies)),
+		responses:  make(map[string]domain.Response, len(api.Responses)),
+	}
+	for _, param := range api.Parameters {
+		r.parameters[param.ID] = param
+	}
+	for _, body := range api.RequestBodies {
+		r.bodies[body.ID] = body
+	}
+	for _, response := range api.Responses {
+		r.responses[response.ID] = response
+	}
+	return r
+}
+
+// Run calls every endpoint on the service at baseURL, sending headers with
+// each request, and reports which responses conform to the definition
+func (r *Runner) Run(ctx context.Context, baseURL string, headers map[string]string) *domain.ContractReport {
+	report := &domain.ContractReport{
+		DefinitionID: r.api.ID,
+		BaseURL:      baseURL,
+		Results:      make([]domain.ContractResult, 0, len(r.api.Endpoints)),
+	}
+
+	for i := range r.api.Endpoints {
+		result := r.call(ctx, &r.api.Endpoints[i], baseURL, headers)
+		report.Total++
+		if result.Passed {
+			report.Passed++
+		} else {
+			report.Failed++
+		}
+		report.Results = append(report.Results, result)
+	}
+	return report
+}
+
+// call sends the request generated for an endpoint and checks the response
+func (r *Runner) call(ctx context.Context, endpoint *domain.Endpoint, baseURL string, headers map[string]string) domain.ContractResult {
+	result := domain.ContractResult{
+		EndpointID:  endpoint.ID,
+		OperationID: endpoint.OperationID,
+		Method:      strings.ToUpper(endpoint.Method),
+		Path:        endpoint.Path,
+	}
+
+	request, err := r.newRequest(ctx, endpoint, baseURL)
+	if err != nil {
+		result.Failures = []domain.ValidationError{{Message: err.Error(), Keyword: "request"}}
+		return result
+	}
+	for name, value := range headers {
+		request.Header.Set(name, value)
+	}
+	result.URL = request.URL.String()
+
+	start := time.Now()
+	response, err := r.client.Do(request)
+	if err != nil {
+		result.DurationMs = time.Since(start).Milliseconds()
+		result.Failures = []domain.ValidationError{{Message: err.Error(), Keyword: "request"}}
+		return result
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxBodySize))
+	result.DurationMs = time.Since(start).Milliseconds()
+	result.Status = response.StatusCode
+	if err != nil {
+		result.Failures = []domain.ValidationError{{
+			Path:    "/body",
+			Message: fmt.Sprintf("cannot read response body: %v", err),
+			Keyword: "request",
+		}}
+		return result
+	}
+
+	result.Failures = r.CheckResponse(endpoint, response.StatusCode, response.Header, body)
+	result.Passed = len(result.Failures) == 0
+	return result
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,163 @@
+package contract
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/swagger-editor/backend/internal/core/codegen/codegentest"
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// petStoreServer implements the pet store, answering requests that do not
+// follow the definition with 400, which the definition does not declare for
+// most operations. drift makes each operation break the definition.
+func petStoreServer(t *testing.T, drift bool) *httptest.Server {
+	owner := map[string]interface{}{"kind": "person", "name": "Ann"}
+	pet := map[string]interface{}{"id": 7, "name": "Rex", "owner": owner}
+	writeJSON := func(w http.ResponseWriter, status int, value interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(value)
+	}
+	reject := func(w http.ResponseWriter, message string) {
+		t.Errorf("unexpected request: %s", message)
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": message})
+	}
+	validID := func(r *http.Request) bool {
+		id, err := strconv.Atoi(r.PathValue("petId"))
+		return err == nil && id >= 1
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /pets", func(w http.ResponseWriter, r *http.Request) {
+		if raw := r.URL.Query().Get("limit"); raw != "" {
+			if limit, err := strconv.Atoi(raw); err != nil || limit < 1 || limit > 100 {
+				reject(w, "limit out of range: "+r.URL.RawQuery)
+				return
+			}
+		}
+		if r.Header.Get("X-Trace-Id") == "" {
+			reject(w, "missing trace ID")
+			return
+		}
+		if drift {
+			writeJSON(w, http.StatusOK, []interface{}{map[string]interface{}{"id": "7", "name": "Rex", "owner": owner}})
+			return
+		}
+		w.Header().Set("X-Total", "1")
+		writeJSON(w, http.StatusOK, []interface{}{pet})
+	})
+	mux.HandleFunc("POST /pets", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if r.Header.Get("Content-Type") != "application/json" || json.NewDecoder(r.Body).Decode(&body) != nil || body["name"] == nil {
+			reject(w, "invalid pet")
+			return
+		}
+		if drift {
+			w.Header().Set("Content-Type", "text/plain")
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte("created"))
+			return
+		}
+		writeJSON(w, http.StatusCreated, body)
+	})
+	mux.HandleFunc("GET /pets/{petId}", func(w http.ResponseWriter, r *http.Request) {
+		if !validID(r) {
+			reject(w, "invalid pet ID")
+			return
+		}
+		if drift {
+			writeJSON(w, http.StatusNotFound, map[string]string{"error": "not found"})
+			return
+		}
+		writeJSON(w, http.StatusOK, pet)
+	})
+	mux.HandleFunc("DELETE /pets/{petId}", func(w http.ResponseWriter, r *http.Request) {
+		if !validID(r) {
+			reject(w, "invalid pet ID")
+			return
+		}
+		if drift {
+			writeJSON(w, http.StatusOK, map[string]bool{"deleted": true})
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func runPetStore(t *testing.T, baseURL string) *domain.ContractReport {
+	runner := New(codegentest.PetStore(), http.DefaultClient, 1)
+	return runner.Run(context.Background(), baseURL, map[string]string{"Authorization": "Bearer token"})
+}
+
+func TestRunPassesConformingService(t *testing.T) {
+	server := petStoreServer(t, false)
+
+	report := runPetStore(t, server.URL+"/")
+
+	if report.Total != 4 || report.Passed != 4 || report.Failed != 0 {
+		t.Fatalf("expected 4 passed operations, got %+v", report)
+	}
+	for _, result := range report.Results {
+		if !result.Passed || len(result.Failures) > 0 {
+			t.Errorf("%s failed: %+v", result.OperationID, result.Failures)
+		}
+	}
+}
+
+func TestRunReportsDriftPerOperation(t *testing.T) {
+	server := petStoreServer(t, true)
+
+	report := runPetStore(t, server.URL)
+
+	if report.Passed != 0 || report.Failed != 4 {
+		t.Fatalf("expected 4 failed operations, got %d passed and %d failed", report.Passed, report.Failed)
+	}
+	expected := map[string][]string{
+		"GET /pets":            {"/headers/X-Total", "/body/0/id"},
+		"POST /pets":           {"/headers/Content-Type"},
+		"GET /pets/{petId}":    {"/body"},
+		"DELETE /pets/{petId}": {"/status"},
+	}
+	for _, result := range report.Results {
+		operation := result.Method + " " + result.Path
+		var paths []string
+		for _, failure := range result.Failures {
+			paths = append(paths, failure.Path)
+		}
+		if !reflect.DeepEqual(paths, expected[operation]) {
+			t.Errorf("%s: expected failures at %v, got %+v", operation, expected[operation], result.Failures)
+		}
+	}
+}
+
+func TestRunReportsUnreachableService(t *testing.T) {
+	server := petStoreServer(t, false)
+	server.Close()
+
+	report := runPetStore(t, server.URL)
+
+	if report.Failed != 4 {
+		t.Fatalf("expected every operation to fail, got %+v", report)
+	}
+	for _, result := range report.Results {
+		if len(result.Failures) != 1 || result.Failures[0].Keyword != "request" {
+			t.Errorf("%s: expected a request failure, got %+v", result.OperationID, result.Failures)
+		}
+	}
+}
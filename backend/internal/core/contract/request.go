@@ -0,0 +1,263 @@
+package contract
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// newRequest builds the request for an endpoint. Path parameters and the
+// required query, header and cookie parameters get their declared example
+// or a generated value; a declared request body is sent as well.
+func (r *Runner) newRequest(ctx context.Context, endpoint *domain.Endpoint, baseURL string) (*http.Request, error) {
+	path := endpoint.Path
+	query := url.Values{}
+	header := http.Header{}
+	var cookies []*http.Cookie
+
+	for _, id := range endpoint.Parameters {
+		param, ok := r.parameters[id]
+		if !ok || (!param.Required && param.In != "path") {
+			continue
+		}
+		values := r.parameterValues(param)
+		switch param.In {
+		case "path":
+			path = strings.ReplaceAll(path, "{"+param.Name+"}", url.PathEscape(strings.Join(values, ",")))
+		case "query":
+			query[param.Name] = values
+		case "header":
+			header.Set(param.Name, strings.Join(values, ","))
+		case "cookie":
+			cookies = append(cookies, &http.Cookie{Name: param.Name, Value: strings.Join(values, ",")})
+		}
+	}
+
+	target := strings.TrimRight(baseURL, "/") + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	var body io.Reader
+	contentType := ""
+	if endpoint.RequestBody != "" {
+		data, mediaType, err := r.requestBody(r.bodies[endpoint.RequestBody])
+		if err != nil {
+			return nil, err
+		}
+		if data != nil {
+			body, contentType = bytes.NewReader(data), mediaType
+		}
+	}
+
+	request, err := http.NewRequestWithContext(ctx, strings.ToUpper(endpoint.Method), target, body)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		request.Header[name] = values
+	}
+	for _, cookie := range cookies {
+		request.AddCookie(cookie)
+	}
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+	if accept := r.accept(endpoint); accept != "" {
+		request.Header.Set("Accept", accept)
+	}
+	return request, nil
+}
+
+// parameterValues returns the values to send for a parameter. Arrays are
+// joined by the delimiter of the parameter's style, or sent as repeated
+// query parameters when the style explodes them.
+func (r *Runner) parameterValues(param domain.Parameter) []string {
+	value := r.parameterExample(param)
+	if param.Schema == nil {
+		data, _ := json.Marshal(value)
+		return []string{string(data)}
+	}
+
+	style := param.Style
+	if style == "" {
+		style = "simple"
+		if param.In == "query" || param.In == "cookie" {
+			style = "form"
+		}
+	}
+	explode := style == "form"
+	if param.Explode != nil {
+		explode = *param.Explode
+	}
+
+	switch v := value.(type) {
+	case []interface{}:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = text(item)
+		}
+		switch {
+		case style == "form" && explode && param.In == "query":
+			return items
+		case style == "spaceDelimited":
+			return []string{strings.Join(items, " ")}
+		case style == "pipeDelimited":
+			return []string{strings.Join(items, "|")}
+		}
+		return []string{strings.Join(items, ",")}
+	case map[string]interface{}:
+		var pairs []string
+		for _, key := range sortedKeys(v) {
+			pairs = append(pairs, key, text(v[key]))
+		}
+		return []string{strings.Join(pairs, ",")}
+	}
+	return []string{text(value)}
+}
+
+// parameterExample returns the declared example of a parameter, the first
+// of its examples, or a value generated from its schema
+func (r *Runner) parameterExample(param domain.Parameter) interface{} {
+	if param.Example != nil {
+		return param.Example
+	}
+	if names := sortedKeys(param.Examples); len(names) > 0 {
+		return r.exampleValue(param.Examples[names[0]])
+	}
+	if param.Schema != nil {
+		return r.generator.Generate(param.Schema)
+	}
+	for _, mediaType := range sortedKeys(param.Content) {
+		return r.mediaExample(param.Content[mediaType])
+	}
+	return nil
+}
+
+// requestBody encodes an example body for one of the media types of a
+// request body, preferring JSON, then form data, then text
+func (r *Runner) requestBody(body domain.RequestBody) ([]byte, string, error) {
+	mediaTypes := sortedKeys(body.Content)
+	if len(mediaTypes) == 0 {
+		return nil, "", nil
+	}
+	sort.SliceStable(mediaTypes, func(i, j int) bool { return bodyRank(mediaTypes[i]) < bodyRank(mediaTypes[j]) })
+	mediaType := mediaTypes[0]
+	value := r.mediaExample(body.Content[mediaType])
+
+	switch {
+	case isJSON(mediaType) || strings.Contains(mediaType, "*"):
+		data, err := json.Marshal(value)
+		return data, "application/json", err
+	case mediaType == "application/x-www-form-urlencoded":
+		form := url.Values{}
+		object, _ := value.(map[string]interface{})
+		for _, key := range sortedKeys(object) {
+			form.Set(key, text(object[key]))
+		}
+		return []byte(form.Encode()), mediaType, nil
+	case strings.HasPrefix(mediaType, "text/"):
+		return []byte(text(value)), mediaType, nil
+	}
+	if !body.Required {
+		return nil, "", nil
+	}
+	return nil, "", fmt.Errorf("cannot generate a %s request body", mediaType)
+}
+
+// bodyRank orders the media types a request body can be sent in
+func bodyRank(mediaType string) int {
+	switch {
+	case isJSON(mediaType):
+		return 0
+	case mediaType == "application/x-www-form-urlencoded":
+		return 1
+	case strings.HasPrefix(mediaType, "text/"):
+		return 2
+	case strings.Contains(mediaType, "*"):
+		return 3
+	}
+	return 4
+}
+
+// accept lists the media types the responses of an endpoint declare
+func (r *Runner) accept(endpoint *domain.Endpoint) string {
+	seen := make(map[string]bool)
+	var mediaTypes []string
+	for _, code := range sortedKeys(endpoint.Responses) {
+		for _, mediaType := range sortedKeys(r.responses[endpoint.Responses[code]].Content) {
+			if !seen[mediaType] {
+				seen[mediaType] = true
+				mediaTypes = append(mediaTypes, mediaType)
+			}
+		}
+	}
+	return strings.Join(mediaTypes, ", ")
+}
+
+// mediaExample returns the declared example of a media type, the first of
+// its examples, or a value generated from its schema
+func (r *Runner) mediaExample(media domain.MediaType) interface{} {
+	if media.Example != nil {
+		return media.Example
+	}
+	if names := sortedKeys(media.Examples); len(names) > 0 {
+		return r.exampleValue(media.Examples[names[0]])
+	}
+	if media.Schema != nil {
+		return r.generator.Generate(media.Schema)
+	}
+	return nil
+}
+
+// exampleValue returns the value of an Example Object
+func (r *Runner) exampleValue(example interface{}) interface{} {
+	object, ok := r.component("examples", example).(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return object["value"]
+}
+
+// component follows a reference into a section of the components, such as
+// #/components/headers/Rate, and returns the object it points at
+func (r *Runner) component(section string, value interface{}) interface{} {
+	prefix := "#/components/" + section + "/"
+	for i := 0; i < 8; i++ {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return value
+		}
+		ref, isRef := object["$ref"].(string)
+		if !isRef {
+			return object
+		}
+		name, found := strings.CutPrefix(ref, prefix)
+		if !found {
+			return nil
+		}
+		components, _ := r.api.Components[section].(map[string]interface{})
+		value = components[name]
+	}
+	return nil
+}
+
+// text writes a value as it appears in a parameter or header
+func text(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	}
+	data, _ := json.Marshal(value)
+	return string(data)
+}
@@ -0,0 +1,226 @@
+package contract
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// CheckResponse checks that the status of a response is declared by the
+// endpoint and that its headers and body match the declared response. The
+// body of a HEAD response is not checked, as there is none.
+func (r *Runner) CheckResponse(endpoint *domain.Endpoint, status int, header http.Header, body []byte) []domain.ValidationError {
+	code, ok := declaredCode(endpoint.Responses, status)
+	if !ok {
+		return []domain.ValidationError{{
+			Path:    "/status",
+			Message: fmt.Sprintf("status %d is not declared for %s %s", status, strings.ToUpper(endpoint.Method), endpoint.Path),
+			Keyword: "status",
+			Params:  map[string]interface{}{"declared": sortedKeys(endpoint.Responses)},
+		}}
+	}
+
+	declared := r.responses[endpoint.Responses[code]]
+	errs := r.checkHeaders(declared, header)
+	if strings.EqualFold(endpoint.Method, http.MethodHead) {
+		return errs
+	}
+	return append(errs, r.checkBody(declared, header.Get("Content-Type"), body)...)
+}
+
+// declaredCode returns the response code that covers a status: the status
+// itself, its range such as 4XX, or default
+func declaredCode(responses map[string]string, status int) (string, bool) {
+	code := strconv.Itoa(status)
+	candidates := []string{code, code[:1] + "XX", code[:1] + "xx", "default"}
+	for _, candidate := range candidates {
+		if _, ok := responses[candidate]; ok {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+// checkHeaders checks that required headers are present and that the
+// values of declared headers match their schemas
+func (r *Runner) checkHeaders(declared domain.Response, header http.Header) []domain.ValidationError {
+	var errs []domain.ValidationError
+	for _, name := range sortedKeys(declared.Headers) {
+		if strings.EqualFold(name, "Content-Type") {
+			continue
+		}
+		object, _ := r.component("headers", declared.Headers[name]).(map[string]interface{})
+		pointer := resolver.JoinPointer("/headers", name)
+
+		values := header.Values(name)
+		if len(values) == 0 {
+			if object["required"] == true {
+				errs = append(errs, domain.ValidationError{
+					Path:    pointer,
+					Message: fmt.Sprintf("missing required header %s", name),
+					Keyword: "required",
+				})
+			}
+			continue
+		}
+
+		if schema := object["schema"]; schema != nil {
+			errs = append(errs, r.validate(schema, r.headerValue(schema, values), pointer)...)
+		}
+	}
+	return errs
+}
+
+// headerValue converts the values of a header to the type its schema
+// declares; arrays are comma-separated lists
+func (r *Runner) headerValue(schema interface{}, values []string) interface{} {
+	object := r.resolve(schema)
+	if typeOf(object) != "array" {
+		return coerce(strings.Join(values, ","), typeOf(object))
+	}
+
+	itemType := typeOf(r.resolve(object["items"]))
+	var list []interface{}
+	for _, value := range values {
+		for _, item := range strings.Split(value, ",") {
+			list = append(list, coerce(strings.TrimSpace(item), itemType))
+		}
+	}
+	return list
+}
+
+// checkBody checks that a response body is in a declared media type and,
+// for JSON, that it matches the schema of that media type
+func (r *Runner) checkBody(declared domain.Response, contentType string, body []byte) []domain.ValidationError {
+	if len(declared.Content) == 0 {
+		if len(bytes.TrimSpace(body)) > 0 {
+			return []domain.ValidationError{{
+				Path:    "/body",
+				Message: "response has a body but no content is declared",
+				Keyword: "content",
+			}}
+		}
+		return nil
+	}
+
+	if len(body) == 0 {
+		return []domain.ValidationError{{
+			Path:    "/body",
+			Message: "response body is empty but content is declared",
+			Keyword: "content",
+		}}
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return []domain.ValidationError{{
+			Path:    "/headers/Content-Type",
+			Message: fmt.Sprintf("missing or invalid Content-Type %q", contentType),
+			Keyword: "content",
+		}}
+	}
+	media, ok := findMediaType(declared.Content, mediaType)
+	if !ok {
+		return []domain.ValidationError{{
+			Path:    "/headers/Content-Type",
+			Message: fmt.Sprintf("media type %s is not declared for this response", mediaType),
+			Keyword: "content",
+			Params:  map[string]interface{}{"declared": sortedKeys(declared.Content)},
+		}}
+	}
+	if !isJSON(mediaType) || media.Schema == nil {
+		return nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return []domain.ValidationError{{
+			Path:    "/body",
+			Message: fmt.Sprintf("response body is not valid JSON: %v", err),
+			Keyword: "json",
+		}}
+	}
+	return r.validate(media.Schema, value, "/body")
+}
+
+// validate checks a value against a schema, reporting errors below pointer
+func (r *Runner) validate(schema, value interface{}, pointer string) []domain.ValidationError {
+	errs := r.validator.Validate(schema, value)
+	for i := range errs {
+		if errs[i].Path == "/" {
+			errs[i].Path = pointer
+		} else {
+			errs[i].Path = pointer + errs[i].Path
+		}
+	}
+	return errs
+}
+
+// resolve follows references and returns the schema object, or nil
+func (r *Runner) resolve(schema interface{}) map[string]interface{} {
+	expanded, err := r.refs.Expand(schema)
+	if err != nil {
+		return nil
+	}
+	object, _ := expanded.(map[string]interface{})
+	return object
+}
+
+// typeOf returns the first non-null type a schema declares
+func typeOf(schema map[string]interface{}) string {
+	switch t := schema["type"].(type) {
+	case string:
+		return t
+	case []interface{}:
+		for _, item := range t {
+			if name, ok := item.(string); ok && name != "null" {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// coerce converts a header value to a number or boolean when the schema
+// asks for one; values that do not convert are left as strings for the
+// schema check to report
+func coerce(value, schemaType string) interface{} {
+	switch schemaType {
+	case "integer", "number":
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			return number
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return value
+}
+
+// findMediaType returns the declared media type that covers the given one,
+// preferring an exact match over type/* and */* ranges
+func findMediaType(content map[string]domain.MediaType, mediaType string) (domain.MediaType, bool) {
+	if media, ok := content[mediaType]; ok {
+		return media, true
+	}
+	major, _, _ := strings.Cut(mediaType, "/")
+	if media, ok := content[major+"/*"]; ok {
+		return media, true
+	}
+	media, ok := content["*/*"]
+	return media, ok
+}
+
+// isJSON reports whether a media type carries JSON, such as
+// application/json or application/problem+json
+func isJSON(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
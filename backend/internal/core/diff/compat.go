@@ -0,0 +1,465 @@
+package diff
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// direction says which way the data a change affects travels: input is
+// sent by clients (parameters, request bodies), output is sent to them
+// (responses). Named schemas can be used both ways.
+type direction int
+
+const (
+	input direction = 1 << iota
+	output
+	both = input | output
+)
+
+// location is where a change was made, read from its path
+type location struct {
+	webhook   bool
+	direction direction
+
+	// named is set when the change is to a whole named schema
+	named bool
+
+	// inSchema is set when the change is made within a schema, whose
+	// tokens below the schema root are then in schema
+	inSchema bool
+	schema   []string
+}
+
+// Classify marks each change as breaking or non-breaking for existing
+// clients. Narrowing what a client may send, or widening what it may
+// receive, is breaking; so is removing anything clients may rely on.
+// Webhooks are the other way round: the API is the client.
+func Classify(changes []domain.DiffChange) []domain.ClassifiedChange {
+	classified := make([]domain.ClassifiedChange, 0, len(changes))
+	for _, change := range changes {
+		breaking, reason := classify(change, locate(change.Path))
+		severity := domain.SeverityNonBreaking
+		if breaking {
+			severity = domain.SeverityBreaking
+		}
+		classified = append(classified, domain.ClassifiedChange{
+			DiffChange: change,
+			Severity:   severity,
+			Reason:     reason,
+		})
+	}
+	return classified
+}
+
+// MajorVersionBump reports whether the major part of a version, such as
+// the 2 of "v2.1.0", was increased. Versions that do not start with a
+// number are never bumped.
+func MajorVersionBump(old, new string) bool {
+	oldMajor, oldOK := majorVersion(old)
+	newMajor, newOK := majorVersion(new)
+	return oldOK && newOK && newMajor > oldMajor
+}
+
+func majorVersion(version string) (int, bool) {
+	version = strings.TrimLeft(strings.TrimSpace(version), "vV")
+	if end := strings.IndexAny(version, ".-+"); end >= 0 {
+		version = version[:end]
+	}
+	major, err := strconv.Atoi(version)
+	return major, err == nil
+}
+
+func locate(path string) location {
+	tokens, _ := resolver.SplitPointer(path)
+	var loc location
+	if len(tokens) < 2 {
+		return loc
+	}
+
+	switch tokens[0] {
+	case "schemas":
+		loc.direction = both
+		loc.inSchema = true
+		loc.schema = tokens[2:]
+		loc.named = len(loc.schema) == 0
+
+	case "endpoints", "webhooks":
+		loc.webhook = tokens[0] == "webhooks"
+		if len(tokens) < 3 {
+			return loc
+		}
+		part, rest := tokens[2], tokens[3:]
+		switch part {
+		case "parameters", "requestBody":
+			loc.direction = input
+		case "responses":
+			loc.direction = output
+		}
+		if loc.webhook {
+			loc.direction = both &^ loc.direction
+		}
+		if start := schemaStart(part, rest); start >= 0 {
+			loc.inSchema = true
+			loc.schema = rest[start:]
+		}
+	}
+
+	return loc
+}
+
+// schemaStart returns where the schema tokens begin in the path below an
+// endpoint's parameters, request body or responses, or -1 when the path
+// does not lead into a schema
+func schemaStart(part string, tokens []string) int {
+	i := 0
+	switch part {
+	case "parameters":
+		i = 2 // in, name
+	case "responses":
+		i = 1 // status code
+		if len(tokens) > i && tokens[i] == "headers" {
+			i += 2
+		}
+	case "requestBody":
+	default:
+		return -1
+	}
+
+	if len(tokens) > i && tokens[i] == "content" {
+		i += 2
+	}
+	if len(tokens) > i && tokens[i] == "schema" {
+		return i + 1
+	}
+	return -1
+}
+
+func classify(change domain.DiffChange, loc location) (bool, string) {
+	if loc.inSchema {
+		return classifySchema(change, loc)
+	}
+
+	added := change.Type == domain.ChangeAdded
+	removed := change.Type == domain.ChangeRemoved
+
+	switch change.Kind {
+	case "metadata":
+		return false, "Metadata does not affect requests or responses"
+
+	case "server":
+		if removed {
+			return true, "Server removed"
+		}
+		return false, "Server added or described differently"
+
+	case "security":
+		return true, "Security requirements changed"
+
+	case "securityScheme":
+		if added {
+			return false, "Security scheme added"
+		}
+		return true, "Security scheme removed or changed"
+
+	case "endpoint":
+		switch {
+		case added && field(change.Path) == "":
+			return false, "Endpoint added"
+		case removed && field(change.Path) == "":
+			return true, "Endpoint removed"
+		}
+		switch field(change.Path) {
+		case "servers":
+			return true, "Endpoint servers changed"
+		case "callbacks":
+			return true, "Callbacks changed"
+		}
+		return false, "Endpoint documentation changed"
+
+	case "parameter":
+		switch {
+		case added:
+			if isRequired(change.New) {
+				return true, "Required parameter added"
+			}
+			return false, "Optional parameter added"
+		case removed:
+			return true, "Parameter removed"
+		}
+		switch field(change.Path) {
+		case "required":
+			if isTrue(change.New) {
+				return true, "Parameter made required"
+			}
+			return false, "Parameter made optional"
+		case "style", "explode", "allowEmptyValue", "allowReserved":
+			return true, "Parameter serialization changed"
+		}
+		return false, "Parameter documentation changed"
+
+	case "requestBody":
+		switch {
+		case added:
+			if isRequired(change.New) {
+				return true, "Required request body added"
+			}
+			return false, "Optional request body added"
+		case removed:
+			return true, "Request body removed"
+		}
+		if field(change.Path) == "required" {
+			if isTrue(change.New) {
+				return true, "Request body made required"
+			}
+			return false, "Request body made optional"
+		}
+		return false, "Request body documentation changed"
+
+	case "response":
+		switch {
+		case added:
+			return false, "Response added"
+		case removed:
+			return true, "Response removed"
+		}
+		return false, "Response documentation changed"
+
+	case "header":
+		switch {
+		case added:
+			return false, "Response header added"
+		case removed:
+			return true, "Response header removed"
+		}
+		if field(change.Path) == "required" && !isTrue(change.New) {
+			return true, "Response header no longer required"
+		}
+		return false, "Response header documentation changed"
+
+	case "mediaType":
+		if added {
+			return false, "Media type added"
+		}
+		return true, "Media type removed"
+	}
+
+	return true, "Unrecognized change"
+}
+
+// classifySchema classifies a change made within a schema by whether it
+// narrows or widens the values the schema allows
+func classifySchema(change domain.DiffChange, loc location) (bool, string) {
+	added := change.Type == domain.ChangeAdded
+	removed := change.Type == domain.ChangeRemoved
+
+	switch change.Kind {
+	case "property":
+		if added {
+			return false, "Property added"
+		}
+		return loc.direction&output != 0, "Property removed"
+
+	case "required":
+		if added {
+			return loc.direction&input != 0, "Property made required"
+		}
+		return loc.direction&output != 0, "Property no longer required"
+
+	case "enum":
+		if added {
+			return loc.direction&output != 0, "Enum value added"
+		}
+		return loc.direction&input != 0, "Enum value removed"
+	}
+
+	keyword := schemaKeyword(loc.schema)
+	switch keyword {
+	case "":
+		if loc.named {
+			if added {
+				return false, "Schema added"
+			}
+			if removed {
+				return true, "Schema removed"
+			}
+		}
+		_, oldIsBool := change.Old.(bool)
+		newBool, newIsBool := change.New.(bool)
+		switch {
+		case oldIsBool && newIsBool:
+			return narrowing(loc, !newBool, newBool, "Schema made stricter", "Schema made looser")
+		case added:
+			return narrowing(loc, true, false, "Schema added", "")
+		case removed:
+			return narrowing(loc, false, true, "", "Schema removed")
+		}
+		return true, "Schema replaced"
+
+	case "type", "types", "format", "const", "discriminator":
+		switch {
+		case added:
+			return narrowing(loc, true, false, "Schema "+keyword+" added", "")
+		case removed:
+			return narrowing(loc, false, true, "", "Schema "+keyword+" removed")
+		}
+		return true, "Schema " + keyword + " changed"
+
+	case "minLength", "minimum", "minItems", "minProperties":
+		return bound(change, loc, keyword, 1)
+
+	case "maxLength", "maximum", "maxItems", "maxProperties":
+		return bound(change, loc, keyword, -1)
+
+	case "pattern", "multipleOf", "enum":
+		switch {
+		case added:
+			return narrowing(loc, true, false, "Constraint "+keyword+" added", "")
+		case removed:
+			return narrowing(loc, false, true, "", "Constraint "+keyword+" removed")
+		}
+		return true, "Constraint " + keyword + " changed"
+
+	case "exclusiveMinimum", "exclusiveMaximum", "uniqueItems":
+		stricter := isTrue(change.New) || (added && !isFalse(change.New))
+		return narrowing(loc, stricter, !stricter, "Constraint "+keyword+" added", "Constraint "+keyword+" removed")
+
+	case "nullable":
+		looser := isTrue(change.New)
+		return narrowing(loc, !looser, looser, "Null no longer allowed", "Null allowed")
+
+	case "title", "description", "example", "examples", "default", "deprecated",
+		"externalDocs", "xml", "extensions", "readOnly", "writeOnly", "$defs":
+		return false, "Schema documentation changed"
+	}
+
+	if strings.HasPrefix(keyword, "x-") {
+		return false, "Schema extension changed"
+	}
+	return true, "Schema " + keyword + " changed"
+}
+
+// bound classifies a change to a lower (sign 1) or upper (sign -1) bound
+func bound(change domain.DiffChange, loc location, keyword string, sign float64) (bool, string) {
+	stricter, looser := "Constraint "+keyword+" tightened", "Constraint "+keyword+" relaxed"
+	switch change.Type {
+	case domain.ChangeAdded:
+		return narrowing(loc, true, false, stricter, looser)
+	case domain.ChangeRemoved:
+		return narrowing(loc, false, true, stricter, looser)
+	}
+
+	old, oldOK := toFloat(change.Old)
+	new, newOK := toFloat(change.New)
+	if !oldOK || !newOK {
+		return true, "Constraint " + keyword + " changed"
+	}
+	tightened := (new-old)*sign > 0
+	return narrowing(loc, tightened, !tightened, stricter, looser)
+}
+
+// narrowing classifies a change that makes a schema stricter, which breaks
+// clients sending values, or looser, which breaks clients receiving them
+func narrowing(loc location, stricter, looser bool, stricterReason, looserReason string) (bool, string) {
+	if stricter {
+		return loc.direction&input != 0, stricterReason
+	}
+	if looser {
+		return loc.direction&output != 0, looserReason
+	}
+	return false, stricterReason
+}
+
+// schemaKeyword returns the keyword a change within a schema is made to,
+// given the path tokens below the schema root, or "" when the change
+// replaces a whole (sub)schema
+func schemaKeyword(tokens []string) string {
+	for i := 0; i < len(tokens); {
+		switch tokens[i] {
+		case "properties", "allOf", "oneOf", "anyOf", "prefixItems":
+			i += 2
+		case "items", "additionalProperties", "unevaluatedProperties", "not":
+			i++
+		default:
+			return tokens[i]
+		}
+	}
+	return ""
+}
+
+// field returns the field of an endpoint, parameter, request body, response
+// or header a change was made to, or "" when the change is to all of it
+func field(path string) string {
+	tokens, _ := resolver.SplitPointer(path)
+	n := len(tokens)
+	switch {
+	case n == 2:
+		return ""
+	case n >= 3 && tokens[0] != "endpoints" && tokens[0] != "webhooks":
+		return tokens[n-1]
+	}
+
+	rest := tokens[2:]
+	switch rest[0] {
+	case "parameters":
+		if len(rest) == 3 {
+			return ""
+		}
+	case "requestBody":
+		if len(rest) == 1 {
+			return ""
+		}
+	case "responses":
+		if len(rest) == 2 {
+			return ""
+		}
+		if rest[2] == "headers" && len(rest) == 4 {
+			return ""
+		}
+	default:
+		return rest[0]
+	}
+	return tokens[n-1]
+}
+
+func isRequired(value interface{}) bool {
+	switch v := value.(type) {
+	case domain.Parameter:
+		return v.Required || v.In == "path"
+	case domain.RequestBody:
+		return v.Required
+	}
+	return false
+}
+
+func isTrue(value interface{}) bool {
+	b, ok := value.(bool)
+	return ok && b
+}
+
+func isFalse(value interface{}) bool {
+	b, ok := value.(bool)
+	return ok && !b
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,236 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// TestClassify classifies single changes the way Compare reports them
+func TestClassify(t *testing.T) {
+	const (
+		listPets  = "/endpoints/GET ~1pets"
+		createPet = "/endpoints/POST ~1pets"
+		petJSON   = "/content/application~1json/schema"
+	)
+	tests := []struct {
+		name     string
+		change   domain.DiffChange
+		breaking bool
+		reason   string
+	}{
+		{
+			name:     "removed operation",
+			change:   domain.DiffChange{Type: domain.ChangeRemoved, Kind: "endpoint", Path: "/endpoints/DELETE ~1pets~1{id}"},
+			breaking: true,
+			reason:   "Endpoint removed",
+		},
+		{
+			name:   "added operation",
+			change: domain.DiffChange{Type: domain.ChangeAdded, Kind: "endpoint", Path: "/endpoints/PUT ~1pets~1{id}"},
+			reason: "Endpoint added",
+		},
+		{
+			name: "required parameter added",
+			change: domain.DiffChange{Type: domain.ChangeAdded, Kind: "parameter", Path: listPets + "/parameters/query/owner",
+				New: domain.Parameter{Name: "owner", In: "query", Required: true}},
+			breaking: true,
+			reason:   "Required parameter added",
+		},
+		{
+			name: "path parameter added, required or not",
+			change: domain.DiffChange{Type: domain.ChangeAdded, Kind: "parameter", Path: listPets + "/parameters/path/shop",
+				New: domain.Parameter{Name: "shop", In: "path"}},
+			breaking: true,
+			reason:   "Required parameter added",
+		},
+		{
+			name: "optional parameter added",
+			change: domain.DiffChange{Type: domain.ChangeAdded, Kind: "parameter", Path: listPets + "/parameters/query/limit",
+				New: domain.Parameter{Name: "limit", In: "query"}},
+			reason: "Optional parameter added",
+		},
+		{
+			name:     "parameter made required",
+			change:   domain.DiffChange{Type: domain.ChangeModified, Kind: "parameter", Path: listPets + "/parameters/query/limit/required", Old: false, New: true},
+			breaking: true,
+			reason:   "Parameter made required",
+		},
+		{
+			name:     "response field removed",
+			change:   domain.DiffChange{Type: domain.ChangeRemoved, Kind: "property", Path: listPets + "/responses/200" + petJSON + "/items/properties/name"},
+			breaking: true,
+			reason:   "Property removed",
+		},
+		{
+			name:   "request field removed",
+			change: domain.DiffChange{Type: domain.ChangeRemoved, Kind: "property", Path: createPet + "/requestBody" + petJSON + "/properties/nickname"},
+			reason: "Property removed",
+		},
+		{
+			name:     "named schema field removed",
+			change:   domain.DiffChange{Type: domain.ChangeRemoved, Kind: "property", Path: "/schemas/Pet/properties/name"},
+			breaking: true,
+			reason:   "Property removed",
+		},
+		{
+			name:     "request field made required",
+			change:   domain.DiffChange{Type: domain.ChangeAdded, Kind: "required", Path: createPet + "/requestBody" + petJSON + "/required/name", New: "name"},
+			breaking: true,
+			reason:   "Property made required",
+		},
+		{
+			name:     "enum value removed from a request",
+			change:   domain.DiffChange{Type: domain.ChangeRemoved, Kind: "enum", Path: listPets + "/parameters/query/status/schema/enum/sold", Old: "sold"},
+			breaking: true,
+			reason:   "Enum value removed",
+		},
+		{
+			name:   "enum value removed from a response",
+			change: domain.DiffChange{Type: domain.ChangeRemoved, Kind: "enum", Path: listPets + "/responses/200" + petJSON + "/items/properties/status/enum/sold", Old: "sold"},
+			reason: "Enum value removed",
+		},
+		{
+			name:     "enum value added to a response",
+			change:   domain.DiffChange{Type: domain.ChangeAdded, Kind: "enum", Path: listPets + "/responses/200" + petJSON + "/items/properties/status/enum/lost", New: "lost"},
+			breaking: true,
+			reason:   "Enum value added",
+		},
+		{
+			name:     "type narrowed in a request",
+			change:   domain.DiffChange{Type: domain.ChangeModified, Kind: "schema", Path: createPet + "/requestBody" + petJSON + "/properties/age/type", Old: "number", New: "integer"},
+			breaking: true,
+			reason:   "Schema type changed",
+		},
+		{
+			name:     "null no longer allowed in a request",
+			change:   domain.DiffChange{Type: domain.ChangeModified, Kind: "schema", Path: createPet + "/requestBody" + petJSON + "/properties/tag/nullable", Old: true, New: false},
+			breaking: true,
+			reason:   "Null no longer allowed",
+		},
+		{
+			name:     "maximum lowered for a request",
+			change:   domain.DiffChange{Type: domain.ChangeModified, Kind: "schema", Path: createPet + "/requestBody" + petJSON + "/properties/age/maximum", Old: 30, New: 20.0},
+			breaking: true,
+			reason:   "Constraint maximum tightened",
+		},
+		{
+			name:   "maximum lowered for a response",
+			change: domain.DiffChange{Type: domain.ChangeModified, Kind: "schema", Path: listPets + "/responses/200" + petJSON + "/items/properties/age/maximum", Old: 30, New: 20},
+			reason: "Constraint maximum tightened",
+		},
+		{
+			name:     "minLength raised on a named schema",
+			change:   domain.DiffChange{Type: domain.ChangeModified, Kind: "schema", Path: "/schemas/Pet/properties/name/minLength", Old: 1, New: 3},
+			breaking: true,
+			reason:   "Constraint minLength tightened",
+		},
+		{
+			name:   "description changed",
+			change: domain.DiffChange{Type: domain.ChangeModified, Kind: "schema", Path: "/schemas/Pet/properties/name/description", Old: "Name", New: "The name"},
+			reason: "Schema documentation changed",
+		},
+		{
+			name:     "response removed",
+			change:   domain.DiffChange{Type: domain.ChangeRemoved, Kind: "response", Path: listPets + "/responses/404"},
+			breaking: true,
+			reason:   "Response removed",
+		},
+		{
+			name:     "webhook payload field removed, which the API sends",
+			change:   domain.DiffChange{Type: domain.ChangeRemoved, Kind: "property", Path: "/webhooks/POST newPet/requestBody" + petJSON + "/properties/name"},
+			breaking: true,
+			reason:   "Property removed",
+		},
+		{
+			name:   "metadata changed",
+			change: domain.DiffChange{Type: domain.ChangeModified, Kind: "metadata", Path: "/metadata/description", Old: "Pets", New: "All pets"},
+			reason: "Metadata does not affect requests or responses",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			classified := Classify([]domain.DiffChange{tt.change})
+			if len(classified) != 1 {
+				t.Fatalf("%d classified changes, want 1", len(classified))
+			}
+			want := domain.SeverityNonBreaking
+			if tt.breaking {
+				want = domain.SeverityBreaking
+			}
+			if got := classified[0]; got.Severity != want || got.Reason != tt.reason {
+				t.Errorf("classified as %s (%s), want %s (%s)", got.Severity, got.Reason, want, tt.reason)
+			}
+		})
+	}
+}
+
+// TestClassifyComparedDefinitions classifies the changes Compare finds
+// between two versions of a definition
+func TestClassifyComparedDefinitions(t *testing.T) {
+	old := &domain.APIDefinition{
+		Endpoints: []domain.Endpoint{
+			{ID: "list", Method: "GET", Path: "/pets", Responses: map[string]string{"200": "ok"}},
+			{ID: "delete", Method: "DELETE", Path: "/pets/{id}"},
+		},
+		Responses: []domain.Response{{ID: "ok", Content: map[string]domain.MediaType{
+			"application/json": {Schema: map[string]interface{}{
+				"type":       "object",
+				"properties": map[string]interface{}{"name": map[string]interface{}{"type": "string"}},
+			}},
+		}}},
+	}
+	new := &domain.APIDefinition{
+		Endpoints: []domain.Endpoint{
+			{ID: "list", Method: "GET", Path: "/pets", Parameters: []string{"owner"}, Responses: map[string]string{"200": "ok"}},
+		},
+		Parameters: []domain.Parameter{{ID: "owner", Name: "owner", In: "query", Required: true}},
+		Responses: []domain.Response{{ID: "ok", Content: map[string]domain.MediaType{
+			"application/json": {Schema: map[string]interface{}{"type": "object"}},
+		}}},
+	}
+
+	got := map[string]string{}
+	for _, change := range Classify(Compare(old, new)) {
+		got[change.Path] = string(change.Severity) + ": " + change.Reason
+	}
+	want := map[string]string{
+		"/endpoints/DELETE ~1pets~1{id}":                                                       "breaking: Endpoint removed",
+		"/endpoints/GET ~1pets/parameters/query/owner":                                         "breaking: Required parameter added",
+		"/endpoints/GET ~1pets/responses/200/content/application~1json/schema/properties/name": "breaking: Property removed",
+	}
+	for path, reason := range want {
+		if got[path] != reason {
+			t.Errorf("%s: got %q, want %q", path, got[path], reason)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("changes = %v, want only %v", got, want)
+	}
+}
+
+// TestMajorVersionBump decides whether a version increased its major part
+func TestMajorVersionBump(t *testing.T) {
+	tests := []struct {
+		old, new string
+		want     bool
+	}{
+		{"1.0.0", "2.0.0", true},
+		{"1.9.3", "2.0.0-beta", true},
+		{"v1.2", "v2", true},
+		{"V1", "3.1.0", true},
+		{" 1.0.0", "2.0.0+build", true},
+		{"1.0.0", "1.1.0", false},
+		{"1.0.0", "1.0.0", false},
+		{"2.0.0", "1.0.0", false},
+		{"10.0.0", "9.0.0", false},
+		{"beta", "2.0.0", false},
+		{"1.0.0", "", false},
+	}
+	for _, tt := range tests {
+		if got := MajorVersionBump(tt.old, tt.new); got != tt.want {
+			t.Errorf("MajorVersionBump(%q, %q) = %t, want %t", tt.old, tt.new, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,440 @@
+// Package diff compares two API definitions at the level of endpoints,
+// parameters, request bodies, responses and schema properties, rather than
+// line by line, and renders the result for review.
+package diff
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// Compare lists the changes from old to new, in the order of the sections
+// they belong to and then of their paths
+func Compare(old, new *domain.APIDefinition) []domain.DiffChange {
+	c := &comparer{old: newSide(old), new: newSide(new)}
+
+	c.metadata()
+	c.servers()
+	c.endpoints("/endpoints", old.Endpoints, new.Endpoints)
+	c.endpoints("/webhooks", old.Webhooks, new.Webhooks)
+	c.schemas()
+	c.securitySchemes()
+	if !equal(old.Security, new.Security) {
+		c.add(domain.ChangeModified, "security", "/security", "", old.Security, new.Security)
+	}
+
+	return c.changes
+}
+
+// Summarize counts the changes of each type
+func Summarize(changes []domain.DiffChange) domain.DiffSummary {
+	var summary domain.DiffSummary
+	for _, change := range changes {
+		switch change.Type {
+		case domain.ChangeAdded:
+			summary.Added++
+		case domain.ChangeRemoved:
+			summary.Removed++
+		case domain.ChangeModified:
+			summary.Modified++
+		}
+	}
+	return summary
+}
+
+// side indexes the parts of one definition that endpoints refer to by ID
+type side struct {
+	api         *domain.APIDefinition
+	schemaNames map[string]string
+	parameters  map[string]domain.Parameter
+	bodies      map[string]domain.RequestBody
+	responses   map[string]domain.Response
+}
+
+func newSide(api *domain.APIDefinition) *side {
+	s := &side{
+		api:         api,
+		schemaNames: make(map[string]string, len(api.Schemas)),
+		parameters:  make(map[string]domain.Parameter, len(api.Parameters)),
+		bodies:      make(map[string]domain.RequestBody, len(api.RequestBodies)),
+		responses:   make(map[string]domain.Response, len(api.Responses)),
+	}
+	for _, schema := range api.Schemas {
+		s.schemaNames[schema.ID] = schema.Name
+	}
+	for _, param := range api.Parameters {
+		s.parameters[param.ID] = param
+	}
+	for _, body := range api.RequestBodies {
+		s.bodies[body.ID] = body
+	}
+	for _, response := range api.Responses {
+		s.responses[response.ID] = response
+	}
+	return s
+}
+
+type comparer struct {
+	old, new *side
+	changes  []domain.DiffChange
+}
+
+func (c *comparer) add(changeType domain.ChangeType, kind, path, endpoint string, old, new interface{}) {
+	c.changes = append(c.changes, domain.DiffChange{
+		Type:     changeType,
+		Kind:     kind,
+		Path:     path,
+		Endpoint: endpoint,
+		Old:      old,
+		New:      new,
+	})
+}
+
+// fields compares two objects key by key, reporting each key that was
+// added, removed or changed; skipped keys are compared by the caller
+func (c *comparer) fields(kind, path, endpoint string, old, new map[string]interface{}, skip ...string) {
+	skipped := make(map[string]bool, len(skip))
+	for _, key := range skip {
+		skipped[key] = true
+	}
+
+	for _, key := range unionKeys(old, new) {
+		if skipped[key] {
+			continue
+		}
+		oldValue, inOld := old[key]
+		newValue, inNew := new[key]
+		switch {
+		case !inOld:
+			c.add(domain.ChangeAdded, kind, resolver.JoinPointer(path, key), endpoint, nil, newValue)
+		case !inNew:
+			c.add(domain.ChangeRemoved, kind, resolver.JoinPointer(path, key), endpoint, oldValue, nil)
+		case !equal(oldValue, newValue):
+			c.add(domain.ChangeModified, kind, resolver.JoinPointer(path, key), endpoint, oldValue, newValue)
+		}
+	}
+}
+
+func (c *comparer) metadata() {
+	c.fields("metadata", "/metadata", "", toMap(c.old.api.Metadata), toMap(c.new.api.Metadata), "servers", "baseUrl", "tags")
+}
+
+func (c *comparer) servers() {
+	old := keyed(c.old.api.Metadata.Servers, func(s domain.Server) string { return s.URL })
+	new := keyed(c.new.api.Metadata.Servers, func(s domain.Server) string { return s.URL })
+
+	for _, url := range unionKeys(old, new) {
+		path := resolver.JoinPointer("/servers", url)
+		oldServer, inOld := old[url]
+		newServer, inNew := new[url]
+		switch {
+		case !inOld:
+			c.add(domain.ChangeAdded, "server", path, "", nil, newServer)
+		case !inNew:
+			c.add(domain.ChangeRemoved, "server", path, "", oldServer, nil)
+		default:
+			c.fields("server", path, "", toMap(oldServer), toMap(newServer), "url")
+		}
+	}
+}
+
+func (c *comparer) endpoints(section string, oldList, newList []domain.Endpoint) {
+	old := keyed(oldList, endpointName)
+	new := keyed(newList, endpointName)
+
+	for _, name := range unionKeys(old, new) {
+		path := resolver.JoinPointer(section, name)
+		oldEndpoint, inOld := old[name]
+		newEndpoint, inNew := new[name]
+		switch {
+		case !inOld:
+			c.add(domain.ChangeAdded, "endpoint", path, name, nil, newEndpoint)
+		case !inNew:
+			c.add(domain.ChangeRemoved, "endpoint", path, name, oldEndpoint, nil)
+		default:
+			c.endpoint(path, name, oldEndpoint, newEndpoint)
+		}
+	}
+}
+
+// endpointName identifies an operation across versions
+func endpointName(e domain.Endpoint) string {
+	return e.Method + " " + e.Path
+}
+
+func (c *comparer) endpoint(path, name string, old, new domain.Endpoint) {
+	c.fields("endpoint", path, name, toMap(old), toMap(new),
+		"id", "path", "method", "tags", "parameters", "requestBody", "responses", "security")
+
+	c.stringSet("endpoint", resolver.JoinPointer(path, "tags"), name, old.Tags, new.Tags)
+	if !equal(old.Security, new.Security) {
+		c.add(domain.ChangeModified, "security", resolver.JoinPointer(path, "security"), name, old.Security, new.Security)
+	}
+
+	c.parameters(resolver.JoinPointer(path, "parameters"), name, old.Parameters, new.Parameters)
+	c.requestBody(resolver.JoinPointer(path, "requestBody"), name, old.RequestBody, new.RequestBody)
+	c.responses(resolver.JoinPointer(path, "responses"), name, old.Responses, new.Responses)
+}
+
+func (c *comparer) parameters(path, endpoint string, oldIDs, newIDs []string) {
+	// Parameters are identified by where they are sent and their name
+	index := func(s *side, ids []string) map[string]domain.Parameter {
+		params := make(map[string]domain.Parameter, len(ids))
+		for _, id := range ids {
+			if param, ok := s.parameters[id]; ok {
+				params[param.In+"/"+param.Name] = param
+			}
+		}
+		return params
+	}
+	old := index(c.old, oldIDs)
+	new := index(c.new, newIDs)
+
+	for _, key := range unionKeys(old, new) {
+		oldParam, inOld := old[key]
+		newParam, inNew := new[key]
+		paramPath := resolver.JoinPointer(path, paramLocation(oldParam, newParam), paramName(oldParam, newParam))
+		switch {
+		case !inOld:
+			c.add(domain.ChangeAdded, "parameter", paramPath, endpoint, nil, newParam)
+		case !inNew:
+			c.add(domain.ChangeRemoved, "parameter", paramPath, endpoint, oldParam, nil)
+		default:
+			c.fields("parameter", paramPath, endpoint, toMap(oldParam), toMap(newParam),
+				"id", "component", "name", "in", "schema", "content", "example", "examples")
+			c.schema(resolver.JoinPointer(paramPath, "schema"), endpoint, oldParam.Schema, newParam.Schema)
+			c.content(resolver.JoinPointer(paramPath, "content"), endpoint, oldParam.Content, newParam.Content)
+		}
+	}
+}
+
+func paramLocation(old, new domain.Parameter) string {
+	if old.In != "" {
+		return old.In
+	}
+	return new.In
+}
+
+func paramName(old, new domain.Parameter) string {
+	if old.Name != "" {
+		return old.Name
+	}
+	return new.Name
+}
+
+func (c *comparer) requestBody(path, endpoint, oldID, newID string) {
+	old, inOld := c.old.bodies[oldID]
+	new, inNew := c.new.bodies[newID]
+	switch {
+	case !inOld && !inNew:
+	case !inOld:
+		c.add(domain.ChangeAdded, "requestBody", path, endpoint, nil, new)
+	case !inNew:
+		c.add(domain.ChangeRemoved, "requestBody", path, endpoint, old, nil)
+	default:
+		c.fields("requestBody", path, endpoint, toMap(old), toMap(new), "id", "component", "content")
+		c.content(resolver.JoinPointer(path, "content"), endpoint, old.Content, new.Content)
+	}
+}
+
+func (c *comparer) responses(path, endpoint string, oldIDs, newIDs map[string]string) {
+	for _, code := range unionKeys(oldIDs, newIDs) {
+		codePath := resolver.JoinPointer(path, code)
+		old, inOld := c.old.responses[oldIDs[code]]
+		new, inNew := c.new.responses[newIDs[code]]
+		switch {
+		case !inOld && !inNew:
+		case !inOld:
+			c.add(domain.ChangeAdded, "response", codePath, endpoint, nil, new)
+		case !inNew:
+			c.add(domain.ChangeRemoved, "response", codePath, endpoint, old, nil)
+		default:
+			c.fields("response", codePath, endpoint, toMap(old), toMap(new), "id", "component", "content", "headers")
+			c.headers(resolver.JoinPointer(codePath, "headers"), endpoint, old.Headers, new.Headers)
+			c.content(resolver.JoinPointer(codePath, "content"), endpoint, old.Content, new.Content)
+		}
+	}
+}
+
+func (c *comparer) headers(path, endpoint string, old, new map[string]interface{}) {
+	for _, name := range unionKeys(old, new) {
+		headerPath := resolver.JoinPointer(path, name)
+		oldHeader, inOld := old[name]
+		newHeader, inNew := new[name]
+		switch {
+		case !inOld:
+			c.add(domain.ChangeAdded, "header", headerPath, endpoint, nil, newHeader)
+		case !inNew:
+			c.add(domain.ChangeRemoved, "header", headerPath, endpoint, oldHeader, nil)
+		default:
+			oldMap, _ := oldHeader.(map[string]interface{})
+			newMap, _ := newHeader.(map[string]interface{})
+			c.fields("header", headerPath, endpoint, oldMap, newMap, "schema", "example", "examples")
+			c.schema(resolver.JoinPointer(headerPath, "schema"), endpoint, oldMap["schema"], newMap["schema"])
+		}
+	}
+}
+
+func (c *comparer) content(path, endpoint string, old, new map[string]domain.MediaType) {
+	for _, mediaType := range unionKeys(old, new) {
+		mediaPath := resolver.JoinPointer(path, mediaType)
+		oldMedia, inOld := old[mediaType]
+		newMedia, inNew := new[mediaType]
+		switch {
+		case !inOld:
+			c.add(domain.ChangeAdded, "mediaType", mediaPath, endpoint, nil, newMedia)
+		case !inNew:
+			c.add(domain.ChangeRemoved, "mediaType", mediaPath, endpoint, oldMedia, nil)
+		default:
+			c.schema(resolver.JoinPointer(mediaPath, "schema"), endpoint, oldMedia.Schema, newMedia.Schema)
+		}
+	}
+}
+
+func (c *comparer) schemas() {
+	old := keyed(c.old.api.Schemas, func(s domain.Schema) string { return s.Name })
+	new := keyed(c.new.api.Schemas, func(s domain.Schema) string { return s.Name })
+
+	for _, name := range unionKeys(old, new) {
+		path := resolver.JoinPointer("/schemas", name)
+		oldSchema, inOld := old[name]
+		newSchema, inNew := new[name]
+		switch {
+		case !inOld:
+			c.add(domain.ChangeAdded, "schema", path, "", nil, newSchema)
+		case !inNew:
+			c.add(domain.ChangeRemoved, "schema", path, "", oldSchema, nil)
+		default:
+			oldMap, newMap := toMap(oldSchema), toMap(newSchema)
+			for _, key := range []string{"id", "name"} {
+				delete(oldMap, key)
+				delete(newMap, key)
+			}
+			c.schema(path, "", oldMap, newMap)
+		}
+	}
+}
+
+func (c *comparer) securitySchemes() {
+	name := func(s domain.SecurityScheme) string {
+		if s.Component != "" {
+			return s.Component
+		}
+		return s.ID
+	}
+	old := keyed(c.old.api.SecuritySchemes, name)
+	new := keyed(c.new.api.SecuritySchemes, name)
+
+	for _, key := range unionKeys(old, new) {
+		path := resolver.JoinPointer("/securitySchemes", key)
+		oldScheme, inOld := old[key]
+		newScheme, inNew := new[key]
+		switch {
+		case !inOld:
+			c.add(domain.ChangeAdded, "securityScheme", path, "", nil, newScheme)
+		case !inNew:
+			c.add(domain.ChangeRemoved, "securityScheme", path, "", oldScheme, nil)
+		default:
+			c.fields("securityScheme", path, "", toMap(oldScheme), toMap(newScheme), "id", "component")
+		}
+	}
+}
+
+// stringSet reports the strings added to and removed from a list
+func (c *comparer) stringSet(kind, path, endpoint string, old, new []string) {
+	oldSet := make(map[string]interface{}, len(old))
+	for _, value := range old {
+		oldSet[value] = true
+	}
+	newSet := make(map[string]interface{}, len(new))
+	for _, value := range new {
+		newSet[value] = true
+	}
+
+	for _, value := range unionKeys(oldSet, newSet) {
+		switch {
+		case oldSet[value] == nil:
+			c.add(domain.ChangeAdded, kind, resolver.JoinPointer(path, value), endpoint, nil, value)
+		case newSet[value] == nil:
+			c.add(domain.ChangeRemoved, kind, resolver.JoinPointer(path, value), endpoint, value, nil)
+		}
+	}
+}
+
+// keyed indexes a list by the key that identifies its items across versions
+func keyed[T any](items []T, key func(T) string) map[string]T {
+	index := make(map[string]T, len(items))
+	for _, item := range items {
+		index[key(item)] = item
+	}
+	return index
+}
+
+// unionKeys returns the keys of both maps in sorted order
+func unionKeys[V any](a, b map[string]V) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	keys := make([]string, 0, len(a)+len(b))
+	for _, m := range []map[string]V{a, b} {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// toMap returns the JSON object form of a value, so that values decoded
+// from different sources compare alike. Flags that the JSON form leaves out
+// when false are written out, so that turning one on is a modification of
+// false rather than an addition.
+func toMap(value interface{}) map[string]interface{} {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(value))
+	if v.Kind() != reflect.Struct {
+		return m
+	}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if field.Type.Kind() != reflect.Bool || name == "" || name == "-" {
+			continue
+		}
+		if _, ok := m[name]; !ok {
+			m[name] = false
+		}
+	}
+	return m
+}
+
+// equal compares values by their JSON form, so that integers read from YAML
+// equal the same numbers read from JSON
+func equal(a, b interface{}) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+	aData, aErr := json.Marshal(a)
+	bData, bErr := json.Marshal(b)
+	if aErr != nil || bErr != nil {
+		return false
+	}
+	var aValue, bValue interface{}
+	json.Unmarshal(aData, &aValue)
+	json.Unmarshal(bData, &bValue)
+	return reflect.DeepEqual(aValue, bValue)
+}
@@ -0,0 +1,293 @@
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// petsDefinition returns a small definition for each test to change
+func petsDefinition() *domain.APIDefinition {
+	return &domain.APIDefinition{
+		Metadata: domain.APIMetadata{
+			Name:    "Pets",
+			Version: "1.0.0",
+			Servers: []domain.Server{{URL: "https://api.example.com/v1"}},
+		},
+		Endpoints: []domain.Endpoint{
+			{
+				ID: "endpoint-listpets", Method: "GET", Path: "/pets", Tags: []string{"pets"},
+				Parameters: []string{"param-limit"},
+				Responses:  map[string]string{"200": "response-pets"},
+			},
+			{
+				ID: "endpoint-createpet", Method: "POST", Path: "/pets",
+				RequestBody: "body-pet",
+				Responses:   map[string]string{"201": "response-pet"},
+			},
+		},
+		Parameters: []domain.Parameter{
+			{ID: "param-limit", Name: "limit", In: "query", Schema: map[string]interface{}{"type": "integer"}},
+		},
+		RequestBodies: []domain.RequestBody{
+			{ID: "body-pet", Content: map[string]domain.MediaType{"application/json": {Schema: "schema-pet"}}},
+		},
+		Responses: []domain.Response{
+			{ID: "response-pets", Description: "The pets", Content: map[string]domain.MediaType{
+				"application/json": {Schema: map[string]interface{}{"type": "array", "items": map[string]interface{}{"$ref": "schema-pet"}}},
+			}},
+			{ID: "response-pet", Description: "The pet", Content: map[string]domain.MediaType{
+				"application/json": {Schema: "schema-pet"},
+			}},
+		},
+		Schemas: []domain.Schema{
+			{ID: "schema-pet", Name: "Pet", Type: "object", Required: []string{"name"}, Properties: map[string]interface{}{
+				"name":   map[string]interface{}{"type": "string"},
+				"status": map[string]interface{}{"type": "string", "enum": []interface{}{"available", "sold"}},
+			}},
+		},
+	}
+}
+
+// TestCompare changes one thing at a time in a definition and lists each
+// change Compare finds as its type, kind and path
+func TestCompare(t *testing.T) {
+	const listPets = "/endpoints/GET ~1pets"
+	tests := []struct {
+		name   string
+		change func(api *domain.APIDefinition)
+		want   []string
+	}{
+		{
+			name:   "nothing",
+			change: func(api *domain.APIDefinition) {},
+		},
+		{
+			name: "IDs alone",
+			change: func(api *domain.APIDefinition) {
+				api.Parameters[0].ID = "param-1"
+				api.Endpoints[0].ID = "endpoint-1"
+				api.Endpoints[0].Parameters = []string{"param-1"}
+			},
+		},
+		{
+			name:   "metadata",
+			change: func(api *domain.APIDefinition) { api.Metadata.Version = "1.1.0" },
+			want:   []string{"modified metadata /metadata/version"},
+		},
+		{
+			name: "servers",
+			change: func(api *domain.APIDefinition) {
+				api.Metadata.Servers = []domain.Server{{URL: "https://api.example.com/v1", Description: "Production"}, {URL: "https://sandbox.example.com/v1"}}
+			},
+			want: []string{
+				"added server /servers/https:~1~1api.example.com~1v1/description",
+				"added server /servers/https:~1~1sandbox.example.com~1v1",
+			},
+		},
+		{
+			name: "endpoint added and removed",
+			change: func(api *domain.APIDefinition) {
+				api.Endpoints[1].Method = "PUT"
+			},
+			want: []string{"removed endpoint /endpoints/POST ~1pets", "added endpoint /endpoints/PUT ~1pets"},
+		},
+		{
+			name: "endpoint fields and tags",
+			change: func(api *domain.APIDefinition) {
+				api.Endpoints[0].Summary = "List pets"
+				api.Endpoints[0].Tags = []string{"animals"}
+			},
+			want: []string{
+				"added endpoint " + listPets + "/summary",
+				"added endpoint " + listPets + "/tags/animals",
+				"removed endpoint " + listPets + "/tags/pets",
+			},
+		},
+		{
+			name: "parameter fields and schema",
+			change: func(api *domain.APIDefinition) {
+				api.Parameters[0].Required = true
+				api.Parameters[0].Schema = map[string]interface{}{"type": "integer", "maximum": 100}
+			},
+			want: []string{
+				"modified parameter " + listPets + "/parameters/query/limit/required",
+				"added schema " + listPets + "/parameters/query/limit/schema/maximum",
+			},
+		},
+		{
+			name: "parameter moved to another location",
+			change: func(api *domain.APIDefinition) {
+				api.Parameters[0].In = "header"
+			},
+			want: []string{
+				"added parameter " + listPets + "/parameters/header/limit",
+				"removed parameter " + listPets + "/parameters/query/limit",
+			},
+		},
+		{
+			name: "request body media type",
+			change: func(api *domain.APIDefinition) {
+				api.RequestBodies[0].Content["application/xml"] = domain.MediaType{Schema: "schema-pet"}
+			},
+			want: []string{"added mediaType /endpoints/POST ~1pets/requestBody/content/application~1xml"},
+		},
+		{
+			name: "response added and described differently",
+			change: func(api *domain.APIDefinition) {
+				api.Responses[0].Description = "All pets"
+				api.Responses = append(api.Responses, domain.Response{ID: "response-missing", Description: "Not found"})
+				api.Endpoints[0].Responses["404"] = "response-missing"
+			},
+			want: []string{
+				"modified response " + listPets + "/responses/200/description",
+				"added response " + listPets + "/responses/404",
+			},
+		},
+		{
+			name: "reference to another schema",
+			change: func(api *domain.APIDefinition) {
+				api.Schemas = append(api.Schemas, domain.Schema{ID: "schema-cat", Name: "Cat", Type: "object"})
+				api.Responses[1].Content["application/json"] = domain.MediaType{Schema: "schema-cat"}
+			},
+			want: []string{
+				"modified schema /endpoints/POST ~1pets/responses/201/content/application~1json/schema",
+				"added schema /schemas/Cat",
+			},
+		},
+		{
+			name: "schema renamed keeps references equal",
+			change: func(api *domain.APIDefinition) {
+				api.Schemas[0].ID = "schema-animal"
+				api.RequestBodies[0].Content["application/json"] = domain.MediaType{Schema: "schema-animal"}
+				api.Responses[1].Content["application/json"] = domain.MediaType{Schema: map[string]interface{}{"$ref": "schema-animal"}}
+				api.Responses[0].Content["application/json"] = domain.MediaType{Schema: map[string]interface{}{"type": "array", "items": "schema-animal"}}
+			},
+		},
+		{
+			name: "named schema properties, required and enum",
+			change: func(api *domain.APIDefinition) {
+				api.Schemas[0].Required = []string{"name", "status"}
+				api.Schemas[0].Properties = map[string]interface{}{
+					"status": map[string]interface{}{"type": "string", "enum": []interface{}{"available", "lost"}},
+					"age":    map[string]interface{}{"type": "integer"},
+				}
+			},
+			want: []string{
+				"added property /schemas/Pet/properties/age",
+				"removed property /schemas/Pet/properties/name",
+				"added enum /schemas/Pet/properties/status/enum/lost",
+				"removed enum /schemas/Pet/properties/status/enum/sold",
+				"added required /schemas/Pet/required/status",
+			},
+		},
+		{
+			name: "inline schema keyword",
+			change: func(api *domain.APIDefinition) {
+				api.Responses[0].Content["application/json"] = domain.MediaType{Schema: map[string]interface{}{
+					"type": "array", "items": map[string]interface{}{"$ref": "schema-pet"}, "maxItems": 50,
+				}}
+			},
+			want: []string{"added schema " + listPets + "/responses/200/content/application~1json/schema/maxItems"},
+		},
+		{
+			name: "security",
+			change: func(api *domain.APIDefinition) {
+				api.Security = []domain.SecurityRequirement{{"apiKey": {}}}
+				api.SecuritySchemes = []domain.SecurityScheme{{ID: "apiKey", Type: "apiKey", Name: "X-API-Key", In: "header"}}
+			},
+			want: []string{"added securityScheme /securitySchemes/apiKey", "modified security /security"},
+		},
+		{
+			name: "webhook",
+			change: func(api *domain.APIDefinition) {
+				api.Webhooks = []domain.Endpoint{{ID: "webhook-newpet", Method: "POST", Path: "newPet"}}
+			},
+			want: []string{"added endpoint /webhooks/POST newPet"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			new := petsDefinition()
+			tt.change(new)
+
+			var got []string
+			for _, change := range Compare(petsDefinition(), new) {
+				got = append(got, string(change.Type)+" "+change.Kind+" "+change.Path)
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("changes:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+// TestCompareNamesEndpoints sets Endpoint on the changes that belong to an
+// operation and names referenced schemas in their values
+func TestCompareNamesEndpoints(t *testing.T) {
+	new := petsDefinition()
+	new.Schemas = append(new.Schemas, domain.Schema{ID: "schema-cat", Name: "Cat"})
+	new.Responses[1].Content["application/json"] = domain.MediaType{Schema: "schema-cat"}
+	new.Metadata.Name = "Pet Store"
+
+	changes := Compare(petsDefinition(), new)
+	if len(changes) != 3 {
+		t.Fatalf("changes = %v, want 3", changes)
+	}
+	if changes[0].Endpoint != "" {
+		t.Errorf("metadata change belongs to %q, want no endpoint", changes[0].Endpoint)
+	}
+	if got := changes[1]; got.Endpoint != "POST /pets" || got.Old != "Pet" || got.New != "Cat" {
+		t.Errorf("schema change = %+v, want POST /pets from Pet to Cat", got)
+	}
+
+	summary := Summarize(changes)
+	if summary.Added != 1 || summary.Modified != 2 || summary.Removed != 0 {
+		t.Errorf("summary = %+v, want 1 added and 2 modified", summary)
+	}
+}
+
+// TestCompareFlagTurnedOn reports a flag that the JSON form leaves out when
+// false as modified from false, which is what classification expects
+func TestCompareFlagTurnedOn(t *testing.T) {
+	new := petsDefinition()
+	new.Parameters[0].Required = true
+
+	changes := Compare(petsDefinition(), new)
+	if len(changes) != 1 {
+		t.Fatalf("changes = %v, want 1", changes)
+	}
+	if got := changes[0]; got.Type != domain.ChangeModified || got.Old != false || got.New != true {
+		t.Errorf("change = %+v, want required modified from false to true", got)
+	}
+	if got := Classify(changes)[0]; got.Reason != "Parameter made required" {
+		t.Errorf("classified as %q, want Parameter made required", got.Reason)
+	}
+}
+
+// TestMarkdown renders changes grouped under their endpoint and schema
+func TestMarkdown(t *testing.T) {
+	new := petsDefinition()
+	new.Metadata.Version = "2.0.0"
+	new.Endpoints = new.Endpoints[:1]
+	new.Schemas[0].Properties = map[string]interface{}{"name": map[string]interface{}{"type": "string", "description": strings.Repeat("a", 100)}}
+
+	got := Markdown(Compare(petsDefinition(), new))
+	for _, want := range []string{
+		"1 added, 2 removed, 1 modified.",
+		"## Endpoints\n\n### POST /pets\n\n- **Removed** endpoint\n",
+		"## Schemas\n\n### Pet\n\n- **Added** schema `properties/name/description`: `" + strings.Repeat("a", 79) + "…`\n",
+		"- **Removed** property `properties/status` (was `{\"enum\":[\"available\",\"sold\"],\"type\":\"string\"}`)\n",
+		"## General\n\n- **Modified** metadata `metadata/version`: `1.0.0` → `2.0.0`\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("markdown does not contain %q:\n%s", want, got)
+		}
+	}
+
+	if got := Markdown(nil); !strings.Contains(got, "No changes.") {
+		t.Errorf("markdown without changes = %q", got)
+	}
+}
@@ -0,0 +1,150 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// maxValueLength is where values are cut short in Markdown
+const maxValueLength = 80
+
+// group gathers the changes made to one endpoint, one schema, or to the
+// rest of the definition
+type group struct {
+	title   string
+	prefix  string
+	changes []domain.DiffChange
+}
+
+// Markdown renders changes for reading, grouped by endpoint and schema. Each
+// change is listed by its path within its group, with its old and new values
+// cut short.
+func Markdown(changes []domain.DiffChange) string {
+	var b strings.Builder
+	b.WriteString("# API changes\n\n")
+
+	if len(changes) == 0 {
+		b.WriteString("No changes.\n")
+		return b.String()
+	}
+
+	summary := Summarize(changes)
+	fmt.Fprintf(&b, "%d added, %d removed, %d modified.\n", summary.Added, summary.Removed, summary.Modified)
+
+	endpoints, schemas, general := groupChanges(changes)
+	writeSection(&b, "Endpoints", endpoints)
+	writeSection(&b, "Schemas", schemas)
+	if len(general) > 0 {
+		writeSection(&b, "General", []*group{{changes: general}})
+	}
+
+	return b.String()
+}
+
+// groupChanges splits changes into endpoint, schema and other groups, in
+// the order they first appear
+func groupChanges(changes []domain.DiffChange) (endpoints, schemas []*group, general []domain.DiffChange) {
+	byTitle := make(map[string]*group)
+
+	for _, change := range changes {
+		tokens, _ := resolver.SplitPointer(change.Path)
+
+		var list *[]*group
+		switch {
+		case change.Endpoint != "" && len(tokens) >= 2:
+			list = &endpoints
+		case len(tokens) >= 2 && tokens[0] == "schemas":
+			list = &schemas
+		default:
+			general = append(general, change)
+			continue
+		}
+
+		key := tokens[0] + "/" + tokens[1]
+		g, ok := byTitle[key]
+		if !ok {
+			g = &group{title: tokens[1], prefix: resolver.JoinPointer("", tokens[0], tokens[1])}
+			if tokens[0] == "webhooks" {
+				g.title = "Webhook " + tokens[1]
+			}
+			byTitle[key] = g
+			*list = append(*list, g)
+		}
+		g.changes = append(g.changes, change)
+	}
+
+	return endpoints, schemas, general
+}
+
+func writeSection(b *strings.Builder, title string, groups []*group) {
+	if len(groups) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n## %s\n", title)
+
+	for _, g := range groups {
+		if g.title != "" {
+			fmt.Fprintf(b, "\n### %s\n\n", g.title)
+		} else {
+			b.WriteString("\n")
+		}
+		for _, change := range g.changes {
+			writeChange(b, change, strings.TrimPrefix(change.Path, g.prefix))
+		}
+	}
+}
+
+func writeChange(b *strings.Builder, change domain.DiffChange, path string) {
+	verb := strings.ToUpper(string(change.Type[:1])) + string(change.Type[1:])
+
+	// A change to a whole endpoint or schema is named by its heading alone
+	if path == "" {
+		fmt.Fprintf(b, "- **%s** %s\n", verb, change.Kind)
+		return
+	}
+
+	location := code(strings.TrimPrefix(path, "/"))
+	switch change.Type {
+	case domain.ChangeAdded:
+		fmt.Fprintf(b, "- **%s** %s %s: %s\n", verb, change.Kind, location, code(shorten(text(change.New))))
+	case domain.ChangeRemoved:
+		fmt.Fprintf(b, "- **%s** %s %s (was %s)\n", verb, change.Kind, location, code(shorten(text(change.Old))))
+	default:
+		fmt.Fprintf(b, "- **%s** %s %s: %s → %s\n", verb, change.Kind, location,
+			code(shorten(text(change.Old))), code(shorten(text(change.New))))
+	}
+}
+
+// code formats text as inline code, with a fence longer than any run of
+// backticks in it
+func code(s string) string {
+	fence := "`"
+	for strings.Contains(s, fence) {
+		fence += "`"
+	}
+	if strings.HasPrefix(s, "`") || strings.HasSuffix(s, "`") {
+		s = " " + s + " "
+	}
+	return fence + s + fence
+}
+
+func shorten(s string) string {
+	runes := []rune(s)
+	if len(runes) <= maxValueLength {
+		return s
+	}
+	return string(runes[:maxValueLength-1]) + "…"
+}
+
+// compact returns the JSON form of a value on one line
+func compact(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
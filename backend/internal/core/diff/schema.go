@@ -0,0 +1,195 @@
+package diff
+
+import (
+	"strconv"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// schema compares two schemas: a Schema ID, a {"$ref": ID} object or an
+// inline schema object. References are compared by the name of the schema
+// they point at; the named schemas themselves are compared under /schemas.
+func (c *comparer) schema(path, endpoint string, old, new interface{}) {
+	oldName, oldIsRef := c.old.refName(old)
+	newName, newIsRef := c.new.refName(new)
+
+	switch {
+	case old == nil && new == nil:
+	case old == nil:
+		c.add(domain.ChangeAdded, "schema", path, endpoint, nil, c.new.display(new))
+	case new == nil:
+		c.add(domain.ChangeRemoved, "schema", path, endpoint, c.old.display(old), nil)
+	case oldIsRef && newIsRef:
+		if oldName != newName {
+			c.add(domain.ChangeModified, "schema", path, endpoint, oldName, newName)
+		}
+	case oldIsRef || newIsRef:
+		c.add(domain.ChangeModified, "schema", path, endpoint, c.old.display(old), c.new.display(new))
+	default:
+		oldMap, oldIsMap := old.(map[string]interface{})
+		newMap, newIsMap := new.(map[string]interface{})
+		if !oldIsMap || !newIsMap {
+			// Boolean schemas and anything else that is not an object
+			if !equal(old, new) {
+				c.add(domain.ChangeModified, "schema", path, endpoint, old, new)
+			}
+			return
+		}
+		c.keywords(path, endpoint, oldMap, newMap)
+	}
+}
+
+// keywords compares the keywords of two schema objects, descending into
+// the ones that hold subschemas
+func (c *comparer) keywords(path, endpoint string, old, new map[string]interface{}) {
+	for _, key := range unionKeys(old, new) {
+		keyPath := resolver.JoinPointer(path, key)
+		oldValue, inOld := old[key]
+		newValue, inNew := new[key]
+
+		switch key {
+		case "properties":
+			c.properties(keyPath, endpoint, asMap(oldValue), asMap(newValue))
+			continue
+		case "required":
+			c.stringSet("required", keyPath, endpoint, asStrings(oldValue), asStrings(newValue))
+			continue
+		case "enum":
+			if inOld && inNew {
+				c.enum(keyPath, endpoint, asList(oldValue), asList(newValue))
+				continue
+			}
+		case "items", "additionalProperties", "unevaluatedProperties", "not":
+			if inOld && inNew {
+				c.schema(keyPath, endpoint, oldValue, newValue)
+				continue
+			}
+		case "allOf", "oneOf", "anyOf", "prefixItems":
+			oldList, newList := asList(oldValue), asList(newValue)
+			if inOld && inNew && len(oldList) == len(newList) {
+				for i := range oldList {
+					c.schema(resolver.JoinPointer(keyPath, strconv.Itoa(i)), endpoint, oldList[i], newList[i])
+				}
+				continue
+			}
+		}
+
+		switch {
+		case !inOld:
+			c.add(domain.ChangeAdded, "schema", keyPath, endpoint, nil, newValue)
+		case !inNew:
+			c.add(domain.ChangeRemoved, "schema", keyPath, endpoint, oldValue, nil)
+		case !equal(oldValue, newValue):
+			c.add(domain.ChangeModified, "schema", keyPath, endpoint, oldValue, newValue)
+		}
+	}
+}
+
+func (c *comparer) properties(path, endpoint string, old, new map[string]interface{}) {
+	for _, name := range unionKeys(old, new) {
+		propertyPath := resolver.JoinPointer(path, name)
+		oldProperty, inOld := old[name]
+		newProperty, inNew := new[name]
+		switch {
+		case !inOld:
+			c.add(domain.ChangeAdded, "property", propertyPath, endpoint, nil, c.new.display(newProperty))
+		case !inNew:
+			c.add(domain.ChangeRemoved, "property", propertyPath, endpoint, c.old.display(oldProperty), nil)
+		default:
+			c.schema(propertyPath, endpoint, oldProperty, newProperty)
+		}
+	}
+}
+
+// enum reports the values added to and removed from an enum, which are
+// matched by their JSON form
+func (c *comparer) enum(path, endpoint string, old, new []interface{}) {
+	index := func(values []interface{}) map[string]interface{} {
+		m := make(map[string]interface{}, len(values))
+		for _, value := range values {
+			m[text(value)] = value
+		}
+		return m
+	}
+	oldValues, newValues := index(old), index(new)
+
+	for _, key := range unionKeys(oldValues, newValues) {
+		oldValue, inOld := oldValues[key]
+		newValue, inNew := newValues[key]
+		switch {
+		case !inOld:
+			c.add(domain.ChangeAdded, "enum", resolver.JoinPointer(path, key), endpoint, nil, newValue)
+		case !inNew:
+			c.add(domain.ChangeRemoved, "enum", resolver.JoinPointer(path, key), endpoint, oldValue, nil)
+		}
+	}
+}
+
+// refName returns the name of the schema a value refers to, if it is a
+// reference
+func (s *side) refName(value interface{}) (string, bool) {
+	var id string
+	switch v := value.(type) {
+	case string:
+		id = v
+	case map[string]interface{}:
+		ref, ok := v["$ref"].(string)
+		if !ok || len(v) != 1 {
+			return "", false
+		}
+		id = ref
+	default:
+		return "", false
+	}
+	if name, ok := s.schemaNames[id]; ok {
+		return name, true
+	}
+	return id, true
+}
+
+// display replaces a reference by the name of the schema it points at
+func (s *side) display(value interface{}) interface{} {
+	if name, ok := s.refName(value); ok {
+		return map[string]interface{}{"$ref": name}
+	}
+	return value
+}
+
+// text returns a non-empty string as it is and anything else in compact
+// JSON form
+func text(value interface{}) string {
+	if s, ok := value.(string); ok && s != "" {
+		return s
+	}
+	return compact(value)
+}
+
+func asMap(value interface{}) map[string]interface{} {
+	m, _ := value.(map[string]interface{})
+	return m
+}
+
+func asList(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case []string:
+		list := make([]interface{}, len(v))
+		for i, s := range v {
+			list[i] = s
+		}
+		return list
+	}
+	return nil
+}
+
+func asStrings(value interface{}) []string {
+	var strings []string
+	for _, item := range asList(value) {
+		if s, ok := item.(string); ok {
+			strings = append(strings, s)
+		}
+	}
+	return strings
+}
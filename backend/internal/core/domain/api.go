@@ -4,148 +4,368 @@ import (
 	"time"
 )
 
-// APIDefinition represents a normalized API structure
+// APIDefinition represents a normalized API structure. Tags holds the tag
+// objects declared by the document, while Metadata.Tags lists every tag name
+// in use. Components keeps the component sections that have no normalized
+// form (headers, examples, links, callbacks, pathItems) as raw objects.
+// PathItems and WebhookItems keep the fields paths and webhooks declare for
+// all of their operations. Revision is the number of the revision that
+// recorded this state.
 type APIDefinition struct {
-	ID             string                   `json:"id"`
-	Metadata       APIMetadata              `json:"metadata"`
-	Endpoints      []Endpoint               `json:"endpoints"`
-	Schemas        []Schema                 `json:"schemas"`
-	Parameters     []Parameter              `json:"parameters"`
-	Responses      []Response               `json:"responses"`
-	RequestBodies  []RequestBody            `json:"requestBodies"`
-	SecuritySchemes []SecurityScheme        `json:"securitySchemes,omitempty"`
-	CreatedAt      time.Time                `json:"createdAt"`
-	UpdatedAt      time.Time                `json:"updatedAt"`
-}
-
-// APIMetadata contains API metadata information
+	ID              string                 `json:"id"`
+	Revision        int                    `json:"revision,omitempty"`
+	Metadata        APIMetadata            `json:"metadata"`
+	Endpoints       []Endpoint             `json:"endpoints"`
+	Webhooks        []Endpoint             `json:"webhooks,omitempty"`
+	PathItems       []PathItem             `json:"pathItems,omitempty"`
+	WebhookItems    []PathItem             `json:"webhookItems,omitempty"`
+	Schemas         []Schema               `json:"schemas"`
+	Parameters      []Parameter            `json:"parameters"`
+	Responses       []Response             `json:"responses"`
+	RequestBodies   []RequestBody          `json:"requestBodies"`
+	SecuritySchemes []SecurityScheme       `json:"securitySchemes,omitempty"`
+	Security        []SecurityRequirement  `json:"security,omitempty"`
+	Tags            []Tag                  `json:"tags,omitempty"`
+	Components      map[string]interface{} `json:"components,omitempty"`
+	Extensions      map[string]interface{} `json:"extensions,omitempty"`
+	CreatedAt       time.Time              `json:"createdAt"`
+	UpdatedAt       time.Time              `json:"updatedAt"`
+}
+
+// APIMetadata contains API metadata information. SpecVersion records the
+// openapi/swagger version of the imported document, BaseURL is the URL of the
+// first entry in Servers and Extensions holds the x- fields of the info object.
 type APIMetadata struct {
-	Name        string            `json:"name"`
-	Version     string            `json:"version"`
-	Description string            `json:"description,omitempty"`
-	BaseURL     string            `json:"baseUrl,omitempty"`
-	License     *License          `json:"license,omitempty"`
-	Tags        []string          `json:"tags,omitempty"`
+	Name           string                 `json:"name"`
+	Version        string                 `json:"version"`
+	SpecVersion    string                 `json:"specVersion,omitempty"`
+	Summary        string                 `json:"summary,omitempty"`
+	Description    string                 `json:"description,omitempty"`
+	TermsOfService string                 `json:"termsOfService,omitempty"`
+	BaseURL        string                 `json:"baseUrl,omitempty"`
+	Servers        []Server               `json:"servers,omitempty"`
+	Contact        *Contact               `json:"contact,omitempty"`
+	License        *License               `json:"license,omitempty"`
+	ExternalDocs   *ExternalDocs          `json:"externalDocs,omitempty"`
+	Tags           []string               `json:"tags,omitempty"`
+	Extensions     map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// Contact represents API contact information
+type Contact struct {
+	Name  string `json:"name,omitempty"`
+	URL   string `json:"url,omitempty"`
+	Email string `json:"email,omitempty"`
 }
 
 // License represents API license information
 type License struct {
-	Name string `json:"name"`
-	URL  string `json:"url,omitempty"`
+	Name       string `json:"name"`
+	Identifier string `json:"identifier,omitempty"`
+	URL        string `json:"url,omitempty"`
+}
+
+// Server represents a server the API is served from
+type Server struct {
+	URL         string                 `json:"url"`
+	Description string                 `json:"description,omitempty"`
+	Variables   map[string]interface{} `json:"variables,omitempty"`
+	Extensions  map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// ExternalDocs points at additional documentation
+type ExternalDocs struct {
+	Description string `json:"description,omitempty"`
+	URL         string `json:"url"`
+}
+
+// Tag represents a tag declared at the top level of a document
+type Tag struct {
+	Name         string                 `json:"name"`
+	Description  string                 `json:"description,omitempty"`
+	ExternalDocs *ExternalDocs          `json:"externalDocs,omitempty"`
+	Extensions   map[string]interface{} `json:"extensions,omitempty"`
 }
 
-// Endpoint represents an API endpoint
+// Endpoint represents an API endpoint. For webhooks, Path holds the webhook
+// name. Security distinguishes an explicit empty list, which disables the
+// global requirements, from nil, which inherits them.
 type Endpoint struct {
-	ID           string                     `json:"id"`
-	Path         string                     `json:"path"`
-	Method       string                     `json:"method"`
-	OperationID  string                     `json:"operationId,omitempty"`
-	Summary      string                     `json:"summary,omitempty"`
-	Description  string                     `json:"description,omitempty"`
-	Tags         []string                   `json:"tags,omitempty"`
-	Parameters   []string                   `json:"parameters,omitempty"`
-	RequestBody  string                     `json:"requestBody,omitempty"`
-	Responses    map[string]string          `json:"responses"`
-	Security     []SecurityRequirement      `json:"security,omitempty"`
-}
-
-// Schema represents a data schema
-type Schema struct {
-	ID          string                 `json:"id"`
-	Name        string                 `json:"name"`
-	Type        string                 `json:"type"`
-	Required    []string               `json:"required,omitempty"`
-	Properties  map[string]interface{} `json:"properties,omitempty"`
-	Items       interface{}            `json:"items,omitempty"`
-	Enum        []string               `json:"enum,omitempty"`
-	Format      string                 `json:"format,omitempty"`
-	MaxItems    int                    `json:"maxItems,omitempty"`
-	MinItems    int                    `json:"minItems,omitempty"`
-	Example     interface{}            `json:"example,omitempty"`
+	ID           string                 `json:"id"`
+	Path         string                 `json:"path"`
+	Method       string                 `json:"method"`
+	OperationID  string                 `json:"operationId,omitempty"`
+	Summary      string                 `json:"summary,omitempty"`
+	Description  string                 `json:"description,omitempty"`
+	Tags         []string               `json:"tags,omitempty"`
+	Parameters   []string               `json:"parameters,omitempty"`
+	RequestBody  string                 `json:"requestBody,omitempty"`
+	Responses    map[string]string      `json:"responses"`
+	Callbacks    map[string]interface{} `json:"callbacks,omitempty"`
+	Security     []SecurityRequirement  `json:"security"`
+	Servers      []Server               `json:"servers,omitempty"`
+	ExternalDocs *ExternalDocs          `json:"externalDocs,omitempty"`
+	Deprecated   bool                   `json:"deprecated,omitempty"`
+	Extensions   map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// PathItem holds the fields a path, or for webhooks a webhook name, declares
+// besides its operations. Its parameters are kept on each operation instead.
+type PathItem struct {
+	Path        string                 `json:"path"`
+	Summary     string                 `json:"summary,omitempty"`
 	Description string                 `json:"description,omitempty"`
+	Servers     []Server               `json:"servers,omitempty"`
+	Extensions  map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// Schema represents a data schema. Nested schemas (properties, items and
+// composition members) are kept as raw schema objects whose $ref values
+// point at the ID of another Schema in the same definition.
+//
+// Type holds the first non-null type. Types is only set when the document
+// lists several types (OpenAPI 3.1), and a "null" entry there is mirrored by
+// Nullable. An OpenAPI 3.0 exclusive bound is Minimum or Maximum plus the
+// ExclusiveMinimum or ExclusiveMaximum flag; a numeric JSON Schema 2020-12
+// bound is kept apart in ExclusiveMinimumValue or ExclusiveMaximumValue, as
+// it may sit next to an inclusive bound. Ref is the reference of an OpenAPI
+// 3.1 schema that applies its own keywords next to the referenced schema.
+type Schema struct {
+	ID                    string                 `json:"id"`
+	Name                  string                 `json:"name"`
+	Ref                   string                 `json:"$ref,omitempty"`
+	Type                  string                 `json:"type"`
+	Types                 []string               `json:"types,omitempty"`
+	Title                 string                 `json:"title,omitempty"`
+	Required              []string               `json:"required,omitempty"`
+	Properties            map[string]interface{} `json:"properties,omitempty"`
+	PatternProperties     map[string]interface{} `json:"patternProperties,omitempty"`
+	AdditionalProperties  interface{}            `json:"additionalProperties,omitempty"`
+	UnevaluatedProperties interface{}            `json:"unevaluatedProperties,omitempty"`
+	PropertyNames         interface{}            `json:"propertyNames,omitempty"`
+	DependentRequired     map[string][]string    `json:"dependentRequired,omitempty"`
+	DependentSchemas      map[string]interface{} `json:"dependentSchemas,omitempty"`
+	Items                 interface{}            `json:"items,omitempty"`
+	PrefixItems           []interface{}          `json:"prefixItems,omitempty"`
+	UnevaluatedItems      interface{}            `json:"unevaluatedItems,omitempty"`
+	Contains              interface{}            `json:"contains,omitempty"`
+	MinContains           *int                   `json:"minContains,omitempty"`
+	MaxContains           *int                   `json:"maxContains,omitempty"`
+	AllOf                 []interface{}          `json:"allOf,omitempty"`
+	OneOf                 []interface{}          `json:"oneOf,omitempty"`
+	AnyOf                 []interface{}          `json:"anyOf,omitempty"`
+	Not                   interface{}            `json:"not,omitempty"`
+	If                    interface{}            `json:"if,omitempty"`
+	Then                  interface{}            `json:"then,omitempty"`
+	Else                  interface{}            `json:"else,omitempty"`
+	Discriminator         interface{}            `json:"discriminator,omitempty"`
+	Enum                  []interface{}          `json:"enum,omitempty"`
+	Const                 interface{}            `json:"const,omitempty"`
+	Format                string                 `json:"format,omitempty"`
+	Pattern               string                 `json:"pattern,omitempty"`
+	MinLength             *int                   `json:"minLength,omitempty"`
+	MaxLength             *int                   `json:"maxLength,omitempty"`
+	Minimum               *float64               `json:"minimum,omitempty"`
+	Maximum               *float64               `json:"maximum,omitempty"`
+	ExclusiveMinimum      bool                   `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum      bool                   `json:"exclusiveMaximum,omitempty"`
+	ExclusiveMinimumValue *float64               `json:"exclusiveMinimumValue,omitempty"`
+	ExclusiveMaximumValue *float64               `json:"exclusiveMaximumValue,omitempty"`
+	MultipleOf            *float64               `json:"multipleOf,omitempty"`
+	MaxItems              *int                   `json:"maxItems,omitempty"`
+	MinItems              *int                   `json:"minItems,omitempty"`
+	UniqueItems           bool                   `json:"uniqueItems,omitempty"`
+	MinProperties         *int                   `json:"minProperties,omitempty"`
+	MaxProperties         *int                   `json:"maxProperties,omitempty"`
+	Nullable              bool                   `json:"nullable,omitempty"`
+	ReadOnly              bool                   `json:"readOnly,omitempty"`
+	WriteOnly             bool                   `json:"writeOnly,omitempty"`
+	Deprecated            bool                   `json:"deprecated,omitempty"`
+	Default               interface{}            `json:"default,omitempty"`
+	Example               interface{}            `json:"example,omitempty"`
+	Examples              []interface{}          `json:"examples,omitempty"`
+	Defs                  map[string]interface{} `json:"$defs,omitempty"`
+	XML                   interface{}            `json:"xml,omitempty"`
+	ExternalDocs          *ExternalDocs          `json:"externalDocs,omitempty"`
+	Description           string                 `json:"description,omitempty"`
+	Extensions            map[string]interface{} `json:"extensions,omitempty"`
 }
 
-// Parameter represents an API parameter
+// Parameter represents an API parameter. Component is the key the parameter
+// was declared under in the components section, empty for inline parameters.
+// Explode is nil when the document leaves it to the style's default.
 type Parameter struct {
-	ID          string      `json:"id"`
-	Name        string      `json:"name"`
-	In          string      `json:"in"`
-	Description string      `json:"description,omitempty"`
-	Required    bool        `json:"required,omitempty"`
-	Schema      interface{} `json:"schema,omitempty"`
-	Style       string      `json:"style,omitempty"`
-	Explode     bool        `json:"explode,omitempty"`
+	ID              string                 `json:"id"`
+	Component       string                 `json:"component,omitempty"`
+	Name            string                 `json:"name"`
+	In              string                 `json:"in"`
+	Description     string                 `json:"description,omitempty"`
+	Required        bool                   `json:"required,omitempty"`
+	Deprecated      bool                   `json:"deprecated,omitempty"`
+	AllowEmptyValue bool                   `json:"allowEmptyValue,omitempty"`
+	AllowReserved   bool                   `json:"allowReserved,omitempty"`
+	Schema          interface{}            `json:"schema,omitempty"`
+	Content         map[string]MediaType   `json:"content,omitempty"`
+	Style           string                 `json:"style,omitempty"`
+	Explode         *bool                  `json:"explode,omitempty"`
+	Example         interface{}            `json:"example,omitempty"`
+	Examples        map[string]interface{} `json:"examples,omitempty"`
+	Extensions      map[string]interface{} `json:"extensions,omitempty"`
 }
 
 // Response represents an API response
 type Response struct {
-	ID          string                       `json:"id"`
-	Description string                       `json:"description"`
-	Headers     map[string]interface{}       `json:"headers,omitempty"`
-	Content     map[string]MediaType         `json:"content,omitempty"`
+	ID          string                 `json:"id"`
+	Component   string                 `json:"component,omitempty"`
+	Description string                 `json:"description"`
+	Headers     map[string]interface{} `json:"headers,omitempty"`
+	Content     map[string]MediaType   `json:"content,omitempty"`
+	Links       map[string]interface{} `json:"links,omitempty"`
+	Extensions  map[string]interface{} `json:"extensions,omitempty"`
 }
 
 // RequestBody represents an API request body
 type RequestBody struct {
 	ID          string                 `json:"id"`
+	Component   string                 `json:"component,omitempty"`
 	Description string                 `json:"description,omitempty"`
 	Content     map[string]MediaType   `json:"content"`
 	Required    bool                   `json:"required,omitempty"`
+	Extensions  map[string]interface{} `json:"extensions,omitempty"`
 }
 
-// MediaType represents a media type
+// MediaType represents a media type. Schema is either the ID of a Schema in the
+// same definition or an inline schema object.
 type MediaType struct {
-	Schema   interface{}            `json:"schema,omitempty"`
-	Example  interface{}            `json:"example,omitempty"`
-	Examples map[string]interface{} `json:"examples,omitempty"`
+	Schema     interface{}            `json:"schema,omitempty"`
+	Example    interface{}            `json:"example,omitempty"`
+	Examples   map[string]interface{} `json:"examples,omitempty"`
+	Encoding   map[string]interface{} `json:"encoding,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
 }
 
 // SecurityScheme represents a security scheme
 type SecurityScheme struct {
-	ID               string      `json:"id"`
-	Type             string      `json:"type"`
-	Name             string      `json:"name,omitempty"`
-	In               string      `json:"in,omitempty"`
-	Scheme           string      `json:"scheme,omitempty"`
-	BearerFormat     string      `json:"bearerFormat,omitempty"`
-	Flows            interface{} `json:"flows,omitempty"`
-	OpenIDConnectURL string      `json:"openIdConnectUrl,omitempty"`
+	ID               string                 `json:"id"`
+	Component        string                 `json:"component,omitempty"`
+	Type             string                 `json:"type"`
+	Description      string                 `json:"description,omitempty"`
+	Name             string                 `json:"name,omitempty"`
+	In               string                 `json:"in,omitempty"`
+	Scheme           string                 `json:"scheme,omitempty"`
+	BearerFormat     string                 `json:"bearerFormat,omitempty"`
+	Flows            interface{}            `json:"flows,omitempty"`
+	OpenIDConnectURL string                 `json:"openIdConnectUrl,omitempty"`
+	Extensions       map[string]interface{} `json:"extensions,omitempty"`
 }
 
-// SecurityRequirement represents a security requirement
+// SecurityRequirement represents a security requirement, keyed by
+// SecurityScheme ID
 type SecurityRequirement map[string][]string
 
+// Revision is an immutable snapshot of an API definition, recorded each time
+// the definition is saved. Numbers start at 1 and increase with every save.
+// Definition holds the snapshot; it is left out when revisions are listed.
+type Revision struct {
+	DefinitionID string         `json:"definitionId"`
+	Number       int            `json:"number"`
+	Author       string         `json:"author,omitempty"`
+	Message      string         `json:"message,omitempty"`
+	RestoredFrom int            `json:"restoredFrom,omitempty"`
+	CreatedAt    time.Time      `json:"createdAt"`
+	Definition   *APIDefinition `json:"definition,omitempty"`
+}
+
+// Change describes who saves a definition and why, for its revision
+type Change struct {
+	Author  string `json:"author,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// ExtensionUsage records one vendor extension (x- field) found in a
+// definition. Path is the JSON Pointer of the extension within the normalized
+// definition, and OwnerID the ID of the endpoint, schema, parameter, response,
+// request body or security scheme that carries it, or the path of the path
+// item, when there is one.
+type ExtensionUsage struct {
+	Key     string      `json:"key"`
+	Value   interface{} `json:"value"`
+	Path    string      `json:"path"`
+	Level   string      `json:"level"`
+	OwnerID string      `json:"ownerId,omitempty"`
+}
+
 // ConversionRequest represents a request to convert Swagger to JSON
 type ConversionRequest struct {
-	SwaggerContent string `json:"swaggerContent"`
-	Format         string `json:"format"` // "yaml" or "json"
+	SwaggerContent string            `json:"swaggerContent"`
+	Format         string            `json:"format"`          // "yaml" or "json"
+	Files          map[string]string `json:"files,omitempty"` // other documents of a multi-file spec, keyed by path relative to the root document
 }
 
 // ConversionResponse represents a conversion response
 type ConversionResponse struct {
-	Success  bool           `json:"success"`
-	Data     *APIDefinition `json:"data,omitempty"`
-	Error    string         `json:"error,omitempty"`
-	Warnings []string       `json:"warnings,omitempty"`
+	Success        bool           `json:"success"`
+	Data           *APIDefinition `json:"data,omitempty"`
+	Error          string         `json:"error,omitempty"`
+	Warnings       []string       `json:"warnings,omitempty"`
+	WarningDetails []Warning      `json:"warningDetails,omitempty"`
 }
 
-// ValidationRequest represents a validation request
+// ValidationRequest represents a validation request. The payload is checked
+// against the schema of DefinitionID whose ID or name is SchemaID, or against
+// the inline Schema, whose references resolve in DefinitionID when it is set.
 type ValidationRequest struct {
-	JSONContent string `json:"jsonContent"`
-	SchemaID    string `json:"schemaId,omitempty"`
+	JSONContent  string      `json:"jsonContent"`
+	DefinitionID string      `json:"definitionId,omitempty"`
+	SchemaID     string      `json:"schemaId,omitempty"`
+	Schema       interface{} `json:"schema,omitempty"`
+}
+
+// LintRequest represents a request to lint a specification. Ruleset is
+// optional YAML whose rule entries override the server's ruleset.
+type LintRequest struct {
+	Content string            `json:"content"`
+	Files   map[string]string `json:"files,omitempty"`
+	Ruleset string            `json:"ruleset,omitempty"`
 }
 
-// ValidationResponse represents a validation response
+// ValidationResponse represents a validation response. WarningDetails holds
+// the same warnings as Warnings, with the place in the source each is about.
 type ValidationResponse struct {
-	Valid    bool              `json:"valid"`
-	Errors   []ValidationError `json:"errors,omitempty"`
-	Warnings []string          `json:"warnings,omitempty"`
+	Valid          bool              `json:"valid"`
+	Errors         []ValidationError `json:"errors,omitempty"`
+	Warnings       []string          `json:"warnings,omitempty"`
+	WarningDetails []Warning         `json:"warningDetails,omitempty"`
 }
 
-// ValidationError represents a validation error
+// ValidationError represents a validation error, positioned in the
+// validated source when there is one. Lint findings also name the rule
+// that produced them.
 type ValidationError struct {
-	Path    string      `json:"path,omitempty"`
-	Message string      `json:"message"`
-	Keyword string      `json:"keyword,omitempty"`
-	Params  interface{} `json:"params,omitempty"`
-}
\ No newline at end of file
+	Path     string      `json:"path,omitempty"`
+	Message  string      `json:"message"`
+	Keyword  string      `json:"keyword,omitempty"`
+	Params   interface{} `json:"params,omitempty"`
+	Rule     string      `json:"rule,omitempty"`
+	Severity string      `json:"severity,omitempty"`
+	SourceRange
+}
+
+// Warning represents a warning about the place in a document at Path
+type Warning struct {
+	Path     string `json:"path,omitempty"`
+	Message  string `json:"message"`
+	Rule     string `json:"rule,omitempty"`
+	Severity string `json:"severity,omitempty"`
+	SourceRange
+}
+
+// SourceRange is a span of source text. Lines and columns are 1-based and
+// EndColumn points just past the last character, as editor markers expect.
+// Zero values mean the position is unknown.
+type SourceRange struct {
+	Line      int `json:"line,omitempty"`
+	Column    int `json:"column,omitempty"`
+	EndLine   int `json:"endLine,omitempty"`
+	EndColumn int `json:"endColumn,omitempty"`
+}
@@ -0,0 +1,15 @@
+package domain
+
+// GeneratedCode is the code generated from an API definition. Name names
+// its root directory, such as the Go package.
+type GeneratedCode struct {
+	Name  string          `json:"name"`
+	Files []GeneratedFile `json:"files"`
+}
+
+// GeneratedFile is a source file of generated code. Path is relative to the
+// root directory.
+type GeneratedFile struct {
+	Path    string `json:"path"`
+	Content string `json:"content"`
+}
@@ -0,0 +1,36 @@
+package domain
+
+// ContractTestRequest asks for the endpoints of a stored API definition to
+// be called on a running service at BaseURL. Headers are sent with every
+// request, such as credentials; Seed picks the generated parameter and body
+// values.
+type ContractTestRequest struct {
+	BaseURL string            `json:"baseUrl"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Seed    uint64            `json:"seed,omitempty"`
+}
+
+// ContractResult is the outcome of calling one operation. Failures point
+// at the part of the response that broke the definition: /status,
+// /headers/{name} or /body, followed by the path within the body.
+type ContractResult struct {
+	EndpointID  string            `json:"endpointId"`
+	OperationID string            `json:"operationId,omitempty"`
+	Method      string            `json:"method"`
+	Path        string            `json:"path"`
+	URL         string            `json:"url"`
+	Status      int               `json:"status,omitempty"`
+	Passed      bool              `json:"passed"`
+	DurationMs  int64             `json:"durationMs"`
+	Failures    []ValidationError `json:"failures,omitempty"`
+}
+
+// ContractReport lists the outcome of every operation of a contract test
+type ContractReport struct {
+	DefinitionID string           `json:"definitionId"`
+	BaseURL      string           `json:"baseUrl"`
+	Total        int              `json:"total"`
+	Passed       int              `json:"passed"`
+	Failed       int              `json:"failed"`
+	Results      []ContractResult `json:"results"`
+}
@@ -0,0 +1,90 @@
+package domain
+
+// ChangeType says whether something was added, removed or modified
+type ChangeType string
+
+const (
+	ChangeAdded    ChangeType = "added"
+	ChangeRemoved  ChangeType = "removed"
+	ChangeModified ChangeType = "modified"
+)
+
+// DiffChange is one difference between two API definitions. Path is a JSON
+// Pointer into a tree keyed by what identifies things across versions rather
+// than by their position, such as /endpoints/GET ~1pets/parameters/query/limit
+// or /schemas/Pet/properties/name. Kind says what the path points at:
+// metadata, server, endpoint, security, parameter, requestBody, response,
+// header, mediaType, schema, property, required, enum or securityScheme.
+// Endpoint names the operation a change belongs to, when it belongs to one.
+type DiffChange struct {
+	Type     ChangeType  `json:"type"`
+	Kind     string      `json:"kind"`
+	Path     string      `json:"path"`
+	Endpoint string      `json:"endpoint,omitempty"`
+	Old      interface{} `json:"old"`
+	New      interface{} `json:"new"`
+}
+
+// DiffSource is one side of a comparison: a stored definition, optionally at
+// a revision, a raw Swagger/OpenAPI document, or a normalized definition
+type DiffSource struct {
+	DefinitionID string            `json:"definitionId,omitempty"`
+	Revision     int               `json:"revision,omitempty"`
+	Content      string            `json:"content,omitempty"`
+	Files        map[string]string `json:"files,omitempty"`
+	Definition   *APIDefinition    `json:"definition,omitempty"`
+}
+
+// DiffRequest asks for the changes from Old to New. Format is "json", the
+// default, or "markdown" to also render the changes for reading.
+type DiffRequest struct {
+	Old    DiffSource `json:"old"`
+	New    DiffSource `json:"new"`
+	Format string     `json:"format,omitempty"`
+}
+
+// DiffSummary counts the changes of each type
+type DiffSummary struct {
+	Added    int `json:"added"`
+	Removed  int `json:"removed"`
+	Modified int `json:"modified"`
+}
+
+// DiffResult lists the changes between two API definitions
+type DiffResult struct {
+	Changes  []DiffChange `json:"changes"`
+	Summary  DiffSummary  `json:"summary"`
+	Markdown string       `json:"markdown,omitempty"`
+}
+
+// ChangeSeverity says whether a change can break existing clients
+type ChangeSeverity string
+
+const (
+	SeverityBreaking    ChangeSeverity = "breaking"
+	SeverityNonBreaking ChangeSeverity = "non-breaking"
+)
+
+// ClassifiedChange is a change with its effect on existing clients and the
+// reason for it
+type ClassifiedChange struct {
+	DiffChange
+	Severity ChangeSeverity `json:"severity"`
+	Reason   string         `json:"reason"`
+}
+
+// CompatibilityReport classifies the changes made to a definition since an
+// earlier revision. A release is blocked when there are breaking changes and
+// the major part of Metadata.Version was not increased.
+type CompatibilityReport struct {
+	DefinitionID     string             `json:"definitionId"`
+	Revision         int                `json:"revision"`
+	Against          int                `json:"against"`
+	OldVersion       string             `json:"oldVersion"`
+	NewVersion       string             `json:"newVersion"`
+	MajorVersionBump bool               `json:"majorVersionBump"`
+	Breaking         int                `json:"breaking"`
+	NonBreaking      int                `json:"nonBreaking"`
+	ReleaseBlocked   bool               `json:"releaseBlocked"`
+	Changes          []ClassifiedChange `json:"changes"`
+}
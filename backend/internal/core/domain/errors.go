@@ -0,0 +1,15 @@
+package domain
+
+import "errors"
+
+var (
+	// ErrConflict is returned when creating something that already exists
+	ErrConflict = errors.New("already exists")
+
+	// ErrNotFound is returned when something asked for does not exist
+	ErrNotFound = errors.New("not found")
+
+	// ErrInvalid is returned when a definition breaks the rules it is
+	// validated against
+	ErrInvalid = errors.New("invalid")
+)
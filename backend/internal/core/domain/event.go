@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+// DefinitionEventType says what happened to an API definition
+type DefinitionEventType string
+
+const (
+	// DefinitionCreated is published when a definition is created or imported
+	DefinitionCreated DefinitionEventType = "created"
+	// DefinitionUpdated is published when a definition is saved again,
+	// including when an old revision is restored
+	DefinitionUpdated DefinitionEventType = "updated"
+	// DefinitionDeleted is published when a definition is deleted
+	DefinitionDeleted DefinitionEventType = "deleted"
+	// DefinitionValidated is published when a stored definition is validated
+	DefinitionValidated DefinitionEventType = "validated"
+)
+
+// DefinitionEvent reports a change to an API definition. Revision, Author
+// and Message describe the revision a save recorded; Valid and Errors are
+// only set for validated events.
+type DefinitionEvent struct {
+	Type         DefinitionEventType `json:"type"`
+	DefinitionID string              `json:"definitionId"`
+	Revision     int                 `json:"revision,omitempty"`
+	Author       string              `json:"author,omitempty"`
+	Message      string              `json:"message,omitempty"`
+	Valid        *bool               `json:"valid,omitempty"`
+	Errors       []ValidationError   `json:"errors,omitempty"`
+	OccurredAt   time.Time           `json:"occurredAt"`
+}
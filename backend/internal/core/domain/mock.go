@@ -0,0 +1,30 @@
+package domain
+
+// MockRequest is a request made to the mock server of an API definition.
+// Path is relative to the root of the mock; header names are canonical.
+type MockRequest struct {
+	Method  string
+	Path    string
+	Query   map[string][]string
+	Headers map[string][]string
+	Body    []byte
+}
+
+// MockResponse is the mock server's answer to a request, including the
+// errors it reports when a request does not match the definition
+type MockResponse struct {
+	Status  int
+	Headers map[string]string
+	Body    []byte
+}
+
+// SchemaExample is an example synthesized for a named schema. Valid and
+// Errors report whether the example satisfies the schema.
+type SchemaExample struct {
+	DefinitionID string            `json:"definitionId"`
+	SchemaID     string            `json:"schemaId"`
+	Seed         uint64            `json:"seed"`
+	Example      interface{}       `json:"example"`
+	Valid        bool              `json:"valid"`
+	Errors       []ValidationError `json:"errors,omitempty"`
+}
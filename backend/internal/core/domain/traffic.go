@@ -0,0 +1,53 @@
+package domain
+
+// ViolationKind says what part of a recorded exchange breaks a definition
+type ViolationKind string
+
+const (
+	// ViolationUndocumentedEndpoint is a request to a path no endpoint declares
+	ViolationUndocumentedEndpoint ViolationKind = "undocumented-endpoint"
+	// ViolationUndocumentedMethod is a request with a method the path does
+	// not declare
+	ViolationUndocumentedMethod ViolationKind = "undocumented-method"
+	// ViolationUndocumentedField is a body property the schema does not list
+	ViolationUndocumentedField ViolationKind = "undocumented-field"
+	// ViolationRequest is a parameter or request body that breaks the definition
+	ViolationRequest ViolationKind = "request"
+	// ViolationResponse is a status, header or response body that breaks the
+	// definition
+	ViolationResponse ViolationKind = "response"
+)
+
+// TrafficSample identifies a recorded exchange that shows a violation
+type TrafficSample struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Status int    `json:"status,omitempty"`
+}
+
+// TrafficViolation is one problem found in recorded traffic, with how many
+// exchanges show it and a few of them as samples. Endpoint is the operation
+// as "METHOD /path/template", or the request path for undocumented
+// endpoints, with numeric and UUID segments replaced by {id}. Path points
+// into the exchange, such as /request/parameters/query/limit,
+// /request/body/name, /response/status or /response/body/*/id, with array
+// indexes replaced by *.
+type TrafficViolation struct {
+	Kind     ViolationKind   `json:"kind"`
+	Endpoint string          `json:"endpoint"`
+	Path     string          `json:"path,omitempty"`
+	Keyword  string          `json:"keyword,omitempty"`
+	Message  string          `json:"message"`
+	Count    int             `json:"count"`
+	Samples  []TrafficSample `json:"samples"`
+}
+
+// TrafficReport sums up the validation of recorded traffic against an API
+// definition. Violations are ordered by count, most frequent first.
+type TrafficReport struct {
+	DefinitionID string             `json:"definitionId"`
+	Exchanges    int                `json:"exchanges"`
+	Conforming   int                `json:"conforming"`
+	Violating    int                `json:"violating"`
+	Violations   []TrafficViolation `json:"violations"`
+}
@@ -0,0 +1,411 @@
+// Package example synthesizes example values from the schemas of an API
+// definition, for documentation, the mock server and anywhere else a
+// document declares a schema but no example. Values are drawn from a seeded
+// source, so the same schema and seed always give the same example.
+package example
+
+import (
+	"encoding/json"
+	"math/rand/v2"
+	"reflect"
+	"sort"
+
+	"github.com/swagger-editor/backend/internal/core/jsonschema"
+)
+
+// maxDepth bounds how deeply references are followed
+const maxDepth = 8
+
+// maxAttempts bounds the retries for values that must differ or match
+// exactly one branch of a oneOf
+const maxAttempts = 10
+
+// Generator builds example values. Values declared by a schema (example,
+// examples, default, const) are used when present; otherwise one is made up
+// from the type, format, constraints and the name of the property.
+type Generator struct {
+	refs      jsonschema.Refs
+	validator *jsonschema.Validator
+	seed      uint64
+	rand      *rand.Rand
+}
+
+// New creates a generator drawing values from seed; refs may be nil when
+// schemas have no references
+func New(refs jsonschema.Refs, seed uint64) *Generator {
+	return &Generator{
+		refs:      refs,
+		validator: jsonschema.New(refs),
+		seed:      seed,
+	}
+}
+
+// Generate returns an example value for a schema: a Schema ID, a schema
+// object or a boolean schema. writeOnly properties are left out, as the
+// examples are ones a server would send. Each call starts from the seed
+// again, so the example depends on the schema alone.
+func (g *Generator) Generate(schema interface{}) interface{} {
+	g.rand = rand.New(rand.NewPCG(g.seed, g.seed))
+	value, _ := g.generate(schema, "", make(map[string]bool))
+	return value
+}
+
+// generate returns an example for a schema; name is the property the value
+// is for, used to pick a realistic string. It fails when the schema refers
+// back to one that is being generated, so that recursive schemas, such as a
+// tree of nodes, stop where the recursion is optional.
+func (g *Generator) generate(schema interface{}, name string, active map[string]bool) (interface{}, bool) {
+	object, refs := g.resolve(schema)
+	for _, ref := range refs {
+		if active[ref] {
+			return nil, false
+		}
+	}
+	if object == nil {
+		return nil, true
+	}
+
+	for _, ref := range refs {
+		active[ref] = true
+		defer delete(active, ref)
+	}
+
+	if value, ok := declared(object); ok {
+		return value, true
+	}
+	if enum, ok := object["enum"].([]interface{}); ok && len(enum) > 0 {
+		return enum[g.rand.IntN(len(enum))], true
+	}
+
+	if allOf, ok := object["allOf"].([]interface{}); ok {
+		return g.merge(object, allOf, name, active)
+	}
+	if oneOf, ok := object["oneOf"].([]interface{}); ok {
+		return g.choose(object, oneOf, name, active)
+	}
+	if anyOf, ok := object["anyOf"].([]interface{}); ok {
+		return g.choose(object, anyOf, name, active)
+	}
+
+	switch schemaType(object) {
+	case "object":
+		return g.object(object, active)
+	case "array":
+		return g.array(object, name, active)
+	case "string":
+		return g.str(object, name), true
+	case "integer":
+		return g.integer(object), true
+	case "number":
+		return g.number(object), true
+	case "boolean":
+		return g.rand.IntN(2) == 0, true
+	}
+	return nil, true
+}
+
+// resolve follows references and returns the schema object, or nil, with
+// the references followed to reach it
+func (g *Generator) resolve(schema interface{}) (map[string]interface{}, []string) {
+	var refs []string
+	for i := 0; i <= maxDepth; i++ {
+		switch s := schema.(type) {
+		case string:
+			schema = map[string]interface{}{"$ref": s}
+			continue
+		case map[string]interface{}:
+			ref, ok := s["$ref"].(string)
+			if !ok || g.refs == nil {
+				return s, refs
+			}
+			target, err := g.refs.Lookup(ref)
+			if err != nil {
+				return nil, refs
+			}
+			refs = append(refs, ref)
+			schema = target
+		default:
+			return nil, refs
+		}
+	}
+	return nil, refs
+}
+
+// declared returns a value the schema itself provides
+func declared(object map[string]interface{}) (interface{}, bool) {
+	if value, ok := object["example"]; ok {
+		return value, true
+	}
+	if examples, ok := object["examples"].([]interface{}); ok && len(examples) > 0 {
+		return examples[0], true
+	}
+	if value, ok := object["default"]; ok {
+		return value, true
+	}
+	if value, ok := object["const"]; ok {
+		return value, true
+	}
+	return nil, false
+}
+
+// merge combines the examples of allOf members with the schema's own
+// properties
+func (g *Generator) merge(object map[string]interface{}, members []interface{}, name string, active map[string]bool) (interface{}, bool) {
+	merged := make(map[string]interface{})
+	var last interface{}
+
+	for _, member := range members {
+		value, ok := g.generate(member, name, active)
+		if !ok {
+			return nil, false
+		}
+		if part, ok := value.(map[string]interface{}); ok {
+			for key, v := range part {
+				merged[key] = v
+			}
+			continue
+		}
+		last = value
+	}
+
+	if _, ok := object["properties"]; ok {
+		own, ok := g.object(object, active)
+		if !ok {
+			return nil, false
+		}
+		for key, v := range own.(map[string]interface{}) {
+			merged[key] = v
+		}
+	}
+
+	if len(merged) == 0 && last != nil {
+		return last, true
+	}
+	return merged, true
+}
+
+// choose generates a value from one branch of a oneOf or anyOf, trying the
+// branches in a seeded order, a few rounds over, until one gives a value the
+// whole schema accepts, such as one that matches exactly one branch of a
+// oneOf. A discriminator property is set to the name mapped to the chosen
+// branch.
+func (g *Generator) choose(object map[string]interface{}, branches []interface{}, name string, active map[string]bool) (interface{}, bool) {
+	var fallback interface{}
+	found := false
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		for _, i := range g.rand.Perm(len(branches)) {
+			value, ok := g.generate(branches[i], name, active)
+			if !ok {
+				continue
+			}
+			g.setDiscriminator(object, branches[i], value)
+
+			if len(g.validator.Validate(object, value)) == 0 {
+				return value, true
+			}
+			if !found {
+				fallback, found = value, true
+			}
+		}
+		if !found {
+			// Every branch recurses; another round would fail the same way
+			break
+		}
+	}
+	return fallback, found
+}
+
+// setDiscriminator sets the discriminator property of a generated object to
+// the mapping key of the branch it was generated from
+func (g *Generator) setDiscriminator(object map[string]interface{}, branch interface{}, value interface{}) {
+	discriminator, _ := object["discriminator"].(map[string]interface{})
+	property, _ := discriminator["propertyName"].(string)
+	mapping, _ := discriminator["mapping"].(map[string]interface{})
+	target, ok := value.(map[string]interface{})
+	if property == "" || !ok {
+		return
+	}
+
+	branchSchema, _ := g.resolve(branch)
+	for _, key := range sortedKeys(mapping) {
+		mapped, _ := g.resolve(mapping[key])
+		if mapped != nil && sameSchema(mapped, branchSchema) {
+			target[property] = key
+			return
+		}
+	}
+}
+
+// object builds an object from every property but writeOnly ones. A
+// property whose schema recurses is left out, unless it is required.
+// Objects with too few properties get extra ones where the schema allows.
+func (g *Generator) object(object map[string]interface{}, active map[string]bool) (interface{}, bool) {
+	value := make(map[string]interface{})
+	required := make(map[string]bool)
+	for _, name := range asStrings(object["required"]) {
+		required[name] = true
+	}
+
+	properties, _ := object["properties"].(map[string]interface{})
+	for _, name := range sortedKeys(properties) {
+		property, _ := g.resolve(properties[name])
+		if property != nil && property["writeOnly"] == true && !required[name] {
+			continue
+		}
+		propertyValue, ok := g.generate(properties[name], name, active)
+		if !ok {
+			if required[name] {
+				return nil, false
+			}
+			continue
+		}
+		value[name] = propertyValue
+	}
+
+	additional := object["additionalProperties"]
+	if additional == false {
+		return value, true
+	}
+	minProperties, _ := toNumber(object["minProperties"])
+	if len(properties) == 0 && additional != nil {
+		minProperties = max(minProperties, 1)
+	}
+	for i := 1; len(value) < int(minProperties) && i <= int(minProperties)+len(properties); i++ {
+		key := g.words(1)
+		if _, taken := value[key]; taken {
+			continue
+		}
+		extra, ok := g.generate(additional, key, active)
+		if !ok {
+			break
+		}
+		value[key] = extra
+	}
+
+	return value, true
+}
+
+// array builds an array of between minItems (at least one) and a few more
+// items, within maxItems. Recursive items leave the array empty.
+func (g *Generator) array(object map[string]interface{}, name string, active map[string]bool) (interface{}, bool) {
+	var value []interface{}
+
+	if prefix, ok := object["prefixItems"].([]interface{}); ok {
+		for _, item := range prefix {
+			itemValue, ok := g.generate(item, name, active)
+			if !ok {
+				return nil, false
+			}
+			value = append(value, itemValue)
+		}
+	}
+
+	low := 1
+	if minItems, ok := toNumber(object["minItems"]); ok {
+		low = int(minItems)
+	}
+	high := max(low, 1) + 2
+	if maxItems, ok := toNumber(object["maxItems"]); ok && int(maxItems) < high {
+		high = int(maxItems)
+	}
+	count := low
+	if high > low {
+		count += g.rand.IntN(high - low + 1)
+	}
+
+	items, ok := object["items"]
+	if !ok || items == false {
+		if value == nil {
+			value = []interface{}{}
+		}
+		return value, true
+	}
+
+	unique := object["uniqueItems"] == true
+	seen := make(map[string]bool)
+	for attempts := 0; len(value) < count && attempts < count*maxAttempts; attempts++ {
+		itemValue, ok := g.generate(items, singular(name), active)
+		if !ok {
+			break
+		}
+		if unique {
+			key, _ := json.Marshal(itemValue)
+			if seen[string(key)] {
+				continue
+			}
+			seen[string(key)] = true
+		}
+		value = append(value, itemValue)
+	}
+
+	if value == nil {
+		value = []interface{}{}
+	}
+	return value, true
+}
+
+// schemaType returns the type an example should have: the first non-null
+// type listed, or the one implied by the keywords present
+func schemaType(object map[string]interface{}) string {
+	switch t := object["type"].(type) {
+	case string:
+		return t
+	case []interface{}:
+		for _, item := range t {
+			if name, ok := item.(string); ok && name != "null" {
+				return name
+			}
+		}
+		return "null"
+	}
+
+	switch {
+	case object["properties"] != nil, object["additionalProperties"] != nil:
+		return "object"
+	case object["items"] != nil, object["prefixItems"] != nil:
+		return "array"
+	case object["minimum"] != nil, object["maximum"] != nil, object["multipleOf"] != nil:
+		return "number"
+	case object["format"] != nil, object["pattern"] != nil, object["minLength"] != nil, object["maxLength"] != nil:
+		return "string"
+	}
+	return ""
+}
+
+// sameSchema reports whether two schema objects are the same object
+func sameSchema(a, b map[string]interface{}) bool {
+	return a != nil && b != nil && reflect.ValueOf(a).Pointer() == reflect.ValueOf(b).Pointer()
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func asStrings(value interface{}) []string {
+	var strings []string
+	list, _ := value.([]interface{})
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			strings = append(strings, s)
+		}
+	}
+	return strings
+}
+
+func toNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
@@ -0,0 +1,188 @@
+package example
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/swagger-editor/backend/internal/core/jsonschema"
+)
+
+// testRefs looks references up in a fixed set of schemas
+type testRefs map[string]interface{}
+
+func (r testRefs) Lookup(ref string) (interface{}, error) {
+	if schema, ok := r[ref]; ok {
+		return schema, nil
+	}
+	return nil, errors.New("not found: " + ref)
+}
+
+func decode(t *testing.T, content string) interface{} {
+	t.Helper()
+	var value interface{}
+	if err := json.Unmarshal([]byte(content), &value); err != nil {
+		t.Fatalf("bad test schema %s: %v", content, err)
+	}
+	return value
+}
+
+// TestGenerateValidExamples generates examples for schemas with constraints
+// of every kind, under several seeds, and validates each against its schema
+func TestGenerateValidExamples(t *testing.T) {
+	refs := testRefs{
+		"schema-node": decode(t, `{"type": "object", "required": ["label"], "properties": {
+			"label": {"type": "string"},
+			"children": {"type": "array", "items": "schema-node"},
+			"parent": {"$ref": "schema-node"}
+		}}`),
+		"schema-cat": decode(t, `{"type": "object", "required": ["kind", "lives"], "properties": {
+			"kind": {"type": "string"}, "lives": {"type": "integer", "minimum": 1, "maximum": 9}
+		}}`),
+		"schema-dog": decode(t, `{"type": "object", "required": ["kind", "barks"], "properties": {
+			"kind": {"type": "string"}, "barks": {"type": "boolean"}
+		}, "additionalProperties": false}`),
+	}
+
+	tests := []struct {
+		name   string
+		schema string
+	}{
+		{"string lengths", `{"type": "string", "minLength": 12, "maxLength": 14}`},
+		{"string pattern", `{"type": "string", "pattern": "^[A-Z]{3}-\\d{2,4}$"}`},
+		{"pattern and length", `{"type": "string", "pattern": "^a+$", "minLength": 2, "maxLength": 3}`},
+		{"formats", `{"type": "object", "properties": {
+			"at": {"type": "string", "format": "date-time"},
+			"on": {"type": "string", "format": "date"},
+			"mail": {"type": "string", "format": "email"},
+			"id": {"type": "string", "format": "uuid"},
+			"site": {"type": "string", "format": "uri"},
+			"host": {"type": "string", "format": "hostname"},
+			"ip": {"type": "string", "format": "ipv4"},
+			"ip6": {"type": "string", "format": "ipv6"},
+			"raw": {"type": "string", "format": "byte"}
+		}}`},
+		{"integer bounds", `{"type": "integer", "minimum": 10, "maximum": 12}`},
+		{"exclusive bounds", `{"type": "number", "exclusiveMinimum": 0, "exclusiveMaximum": 1}`},
+		{"3.0 exclusive flags", `{"type": "integer", "minimum": 1, "maximum": 3, "exclusiveMinimum": true, "exclusiveMaximum": true}`},
+		{"multipleOf", `{"type": "number", "minimum": 1, "maximum": 2, "multipleOf": 0.25}`},
+		{"int32", `{"type": "integer", "format": "int32"}`},
+		{"enum", `{"enum": ["red", "green"]}`},
+		{"nullable type list", `{"type": ["null", "string"], "minLength": 2}`},
+		{"array counts", `{"type": "array", "minItems": 4, "maxItems": 5, "items": {"type": "integer"}}`},
+		{"unique items", `{"type": "array", "minItems": 3, "uniqueItems": true, "items": {"type": "integer", "minimum": 0, "maximum": 3}}`},
+		{"tuple", `{"type": "array", "prefixItems": [{"type": "string"}, {"type": "boolean"}], "items": false}`},
+		{"required and extra properties", `{"type": "object", "required": ["name"], "minProperties": 3,
+			"properties": {"name": {"type": "string"}}, "additionalProperties": {"type": "integer"}}`},
+		{"closed object", `{"type": "object", "properties": {"a": {"type": "string"}}, "additionalProperties": false}`},
+		{"allOf", `{"allOf": ["schema-cat", {"properties": {"name": {"type": "string", "maxLength": 5}}}]}`},
+		{"oneOf with overlapping branches", `{"oneOf": [{"type": "integer"}, {"type": "number", "multipleOf": 0.5}]}`},
+		{"oneOf with a discriminator", `{"oneOf": ["schema-cat", "schema-dog"],
+			"discriminator": {"propertyName": "kind", "mapping": {"cat": "schema-cat", "dog": "schema-dog"}}}`},
+		{"anyOf", `{"anyOf": [{"type": "string", "format": "email"}, {"type": "integer"}]}`},
+		{"recursive", `"schema-node"`},
+	}
+
+	g := New(refs, 0)
+	v := jsonschema.New(refs)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema := decode(t, tt.schema)
+			for seed := uint64(0); seed < 20; seed++ {
+				g.seed = seed
+				value := g.Generate(schema)
+				if errs := v.Validate(schema, value); len(errs) > 0 {
+					data, _ := json.Marshal(value)
+					t.Fatalf("seed %d: %s breaks the schema: %s %s", seed, data, errs[0].Path, errs[0].Message)
+				}
+			}
+		})
+	}
+}
+
+// TestGenerateIsSeeded gets the same example for the same seed on every
+// call, whatever was generated in between, and different ones across seeds
+func TestGenerateIsSeeded(t *testing.T) {
+	schema := decode(t, `{"type": "object", "properties": {
+		"name": {"type": "string"}, "age": {"type": "integer"}, "tags": {"type": "array", "items": {"type": "string"}}
+	}}`)
+
+	g := New(nil, 42)
+	first := g.Generate(schema)
+	g.Generate(map[string]interface{}{"type": "string"})
+	if again := g.Generate(schema); !reflect.DeepEqual(first, again) {
+		t.Errorf("seed 42 gave %v, then %v", first, again)
+	}
+	if same := New(nil, 42).Generate(schema); !reflect.DeepEqual(first, same) {
+		t.Errorf("two generators with seed 42 gave %v and %v", first, same)
+	}
+
+	differs := false
+	for seed := uint64(0); seed < 5 && !differs; seed++ {
+		differs = !reflect.DeepEqual(first, New(nil, seed).Generate(schema))
+	}
+	if !differs {
+		t.Error("every seed gave the same example")
+	}
+}
+
+// TestGenerateUsesDeclaredValues prefers what the schema declares over a
+// made-up value, and leaves out writeOnly properties
+func TestGenerateUsesDeclaredValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   string
+	}{
+		{"example", `{"type": "string", "example": "Rex", "default": "Fido"}`, `"Rex"`},
+		{"first of examples", `{"type": "string", "examples": ["Tom", "Rex"]}`, `"Tom"`},
+		{"default", `{"type": "integer", "default": 3}`, `3`},
+		{"const", `{"const": {"a": 1}}`, `{"a": 1}`},
+		{"writeOnly left out", `{"properties": {"name": {"type": "string", "example": "Rex"}, "password": {"type": "string", "writeOnly": true}}}`, `{"name": "Rex"}`},
+		{"required writeOnly kept", `{"required": ["password"], "properties": {"password": {"type": "string", "writeOnly": true, "example": "secret"}}}`, `{"password": "secret"}`},
+		{"empty array without items", `{"type": "array"}`, `[]`},
+		{"unknown reference", `{"$ref": "schema-missing"}`, `null`},
+		{"false schema", `false`, `null`},
+	}
+
+	g := New(testRefs{}, 0)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := g.Generate(decode(t, tt.schema)); !reflect.DeepEqual(got, decode(t, tt.want)) {
+				t.Errorf("example = %#v, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestGenerateStopsRecursion generates an example for schemas that refer to
+// themselves, leaving out optional recursion and failing required recursion
+func TestGenerateStopsRecursion(t *testing.T) {
+	refs := testRefs{
+		"schema-node": decode(t, `{"type": "object", "properties": {"next": "schema-node", "label": {"type": "string", "example": "a"}}}`),
+		"schema-loop": decode(t, `{"type": "object", "required": ["self"], "properties": {"self": "schema-loop"}}`),
+	}
+	g := New(refs, 0)
+
+	if got := g.Generate("schema-node"); !reflect.DeepEqual(got, map[string]interface{}{"label": "a"}) {
+		t.Errorf("node example = %v, want the label alone", got)
+	}
+	if got := g.Generate("schema-loop"); got != nil {
+		t.Errorf("loop example = %v, want none", got)
+	}
+}
+
+// TestGenerateNamedStrings picks strings suited to the property name
+func TestGenerateNamedStrings(t *testing.T) {
+	schema := decode(t, `{"type": "object", "properties": {"email": {"type": "string"}, "id": {"type": "string"}}}`)
+	value := New(nil, 0).Generate(schema).(map[string]interface{})
+
+	v := jsonschema.New(nil)
+	if errs := v.Validate(map[string]interface{}{"format": "email"}, value["email"]); len(errs) > 0 {
+		t.Errorf("email = %v, want an email address", value["email"])
+	}
+	if s, _ := value["id"].(string); s == "" {
+		t.Errorf("id = %v, want a string", value["id"])
+	}
+}
@@ -0,0 +1,107 @@
+package example
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxRepeat bounds the repetitions of *, + and open-ended {n,} in a
+// generated string
+const maxRepeat = 4
+
+// matching generates a string that matches a pattern and has between min
+// and max characters, trying a few times before giving up
+func (g *Generator) matching(pattern string, min, max int) (string, bool) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", false
+	}
+	parsed, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+	parsed = parsed.Simplify()
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		var b strings.Builder
+		g.expand(&b, parsed)
+		value := b.String()
+		length := utf8.RuneCountInString(value)
+		if length >= min && length <= max && re.MatchString(value) {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// expand writes a string that the parsed expression matches
+func (g *Generator) expand(b *strings.Builder, re *syntax.Regexp) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 && g.rand.IntN(2) == 0 {
+				r = []rune(strings.ToUpper(string(r)))[0]
+			}
+			b.WriteRune(r)
+		}
+	case syntax.OpCharClass:
+		b.WriteRune(g.classRune(re.Rune))
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		b.WriteByte(alphabet[g.rand.IntN(len(alphabet))])
+	case syntax.OpCapture:
+		g.expand(b, re.Sub[0])
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			g.expand(b, sub)
+		}
+	case syntax.OpAlternate:
+		g.expand(b, re.Sub[g.rand.IntN(len(re.Sub))])
+	case syntax.OpStar:
+		g.repeat(b, re.Sub[0], 0, maxRepeat)
+	case syntax.OpPlus:
+		g.repeat(b, re.Sub[0], 1, maxRepeat)
+	case syntax.OpQuest:
+		g.repeat(b, re.Sub[0], 0, 1)
+	case syntax.OpRepeat:
+		high := re.Max
+		if high < 0 {
+			high = re.Min + maxRepeat
+		}
+		g.repeat(b, re.Sub[0], re.Min, high)
+	}
+	// Anchors, word boundaries and empty matches write nothing
+}
+
+func (g *Generator) repeat(b *strings.Builder, re *syntax.Regexp, low, high int) {
+	count := low
+	if high > low {
+		count += g.rand.IntN(high - low + 1)
+	}
+	for i := 0; i < count; i++ {
+		g.expand(b, re)
+	}
+}
+
+// classRune picks a rune from a character class, given as pairs of
+// inclusive bounds, preferring printable ASCII
+func (g *Generator) classRune(ranges []rune) rune {
+	var printable []rune
+	for i := 0; i+1 < len(ranges); i += 2 {
+		low, high := max(ranges[i], ' '+1), min(ranges[i+1], '~')
+		if low <= high {
+			printable = append(printable, low, high)
+		}
+	}
+	if len(printable) > 0 {
+		ranges = printable
+	}
+	if len(ranges) < 2 {
+		return 'x'
+	}
+
+	pair := g.rand.IntN(len(ranges)/2) * 2
+	low, high := ranges[pair], ranges[pair+1]
+	return low + rune(g.rand.IntN(int(high-low)+1))
+}
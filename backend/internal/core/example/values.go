@@ -0,0 +1,271 @@
+package example
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	firstNames = []string{"Alice", "Bruno", "Chloe", "Dmitri", "Elena", "Farah", "Gustav", "Hana", "Ivan", "Julia", "Kofi", "Lena", "Mateo", "Nadia", "Omar", "Priya"}
+	lastNames  = []string{"Andersen", "Baptiste", "Costa", "Dubois", "Eriksen", "Fischer", "Garcia", "Hoffmann", "Ito", "Jansen", "Kowalski", "Lopez", "Moreau", "Nakamura", "Okafor", "Petrov"}
+	cities     = []string{"Amsterdam", "Berlin", "Cape Town", "Dublin", "Edinburgh", "Florence", "Geneva", "Helsinki", "Istanbul", "Kyoto", "Lisbon", "Montreal", "Nairobi", "Oslo", "Prague", "Seoul"}
+	countries  = []string{"NL", "DE", "ZA", "IE", "GB", "IT", "CH", "FI", "TR", "JP", "PT", "CA", "KE", "NO", "CZ", "KR"}
+	streets    = []string{"Elm Street", "High Street", "Station Road", "Park Lane", "Mill Road", "Church Street", "Queen Street", "River Walk"}
+	companies  = []string{"Acme", "Globex", "Initech", "Umbrella", "Hooli", "Vandelay", "Stark", "Wayne"}
+	words      = []string{"alpha", "amber", "anchor", "beacon", "birch", "cedar", "comet", "delta", "ember", "falcon", "garnet", "harbor", "indigo", "juniper", "kestrel", "lagoon", "maple", "nova", "orbit", "pebble", "quartz", "raven", "sierra", "tundra", "umber", "velvet", "willow", "zephyr"}
+	statuses   = []string{"active", "pending", "archived"}
+	alphabet   = "abcdefghijklmnopqrstuvwxyz0123456789"
+)
+
+// baseTime anchors generated dates, so they look recent but do not depend
+// on the clock
+var baseTime = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// str builds a string for a schema: one matching its pattern, one in its
+// format, or one suited to the property name, then fitted to the length
+// bounds when neither a pattern nor a format fixes the shape
+func (g *Generator) str(object map[string]interface{}, name string) string {
+	minLength, hasMin := toNumber(object["minLength"])
+	maxLength, hasMax := toNumber(object["maxLength"])
+	if !hasMax {
+		maxLength = math.Max(minLength, 64)
+	}
+
+	if pattern, ok := object["pattern"].(string); ok {
+		if value, ok := g.matching(pattern, int(minLength), int(maxLength)); ok {
+			return value
+		}
+	}
+
+	format, _ := object["format"].(string)
+	if value, ok := g.formatted(format); ok {
+		return value
+	}
+
+	value := g.named(name)
+	if hasMin || hasMax {
+		value = fitLength(value, int(minLength), int(maxLength), g.letters)
+	}
+	return value
+}
+
+// formatted returns a value in one of the string formats of JSON Schema and
+// OpenAPI
+func (g *Generator) formatted(format string) (string, bool) {
+	switch format {
+	case "date-time":
+		return g.time().Format(time.RFC3339), true
+	case "date":
+		return g.time().Format("2006-01-02"), true
+	case "time":
+		return g.time().Format("15:04:05Z"), true
+	case "email", "idn-email":
+		return g.email(), true
+	case "hostname", "idn-hostname":
+		return g.words(1) + ".example.com", true
+	case "ipv4":
+		return fmt.Sprintf("192.0.2.%d", 1+g.rand.IntN(254)), true
+	case "ipv6":
+		return fmt.Sprintf("2001:db8::%x", 1+g.rand.IntN(0xfffe)), true
+	case "uri", "url", "iri":
+		return "https://example.com/" + g.words(1), true
+	case "uri-reference", "iri-reference":
+		return "/" + g.words(1), true
+	case "uuid":
+		return g.uuid(), true
+	case "byte":
+		return base64.StdEncoding.EncodeToString([]byte(g.words(2))), true
+	case "binary":
+		return g.words(2), true
+	case "password":
+		return g.letters(12), true
+	case "regex":
+		return "^[a-z]+$", true
+	}
+	return "", false
+}
+
+// named returns a string suited to a property name, such as a person's name
+// for "name" or a city for "city"
+func (g *Generator) named(name string) string {
+	key := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+	switch {
+	case strings.Contains(key, "email"):
+		return g.email()
+	case key == "firstname" || key == "givenname":
+		return g.pick(firstNames)
+	case key == "lastname" || key == "surname" || key == "familyname":
+		return g.pick(lastNames)
+	case key == "username" || key == "login" || key == "handle":
+		return strings.ToLower(g.pick(firstNames)) + fmt.Sprint(g.rand.IntN(100))
+	case key == "name" || key == "fullname" || key == "displayname" || key == "author" || key == "owner":
+		return g.pick(firstNames) + " " + g.pick(lastNames)
+	case strings.Contains(key, "city") || key == "town":
+		return g.pick(cities)
+	case strings.Contains(key, "country"):
+		return g.pick(countries)
+	case strings.Contains(key, "street") || strings.Contains(key, "address"):
+		return fmt.Sprintf("%d %s", 1+g.rand.IntN(200), g.pick(streets))
+	case strings.Contains(key, "phone") || key == "mobile" || key == "fax":
+		return fmt.Sprintf("+1-555-%04d", g.rand.IntN(10000))
+	case strings.Contains(key, "company") || strings.Contains(key, "organization"):
+		return g.pick(companies)
+	case strings.Contains(key, "url") || strings.Contains(key, "uri") || strings.Contains(key, "link") || strings.Contains(key, "website"):
+		return "https://example.com/" + g.words(1)
+	case key == "id" || strings.HasSuffix(key, "id"):
+		return g.letters(8)
+	case strings.Contains(key, "description") || strings.Contains(key, "summary") || strings.Contains(key, "comment") || key == "text" || key == "message":
+		sentence := g.words(6)
+		return strings.ToUpper(sentence[:1]) + sentence[1:] + "."
+	case key == "status" || key == "state":
+		return g.pick(statuses)
+	case key == "title":
+		title := g.words(2)
+		return strings.ToUpper(title[:1]) + title[1:]
+	case strings.HasSuffix(key, "date") || strings.HasSuffix(key, "edat") || strings.HasSuffix(key, "time"):
+		return g.time().Format(time.RFC3339)
+	}
+	return g.words(1)
+}
+
+// integer returns a whole number within the schema's bounds and a multiple
+// of multipleOf
+func (g *Generator) integer(object map[string]interface{}) float64 {
+	low, high, step := bounds(object, 1)
+	if step < 1 || step != math.Trunc(step) {
+		step = 1
+	}
+	return g.within(low, high, step)
+}
+
+// number returns a number within the schema's bounds, with two decimals
+// unless multipleOf sets the step
+func (g *Generator) number(object map[string]interface{}) float64 {
+	low, high, step := bounds(object, 0.01)
+	value := g.within(low, high, step)
+	if step == 0.01 {
+		value = math.Round(value*100) / 100
+	}
+	return value
+}
+
+// within picks a multiple of step in [low, high]
+func (g *Generator) within(low, high, step float64) float64 {
+	first := math.Ceil(low/step - 1e-9)
+	last := math.Floor(high/step + 1e-9)
+	if last < first {
+		return low
+	}
+	span := last - first
+	if span > 1<<30 {
+		span = 1 << 30
+	}
+	return (first + float64(g.rand.Int64N(int64(span)+1))) * step
+}
+
+// bounds returns the inclusive range a number may take and the step between
+// allowed values. Unbounded sides make a range of 1000 steps from the other
+// bound, or 1 to 1000 when neither is set.
+func bounds(object map[string]interface{}, defaultStep float64) (float64, float64, float64) {
+	step := defaultStep
+	if multipleOf, ok := toNumber(object["multipleOf"]); ok && multipleOf > 0 {
+		step = multipleOf
+	}
+
+	low, hasLow := toNumber(object["minimum"])
+	if exclusive, ok := toNumber(object["exclusiveMinimum"]); ok {
+		low, hasLow = exclusive+step, true
+	} else if hasLow && object["exclusiveMinimum"] == true {
+		low += step
+	}
+
+	high, hasHigh := toNumber(object["maximum"])
+	if exclusive, ok := toNumber(object["exclusiveMaximum"]); ok {
+		high, hasHigh = exclusive-step, true
+	} else if hasHigh && object["exclusiveMaximum"] == true {
+		high -= step
+	}
+
+	scale := math.Max(step, 1)
+	switch {
+	case !hasLow && !hasHigh:
+		low, high = scale, 1000*scale
+	case !hasLow:
+		low = high - 1000*scale
+		if high > scale {
+			low = math.Max(low, scale)
+		}
+	case !hasHigh:
+		high = low + 1000*scale
+	}
+	return low, high, step
+}
+
+func (g *Generator) time() time.Time {
+	return baseTime.Add(time.Duration(g.rand.Int64N(365*24*60*60)) * time.Second)
+}
+
+func (g *Generator) email() string {
+	return strings.ToLower(g.pick(firstNames)+"."+g.pick(lastNames)) + "@example.com"
+}
+
+func (g *Generator) uuid() string {
+	b := make([]byte, 16)
+	for i := range b {
+		b[i] = byte(g.rand.IntN(256))
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// words returns n words joined by spaces
+func (g *Generator) words(n int) string {
+	chosen := make([]string, n)
+	for i := range chosen {
+		chosen[i] = g.pick(words)
+	}
+	return strings.Join(chosen, " ")
+}
+
+// letters returns n random lowercase letters and digits
+func (g *Generator) letters(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = alphabet[g.rand.IntN(len(alphabet))]
+	}
+	return string(b)
+}
+
+func (g *Generator) pick(list []string) string {
+	return list[g.rand.IntN(len(list))]
+}
+
+// fitLength pads or cuts a string to between min and max characters
+func fitLength(value string, min, max int, pad func(int) string) string {
+	if length := utf8.RuneCountInString(value); length < min {
+		value += pad(min - length)
+	}
+	if max >= 0 && utf8.RuneCountInString(value) > max {
+		value = string([]rune(value)[:max])
+	}
+	return value
+}
+
+// singular guesses the name of one item of a list property, such as "tag"
+// for "tags"
+func singular(name string) string {
+	switch {
+	case strings.HasSuffix(name, "ies"):
+		return strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "ses"):
+		return strings.TrimSuffix(name, "es")
+	case strings.HasSuffix(name, "s"):
+		return strings.TrimSuffix(name, "s")
+	}
+	return name
+}
@@ -0,0 +1,74 @@
+package jsonschema
+
+import (
+	"encoding/base64"
+	"math"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	uuidPattern     = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	hostnamePattern = regexp.MustCompile(`^(?i)[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*$`)
+	timePattern     = regexp.MustCompile(`^\d{2}:\d{2}:\d{2}(\.\d+)?(Z|z|[+-]\d{2}:\d{2})$`)
+)
+
+// checkStringFormat validates the string formats of JSON Schema and OpenAPI.
+// Unknown formats are annotations only and always pass.
+func checkStringFormat(format, value string) bool {
+	switch format {
+	case "date-time":
+		_, err := time.Parse(time.RFC3339Nano, strings.ToUpper(value))
+		return err == nil
+	case "date":
+		_, err := time.Parse("2006-01-02", value)
+		return err == nil
+	case "time":
+		return timePattern.MatchString(value)
+	case "email":
+		address, err := mail.ParseAddress(value)
+		return err == nil && address.Address == value
+	case "hostname":
+		return len(value) <= 253 && hostnamePattern.MatchString(value)
+	case "ipv4":
+		ip := net.ParseIP(value)
+		return ip != nil && ip.To4() != nil && !strings.Contains(value, ":")
+	case "ipv6":
+		ip := net.ParseIP(value)
+		return ip != nil && strings.Contains(value, ":")
+	case "uri":
+		parsed, err := url.Parse(value)
+		return err == nil && parsed.Scheme != ""
+	case "uri-reference":
+		_, err := url.Parse(value)
+		return err == nil
+	case "uuid":
+		return uuidPattern.MatchString(value)
+	case "byte":
+		_, err := base64.StdEncoding.DecodeString(value)
+		return err == nil
+	case "regex":
+		_, err := regexp.Compile(value)
+		return err == nil
+	default:
+		return true
+	}
+}
+
+// checkNumberFormat validates the OpenAPI numeric formats
+func checkNumberFormat(format string, value float64) bool {
+	switch format {
+	case "int32":
+		return value == math.Trunc(value) && value >= math.MinInt32 && value <= math.MaxInt32
+	case "int64":
+		return value == math.Trunc(value) && value >= math.MinInt64 && value <= math.MaxInt64
+	case "float":
+		return math.Abs(value) <= math.MaxFloat32
+	default:
+		return true
+	}
+}
@@ -0,0 +1,582 @@
+// Package jsonschema validates JSON values against the schema objects of an
+// API definition. It understands both the OpenAPI 3.0 dialect (nullable,
+// boolean exclusive bounds) and JSON Schema 2020-12 as used by OpenAPI 3.1.
+package jsonschema
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// Refs looks up the schema a $ref points at. resolver.SchemaResolver
+// implements it for the schemas of an API definition.
+type Refs interface {
+	Lookup(ref string) (interface{}, error)
+}
+
+// Validator checks instances against schemas. References are looked up in
+// the schemas of the definition the validator was created for.
+type Validator struct {
+	refs     Refs
+	patterns map[string]*regexp.Regexp
+}
+
+// New creates a validator; refs may be nil when schemas have no references
+func New(refs Refs) *Validator {
+	return &Validator{
+		refs:     refs,
+		patterns: make(map[string]*regexp.Regexp),
+	}
+}
+
+// Validate returns every violation of schema by instance. Instances are
+// values decoded by encoding/json; each error's Path is the JSON Pointer of
+// the offending value within the instance.
+func (v *Validator) Validate(schema interface{}, instance interface{}) []domain.ValidationError {
+	s := &state{validator: v, active: make(map[string]bool)}
+	s.validate(schema, instance, "")
+	return s.errs
+}
+
+// state collects the errors of one validation run
+type state struct {
+	validator *Validator
+	errs      []domain.ValidationError
+	active    map[string]bool
+}
+
+func (s *state) fail(pointer, keyword, message string, params map[string]interface{}) {
+	if pointer == "" {
+		pointer = "/"
+	}
+	s.errs = append(s.errs, domain.ValidationError{
+		Path:    pointer,
+		Message: message,
+		Keyword: keyword,
+		Params:  params,
+	})
+}
+
+// check runs a subschema in isolation and reports whether it passed
+func (s *state) check(schema interface{}, instance interface{}, pointer string) bool {
+	return len(s.run(schema, instance, pointer)) == 0
+}
+
+// run validates against a subschema in isolation and returns its errors
+func (s *state) run(schema interface{}, instance interface{}, pointer string) []domain.ValidationError {
+	sub := &state{validator: s.validator, active: s.active}
+	sub.validate(schema, instance, pointer)
+	return sub.errs
+}
+
+func (s *state) validate(schema interface{}, instance interface{}, pointer string) {
+	switch sch := schema.(type) {
+	case bool:
+		if !sch {
+			s.fail(pointer, "false schema", "no value is allowed here", nil)
+		}
+		return
+	case string:
+		// A bare schema ID, as used for links to named schemas
+		schema = map[string]interface{}{"$ref": sch}
+	}
+
+	object, ok := schema.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	if ref, ok := object["$ref"].(string); ok {
+		s.validateRef(ref, instance, pointer)
+		// Before 2020-12, $ref replaced the whole schema
+		if len(object) == 1 {
+			return
+		}
+	}
+
+	if instance == nil && object["nullable"] == true {
+		return
+	}
+
+	if !s.validateType(object, instance, pointer) {
+		// The remaining keywords only make sense for the declared type
+		return
+	}
+
+	s.validateGeneric(object, instance, pointer)
+
+	switch value := instance.(type) {
+	case float64:
+		s.validateNumber(object, value, pointer)
+	case string:
+		s.validateString(object, value, pointer)
+	case []interface{}:
+		s.validateArray(object, value, pointer)
+	case map[string]interface{}:
+		s.validateObject(object, value, pointer)
+	}
+
+	s.validateComposition(object, instance, pointer)
+}
+
+func (s *state) validateRef(ref string, instance interface{}, pointer string) {
+	if s.validator.refs == nil {
+		s.fail(pointer, "$ref", fmt.Sprintf("cannot resolve %s: no definition to look it up in", ref), map[string]interface{}{"ref": ref})
+		return
+	}
+
+	// The same reference applied to the same value again means a loop that
+	// does not consume any input
+	key := ref + "@" + pointer
+	if s.active[key] {
+		return
+	}
+
+	target, err := s.validator.refs.Lookup(ref)
+	if err != nil {
+		s.fail(pointer, "$ref", err.Error(), map[string]interface{}{"ref": ref})
+		return
+	}
+
+	s.active[key] = true
+	s.validate(target, instance, pointer)
+	delete(s.active, key)
+}
+
+// validateType checks type and reports whether the instance has a type the
+// schema allows
+func (s *state) validateType(object map[string]interface{}, instance interface{}, pointer string) bool {
+	var allowed []string
+	switch t := object["type"].(type) {
+	case string:
+		allowed = []string{t}
+	case []interface{}:
+		for _, item := range t {
+			if name, ok := item.(string); ok {
+				allowed = append(allowed, name)
+			}
+		}
+	}
+	if len(allowed) == 0 {
+		return true
+	}
+
+	for _, name := range allowed {
+		if hasType(instance, name) {
+			return true
+		}
+	}
+
+	s.fail(pointer, "type", fmt.Sprintf("must be %s", strings.Join(allowed, " or ")),
+		map[string]interface{}{"type": strings.Join(allowed, ","), "actual": typeOf(instance)})
+	return false
+}
+
+func (s *state) validateGeneric(object map[string]interface{}, instance interface{}, pointer string) {
+	if enum, ok := object["enum"].([]interface{}); ok {
+		found := false
+		for _, allowed := range enum {
+			if equal(allowed, instance) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			s.fail(pointer, "enum", "must be equal to one of the allowed values",
+				map[string]interface{}{"allowedValues": enum})
+		}
+	}
+
+	if constant, ok := object["const"]; ok && !equal(constant, instance) {
+		s.fail(pointer, "const", "must be equal to constant",
+			map[string]interface{}{"allowedValue": constant})
+	}
+}
+
+func (s *state) validateNumber(object map[string]interface{}, value float64, pointer string) {
+	// OpenAPI 3.0 marks the bound exclusive with a flag; 2020-12 uses a number
+	exclusiveMin, _ := object["exclusiveMinimum"].(bool)
+	exclusiveMax, _ := object["exclusiveMaximum"].(bool)
+
+	if limit, ok := number(object["minimum"]); ok {
+		if exclusiveMin && value <= limit {
+			s.fail(pointer, "exclusiveMinimum", fmt.Sprintf("must be > %v", limit), limitParams(">", limit))
+		} else if value < limit {
+			s.fail(pointer, "minimum", fmt.Sprintf("must be >= %v", limit), limitParams(">=", limit))
+		}
+	}
+	if limit, ok := number(object["maximum"]); ok {
+		if exclusiveMax && value >= limit {
+			s.fail(pointer, "exclusiveMaximum", fmt.Sprintf("must be < %v", limit), limitParams("<", limit))
+		} else if value > limit {
+			s.fail(pointer, "maximum", fmt.Sprintf("must be <= %v", limit), limitParams("<=", limit))
+		}
+	}
+	if limit, ok := number(object["exclusiveMinimum"]); ok && value <= limit {
+		s.fail(pointer, "exclusiveMinimum", fmt.Sprintf("must be > %v", limit), limitParams(">", limit))
+	}
+	if limit, ok := number(object["exclusiveMaximum"]); ok && value >= limit {
+		s.fail(pointer, "exclusiveMaximum", fmt.Sprintf("must be < %v", limit), limitParams("<", limit))
+	}
+
+	if divisor, ok := number(object["multipleOf"]); ok && divisor > 0 {
+		quotient := value / divisor
+		if math.Abs(quotient-math.Round(quotient)) > 1e-9 {
+			s.fail(pointer, "multipleOf", fmt.Sprintf("must be multiple of %v", divisor),
+				map[string]interface{}{"multipleOf": divisor})
+		}
+	}
+
+	if format, ok := object["format"].(string); ok && !checkNumberFormat(format, value) {
+		s.fail(pointer, "format", fmt.Sprintf("must match format %q", format),
+			map[string]interface{}{"format": format})
+	}
+}
+
+func (s *state) validateString(object map[string]interface{}, value string, pointer string) {
+	length := utf8.RuneCountInString(value)
+
+	if limit, ok := number(object["minLength"]); ok && float64(length) < limit {
+		s.fail(pointer, "minLength", fmt.Sprintf("must NOT have fewer than %v characters", limit),
+			map[string]interface{}{"limit": limit})
+	}
+	if limit, ok := number(object["maxLength"]); ok && float64(length) > limit {
+		s.fail(pointer, "maxLength", fmt.Sprintf("must NOT have more than %v characters", limit),
+			map[string]interface{}{"limit": limit})
+	}
+
+	if pattern, ok := object["pattern"].(string); ok {
+		re, err := s.validator.pattern(pattern)
+		if err != nil {
+			s.fail(pointer, "pattern", fmt.Sprintf("invalid pattern %q: %v", pattern, err),
+				map[string]interface{}{"pattern": pattern})
+		} else if !re.MatchString(value) {
+			s.fail(pointer, "pattern", fmt.Sprintf("must match pattern %q", pattern),
+				map[string]interface{}{"pattern": pattern})
+		}
+	}
+
+	if format, ok := object["format"].(string); ok && !checkStringFormat(format, value) {
+		s.fail(pointer, "format", fmt.Sprintf("must match format %q", format),
+			map[string]interface{}{"format": format})
+	}
+}
+
+func (s *state) validateArray(object map[string]interface{}, items []interface{}, pointer string) {
+	if limit, ok := number(object["minItems"]); ok && float64(len(items)) < limit {
+		s.fail(pointer, "minItems", fmt.Sprintf("must NOT have fewer than %v items", limit),
+			map[string]interface{}{"limit": limit})
+	}
+	if limit, ok := number(object["maxItems"]); ok && float64(len(items)) > limit {
+		s.fail(pointer, "maxItems", fmt.Sprintf("must NOT have more than %v items", limit),
+			map[string]interface{}{"limit": limit})
+	}
+
+	if unique, _ := object["uniqueItems"].(bool); unique {
+		for i := 0; i < len(items); i++ {
+			for j := i + 1; j < len(items); j++ {
+				if equal(items[i], items[j]) {
+					s.fail(pointer, "uniqueItems", fmt.Sprintf("must NOT have duplicate items (items ## %d and %d are identical)", j, i),
+						map[string]interface{}{"i": j, "j": i})
+				}
+			}
+		}
+	}
+
+	prefix, _ := object["prefixItems"].([]interface{})
+	for i, schema := range prefix {
+		if i < len(items) {
+			s.validate(schema, items[i], resolver.JoinPointer(pointer, fmt.Sprint(i)))
+		}
+	}
+
+	if schema, ok := object["items"]; ok {
+		for i := len(prefix); i < len(items); i++ {
+			s.validate(schema, items[i], resolver.JoinPointer(pointer, fmt.Sprint(i)))
+		}
+	}
+}
+
+func (s *state) validateObject(object map[string]interface{}, value map[string]interface{}, pointer string) {
+	if required, ok := object["required"].([]interface{}); ok {
+		for _, item := range required {
+			name, _ := item.(string)
+			if _, present := value[name]; !present {
+				s.fail(pointer, "required", fmt.Sprintf("must have required property '%s'", name),
+					map[string]interface{}{"missingProperty": name})
+			}
+		}
+	}
+
+	if limit, ok := number(object["minProperties"]); ok && float64(len(value)) < limit {
+		s.fail(pointer, "minProperties", fmt.Sprintf("must NOT have fewer than %v properties", limit),
+			map[string]interface{}{"limit": limit})
+	}
+	if limit, ok := number(object["maxProperties"]); ok && float64(len(value)) > limit {
+		s.fail(pointer, "maxProperties", fmt.Sprintf("must NOT have more than %v properties", limit),
+			map[string]interface{}{"limit": limit})
+	}
+
+	properties, _ := object["properties"].(map[string]interface{})
+	patternProperties, _ := object["patternProperties"].(map[string]interface{})
+	additional, hasAdditional := object["additionalProperties"]
+
+	for _, name := range sortedKeys(value) {
+		child := resolver.JoinPointer(pointer, name)
+		matched := false
+
+		if schema, ok := properties[name]; ok {
+			matched = true
+			s.validate(schema, value[name], child)
+		}
+		for pattern, schema := range patternProperties {
+			if re, err := s.validator.pattern(pattern); err == nil && re.MatchString(name) {
+				matched = true
+				s.validate(schema, value[name], child)
+			}
+		}
+
+		if matched || !hasAdditional {
+			continue
+		}
+		if allowed, ok := additional.(bool); ok {
+			if !allowed {
+				s.fail(pointer, "additionalProperties", "must NOT have additional properties",
+					map[string]interface{}{"additionalProperty": name})
+			}
+			continue
+		}
+		s.validate(additional, value[name], child)
+	}
+}
+
+func (s *state) validateComposition(object map[string]interface{}, instance interface{}, pointer string) {
+	if allOf, ok := object["allOf"].([]interface{}); ok {
+		for _, schema := range allOf {
+			s.validate(schema, instance, pointer)
+		}
+	}
+
+	if anyOf, ok := object["anyOf"].([]interface{}); ok {
+		var failures [][]domain.ValidationError
+		for _, schema := range anyOf {
+			errs := s.run(schema, instance, pointer)
+			if len(errs) == 0 {
+				failures = nil
+				break
+			}
+			failures = append(failures, errs)
+		}
+		if failures != nil {
+			s.fail(pointer, "anyOf", "must match a schema in anyOf", nil)
+			s.errs = append(s.errs, closestBranch(failures, pointer)...)
+		}
+	}
+
+	if oneOf, ok := object["oneOf"].([]interface{}); ok {
+		var passing []int
+		var failures [][]domain.ValidationError
+		for i, schema := range oneOf {
+			errs := s.run(schema, instance, pointer)
+			if len(errs) == 0 {
+				passing = append(passing, i)
+			}
+			failures = append(failures, errs)
+		}
+		if len(passing) != 1 {
+			params := map[string]interface{}{"passingSchemas": nil}
+			if len(passing) > 1 {
+				params["passingSchemas"] = passing
+			}
+			s.fail(pointer, "oneOf", "must match exactly one schema in oneOf", params)
+			if len(passing) == 0 {
+				s.errs = append(s.errs, closestBranch(failures, pointer)...)
+			}
+		}
+	}
+
+	if not, ok := object["not"]; ok && s.check(not, instance, pointer) {
+		s.fail(pointer, "not", "must NOT be valid", nil)
+	}
+}
+
+// closestBranch picks the errors of the anyOf or oneOf branch the instance
+// came nearest to matching, so the report points inside it. Branches that
+// fail on the instance's type, on a fixed value of one of its properties or
+// on a missing $ref were written for another kind of value and are skipped.
+func closestBranch(failures [][]domain.ValidationError, pointer string) []domain.ValidationError {
+	prefix := pointer + "/"
+	if pointer == "" {
+		pointer = "/"
+	}
+
+	var closest []domain.ValidationError
+	for _, errs := range failures {
+		unrelated := false
+		for _, err := range errs {
+			child := strings.TrimPrefix(err.Path, prefix)
+			switch {
+			case err.Path == pointer && err.Keyword == "type":
+				unrelated = true
+			case err.Path == pointer && err.Keyword == "required" && missingProperty(err) == "$ref":
+				unrelated = true
+			case (err.Keyword == "enum" || err.Keyword == "const") && child != err.Path && !strings.Contains(child, "/"):
+				unrelated = true
+			}
+		}
+		if !unrelated && (closest == nil || len(errs) < len(closest)) {
+			closest = errs
+		}
+	}
+
+	// Nested anyOf and oneOf failures at the same place repeat the caller's
+	var details []domain.ValidationError
+	for _, err := range closest {
+		if err.Path == pointer && (err.Keyword == "anyOf" || err.Keyword == "oneOf") {
+			continue
+		}
+		details = append(details, err)
+	}
+	return details
+}
+
+func missingProperty(err domain.ValidationError) string {
+	params, _ := err.Params.(map[string]interface{})
+	name, _ := params["missingProperty"].(string)
+	return name
+}
+
+// pattern compiles and caches a regular expression
+func (v *Validator) pattern(pattern string) (*regexp.Regexp, error) {
+	if re, ok := v.patterns[pattern]; ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	v.patterns[pattern] = re
+	return re, nil
+}
+
+func hasType(instance interface{}, name string) bool {
+	switch name {
+	case "null":
+		return instance == nil
+	case "boolean":
+		_, ok := instance.(bool)
+		return ok
+	case "string":
+		_, ok := instance.(string)
+		return ok
+	case "number":
+		_, ok := instance.(float64)
+		return ok
+	case "integer":
+		value, ok := instance.(float64)
+		return ok && value == math.Trunc(value) && !math.IsInf(value, 0)
+	case "array":
+		_, ok := instance.([]interface{})
+		return ok
+	case "object":
+		_, ok := instance.(map[string]interface{})
+		return ok
+	default:
+		return true
+	}
+}
+
+func typeOf(instance interface{}) string {
+	switch value := instance.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case float64:
+		if value == math.Trunc(value) {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", instance)
+	}
+}
+
+// number reads a numeric keyword value, whichever numeric type it was decoded as
+func number(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+// equal compares decoded JSON values, treating every numeric type alike
+func equal(a, b interface{}) bool {
+	if x, ok := number(a); ok {
+		y, ok := number(b)
+		return ok && x == y
+	}
+
+	switch x := a.(type) {
+	case []interface{}:
+		y, ok := b.([]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !equal(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		y, ok := b.(map[string]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for key, value := range x {
+			other, ok := y[key]
+			if !ok || !equal(value, other) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+func limitParams(comparison string, limit float64) map[string]interface{} {
+	return map[string]interface{}{"comparison": comparison, "limit": limit}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,191 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// testRefs looks references up in a fixed set of schemas
+type testRefs map[string]interface{}
+
+func (r testRefs) Lookup(ref string) (interface{}, error) {
+	if schema, ok := r[ref]; ok {
+		return schema, nil
+	}
+	return nil, &resolver.RefError{Ref: ref, Err: resolver.ErrNotFound}
+}
+
+func decode(t *testing.T, content string) interface{} {
+	t.Helper()
+	var value interface{}
+	if err := json.Unmarshal([]byte(content), &value); err != nil {
+		t.Fatalf("bad test value %s: %v", content, err)
+	}
+	return value
+}
+
+// TestValidate validates instances against schemas of both dialects and
+// lists each error as its path and keyword
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   string
+		instance string
+		want     []string
+	}{
+		{"type matches", `{"type": "string"}`, `"rex"`, nil},
+		{"type mismatch", `{"type": "string"}`, `3`, []string{"/ type"}},
+		{"integer rejects fractions", `{"type": "integer"}`, `3.5`, []string{"/ type"}},
+		{"type list", `{"type": ["string", "null"]}`, `null`, nil},
+		{"nullable", `{"type": "string", "nullable": true}`, `null`, nil},
+		{"not nullable", `{"type": "string"}`, `null`, []string{"/ type"}},
+		{"enum", `{"enum": ["a", 1]}`, `1.0`, nil},
+		{"enum miss", `{"enum": ["a", "b"]}`, `"c"`, []string{"/ enum"}},
+		{"const", `{"const": {"a": [1]}}`, `{"a": [2]}`, []string{"/ const"}},
+
+		{"bounds", `{"minimum": 1, "maximum": 5}`, `6`, []string{"/ maximum"}},
+		{"3.0 exclusive flag", `{"minimum": 1, "exclusiveMinimum": true}`, `1`, []string{"/ exclusiveMinimum"}},
+		{"2020-12 exclusive number", `{"exclusiveMaximum": 5}`, `5`, []string{"/ exclusiveMaximum"}},
+		{"multipleOf decimals", `{"multipleOf": 0.1}`, `0.3`, nil},
+		{"multipleOf", `{"multipleOf": 2}`, `3`, []string{"/ multipleOf"}},
+		{"int32 range", `{"type": "integer", "format": "int32"}`, `3000000000`, []string{"/ format"}},
+
+		{"length counts characters", `{"maxLength": 2}`, `"éé"`, nil},
+		{"minLength", `{"minLength": 3}`, `"ab"`, []string{"/ minLength"}},
+		{"pattern", `{"pattern": "^[a-z]+$"}`, `"Rex"`, []string{"/ pattern"}},
+		{"bad pattern", `{"pattern": "("}`, `"x"`, []string{"/ pattern"}},
+		{"format", `{"format": "email"}`, `"not an address"`, []string{"/ format"}},
+		{"unknown format", `{"format": "color"}`, `"red"`, nil},
+
+		{"items", `{"items": {"type": "integer"}}`, `[1, "2", 3]`, []string{"/1 type"}},
+		{"prefixItems then items", `{"prefixItems": [{"type": "string"}], "items": {"type": "integer"}}`, `["a", 1, "b"]`, []string{"/2 type"}},
+		{"false items", `{"prefixItems": [{}], "items": false}`, `[1, 2]`, []string{"/1 false schema"}},
+		{"item counts", `{"minItems": 2, "maxItems": 3}`, `[1]`, []string{"/ minItems"}},
+		{"uniqueItems", `{"uniqueItems": true}`, `[{"a": 1}, {"a": 1.0}]`, []string{"/ uniqueItems"}},
+
+		{"required", `{"required": ["name", "id"]}`, `{"id": 1}`, []string{"/ required"}},
+		{"properties", `{"properties": {"age": {"minimum": 0}}}`, `{"age": -1}`, []string{"/age minimum"}},
+		{"additionalProperties false", `{"properties": {"a": {}}, "additionalProperties": false}`, `{"a": 1, "b": 2}`, []string{"/ additionalProperties"}},
+		{"additionalProperties schema", `{"additionalProperties": {"type": "string"}}`, `{"a": 1}`, []string{"/a type"}},
+		{"patternProperties", `{"patternProperties": {"^x-": {"type": "string"}}, "additionalProperties": false}`, `{"x-a": 1}`, []string{"/x-a type"}},
+		{"property counts", `{"maxProperties": 1}`, `{"a": 1, "b": 2}`, []string{"/ maxProperties"}},
+		{"escaped property path", `{"properties": {"a/b": {"type": "string"}}}`, `{"a/b": 1}`, []string{"/a~1b type"}},
+
+		{"allOf", `{"allOf": [{"required": ["a"]}, {"required": ["b"]}]}`, `{}`, []string{"/ required", "/ required"}},
+		{"anyOf match", `{"anyOf": [{"type": "string"}, {"type": "integer"}]}`, `1`, nil},
+		{"anyOf points into the closest branch", `{"anyOf": [
+			{"type": "string"},
+			{"type": "object", "properties": {"age": {"type": "integer"}}}
+		]}`, `{"age": "old"}`, []string{"/ anyOf", "/age type"}},
+		{"oneOf none", `{"oneOf": [{"type": "string"}, {"type": "boolean"}]}`, `1`, []string{"/ oneOf"}},
+		{"oneOf several", `{"oneOf": [{"type": "number"}, {"type": "integer"}]}`, `1`, []string{"/ oneOf"}},
+		{"oneOf skips branches for another kind", `{"oneOf": [
+			{"properties": {"kind": {"const": "cat"}, "lives": {"type": "integer"}}, "required": ["kind"]},
+			{"properties": {"kind": {"const": "dog"}, "bark": {"type": "string"}}, "required": ["kind"]}
+		]}`, `{"kind": "dog", "bark": 1}`, []string{"/ oneOf", "/bark type"}},
+		{"not", `{"not": {"type": "string"}}`, `"x"`, []string{"/ not"}},
+		{"false schema", `false`, `1`, []string{"/ false schema"}},
+		{"true schema", `true`, `1`, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := New(nil).Validate(decode(t, tt.schema), decode(t, tt.instance))
+			var got []string
+			for _, err := range errs {
+				got = append(got, err.Path+" "+err.Keyword)
+			}
+			if strings.Join(got, ", ") != strings.Join(tt.want, ", ") {
+				t.Errorf("errors = %v, want %v", got, tt.want)
+				for _, err := range errs {
+					t.Logf("  %s: %s", err.Path, err.Message)
+				}
+			}
+		})
+	}
+}
+
+// TestValidateRefs follows schema IDs and $ref objects, stops on recursive
+// schemas and reports references that do not resolve
+func TestValidateRefs(t *testing.T) {
+	refs := testRefs{
+		"schema-node": decode(t, `{
+			"type": "object",
+			"required": ["name"],
+			"properties": {"name": {"type": "string"}, "children": {"type": "array", "items": "schema-node"}}
+		}`),
+		"schema-loop": decode(t, `{"$ref": "schema-loop"}`),
+	}
+	v := New(refs)
+
+	errs := v.Validate("schema-node", decode(t, `{"name": "root", "children": [{"name": "a"}, {"children": []}]}`))
+	if len(errs) != 1 || errs[0].Path != "/children/1" || errs[0].Keyword != "required" {
+		t.Errorf("errors = %v, want the second child missing its name", errs)
+	}
+
+	if errs := v.Validate(map[string]interface{}{"$ref": "schema-loop"}, 1.0); len(errs) != 0 {
+		t.Errorf("reference loop gave %v, want no errors and no hang", errs)
+	}
+
+	errs = v.Validate(decode(t, `{"properties": {"owner": {"$ref": "schema-owner"}}}`), decode(t, `{"owner": {}}`))
+	if len(errs) != 1 || errs[0].Path != "/owner" || errs[0].Keyword != "$ref" {
+		t.Errorf("errors = %v, want the broken reference reported at /owner", errs)
+	}
+
+	if errs := New(nil).Validate("schema-node", 1.0); len(errs) != 1 || errs[0].Keyword != "$ref" {
+		t.Errorf("errors without refs = %v, want one $ref error", errs)
+	}
+}
+
+// TestStringFormats checks a valid and an invalid value of each format
+func TestStringFormats(t *testing.T) {
+	tests := []struct {
+		format, valid, invalid string
+	}{
+		{"date-time", "2024-01-02T03:04:05.123+01:00", "2024-01-02 03:04:05"},
+		{"date", "2024-02-29", "2023-02-29"},
+		{"time", "03:04:05Z", "3:04"},
+		{"email", "ann@example.com", "Ann <ann@example.com>"},
+		{"hostname", "api.example.com", "-api.example.com"},
+		{"ipv4", "192.168.0.1", "::ffff:192.168.0.1"},
+		{"ipv6", "2001:db8::1", "192.168.0.1"},
+		{"uri", "https://example.com/a", "/relative"},
+		{"uuid", "123e4567-e89b-12d3-a456-426614174000", "123e4567e89b12d3a456426614174000"},
+		{"byte", "cGV0", "not base64!"},
+		{"regex", "^a+$", "("},
+	}
+	for _, tt := range tests {
+		if !checkStringFormat(tt.format, tt.valid) {
+			t.Errorf("%s: %q rejected", tt.format, tt.valid)
+		}
+		if checkStringFormat(tt.format, tt.invalid) {
+			t.Errorf("%s: %q accepted", tt.format, tt.invalid)
+		}
+	}
+}
+
+// TestNumberFormats checks the OpenAPI numeric formats at their limits
+func TestNumberFormats(t *testing.T) {
+	tests := []struct {
+		format string
+		value  float64
+		want   bool
+	}{
+		{"int32", 2147483647, true},
+		{"int32", 2147483648, false},
+		{"int32", 1.5, false},
+		{"int64", -9007199254740992, true},
+		{"float", 3.4e38, true},
+		{"float", 3.5e38, false},
+		{"double", 1e300, true},
+	}
+	for _, tt := range tests {
+		if got := checkNumberFormat(tt.format, tt.value); got != tt.want {
+			t.Errorf("%s %s = %t, want %t", tt.format, fmt.Sprint(tt.value), got, tt.want)
+		}
+	}
+}
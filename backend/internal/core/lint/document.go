@@ -0,0 +1,265 @@
+package lint
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// httpMethods lists the operation keys of a path item
+var httpMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// document gives rules a version-independent view of a Swagger 2.0 or
+// OpenAPI 3.x document. Traversals visit keys in sorted order so findings
+// come out the same on every run.
+type document struct {
+	spec     map[string]interface{}
+	swagger2 bool
+}
+
+func newDocument(spec map[string]interface{}) *document {
+	version, _ := spec["swagger"].(string)
+	return &document{
+		spec:     spec,
+		swagger2: strings.HasPrefix(version, "2."),
+	}
+}
+
+// operation is one operation of a path item together with where it is
+type operation struct {
+	path    string
+	method  string
+	pointer string
+	value   map[string]interface{}
+}
+
+// operations lists the operations under paths and webhooks
+func (d *document) operations() []operation {
+	var operations []operation
+	for _, section := range []string{"paths", "webhooks"} {
+		items := mapValue(d.spec[section])
+		for _, path := range sortedKeys(items) {
+			pathItem := mapValue(d.resolve(items[path]))
+			for _, method := range httpMethods {
+				value, ok := pathItem[method].(map[string]interface{})
+				if !ok {
+					continue
+				}
+				operations = append(operations, operation{
+					path:    path,
+					method:  method,
+					pointer: resolver.JoinPointer("/"+section, path, method),
+					value:   value,
+				})
+			}
+		}
+	}
+	return operations
+}
+
+// paths lists the keys of the paths object that are paths
+func (d *document) paths() []string {
+	var paths []string
+	for _, path := range sortedKeys(mapValue(d.spec["paths"])) {
+		if strings.HasPrefix(path, "/") {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// component is a named reusable object and the pointer a $ref uses for it
+type component struct {
+	kind    string
+	name    string
+	pointer string
+	value   interface{}
+}
+
+// componentSections maps the sections holding reusable objects to the kind
+// of object they hold
+func (d *document) componentSections() [][2]string {
+	if d.swagger2 {
+		return [][2]string{{"/definitions", "schema"}, {"/parameters", "parameter"}, {"/responses", "response"}}
+	}
+	return [][2]string{
+		{"/components/schemas", "schema"},
+		{"/components/parameters", "parameter"},
+		{"/components/responses", "response"},
+		{"/components/requestBodies", "requestBody"},
+		{"/components/headers", "header"},
+		{"/components/examples", "example"},
+		{"/components/links", "link"},
+		{"/components/callbacks", "callback"},
+		{"/components/pathItems", "pathItem"},
+	}
+}
+
+func (d *document) components() []component {
+	var components []component
+	for _, section := range d.componentSections() {
+		value, err := resolver.Get(d.spec, section[0])
+		if err != nil {
+			continue
+		}
+		items := mapValue(value)
+		for _, name := range sortedKeys(items) {
+			components = append(components, component{
+				kind:    section[1],
+				name:    name,
+				pointer: resolver.JoinPointer(section[0], name),
+				value:   items[name],
+			})
+		}
+	}
+	return components
+}
+
+// schemaRoots calls fn with every schema that is not nested in another
+// schema: named schemas and the schemas of parameters, bodies, responses
+// and headers, wherever they are declared
+func (d *document) schemaRoots(fn func(schema interface{}, pointer string)) {
+	visitContent := func(content interface{}, pointer string) {
+		media := mapValue(content)
+		for _, mediaType := range sortedKeys(media) {
+			if schema, ok := mapValue(media[mediaType])["schema"]; ok {
+				fn(schema, resolver.JoinPointer(pointer, mediaType, "schema"))
+			}
+		}
+	}
+
+	// visit handles any parameter, header, response or request body object
+	visit := func(value interface{}, pointer string) {
+		object := mapValue(value)
+		if schema, ok := object["schema"]; ok {
+			fn(schema, resolver.JoinPointer(pointer, "schema"))
+		}
+		visitContent(object["content"], resolver.JoinPointer(pointer, "content"))
+
+		headers := mapValue(object["headers"])
+		for _, name := range sortedKeys(headers) {
+			header := mapValue(headers[name])
+			if schema, ok := header["schema"]; ok {
+				fn(schema, resolver.JoinPointer(pointer, "headers", name, "schema"))
+			}
+		}
+	}
+
+	for _, c := range d.components() {
+		switch c.kind {
+		case "schema":
+			fn(c.value, c.pointer)
+		case "parameter", "response", "requestBody", "header":
+			visit(c.value, c.pointer)
+		}
+	}
+
+	for _, op := range d.operations() {
+		params, _ := op.value["parameters"].([]interface{})
+		for i, param := range params {
+			visit(param, resolver.JoinPointer(op.pointer, "parameters", strconv.Itoa(i)))
+		}
+		visit(op.value["requestBody"], resolver.JoinPointer(op.pointer, "requestBody"))
+
+		responses := mapValue(op.value["responses"])
+		for _, code := range sortedKeys(responses) {
+			visit(responses[code], resolver.JoinPointer(op.pointer, "responses", code))
+		}
+	}
+}
+
+// walkSchema calls fn for schema and every schema nested in it. Referenced
+// schemas are not entered; they are visited where they are declared.
+func walkSchema(schema interface{}, pointer string, fn func(schema map[string]interface{}, pointer string)) {
+	object, ok := schema.(map[string]interface{})
+	if !ok {
+		return
+	}
+	if _, isRef := object["$ref"]; isRef {
+		return
+	}
+
+	fn(object, pointer)
+
+	properties := mapValue(object["properties"])
+	for _, name := range sortedKeys(properties) {
+		walkSchema(properties[name], resolver.JoinPointer(pointer, "properties", name), fn)
+	}
+
+	for _, key := range []string{"items", "additionalProperties", "not"} {
+		switch value := object[key].(type) {
+		case map[string]interface{}:
+			walkSchema(value, resolver.JoinPointer(pointer, key), fn)
+		case []interface{}:
+			for i, item := range value {
+				walkSchema(item, resolver.JoinPointer(pointer, key, strconv.Itoa(i)), fn)
+			}
+		}
+	}
+
+	for _, key := range []string{"allOf", "anyOf", "oneOf", "prefixItems"} {
+		list, _ := object[key].([]interface{})
+		for i, item := range list {
+			walkSchema(item, resolver.JoinPointer(pointer, key, strconv.Itoa(i)), fn)
+		}
+	}
+}
+
+// resolve follows local references; other values are returned as they are
+func (d *document) resolve(value interface{}) interface{} {
+	for i := 0; i < 32; i++ {
+		ref, ok := mapValue(value)["$ref"].(string)
+		if !ok {
+			return value
+		}
+		file, pointer, err := resolver.SplitRef(ref)
+		if err != nil || file != "" {
+			return value
+		}
+		target, err := resolver.Get(d.spec, pointer)
+		if err != nil {
+			return value
+		}
+		value = target
+	}
+	return value
+}
+
+// refs collects every $ref value in the document
+func (d *document) refs() map[string]bool {
+	refs := make(map[string]bool)
+	var walk func(value interface{})
+	walk = func(value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if ref, ok := v["$ref"].(string); ok {
+				refs[ref] = true
+			}
+			for _, item := range v {
+				walk(item)
+			}
+		case []interface{}:
+			for _, item := range v {
+				walk(item)
+			}
+		}
+	}
+	walk(d.spec)
+	return refs
+}
+
+func mapValue(value interface{}) map[string]interface{} {
+	m, _ := value.(map[string]interface{})
+	return m
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,328 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// Rule is a built-in lint rule. Check reports each violation it finds with
+// the JSON Pointer of the offending value.
+type Rule struct {
+	ID          string
+	Description string
+	Severity    Severity
+	Check       func(doc *document, options map[string]interface{}, report func(path, message string))
+
+	// CheckOptions rejects options the rule cannot work with; it may be nil
+	CheckOptions func(options map[string]interface{}) error
+}
+
+var (
+	kebabSegmentPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*(\.[a-z0-9]+)?$`)
+	camelCasePattern    = regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`)
+	templatePattern     = regexp.MustCompile(`\{[^{}]*\}`)
+
+	// defaultVersionPattern matches a version segment such as /v1 or /v2.1
+	defaultVersionPattern = `/v[0-9]+(\.[0-9]+)*(/|$)`
+)
+
+// builtinRules lists every rule in the order findings are reported
+var builtinRules = []Rule{
+	{
+		ID:          "path-kebab-case",
+		Description: "Path segments are lower-case words separated by hyphens",
+		Severity:    SeverityWarn,
+		Check:       checkPathKebabCase,
+	},
+	{
+		ID:          "property-camel-case",
+		Description: "Schema property names are camelCase",
+		Severity:    SeverityWarn,
+		Check:       checkPropertyCamelCase,
+	},
+	{
+		ID:          "operation-summary",
+		Description: "Every operation has a summary",
+		Severity:    SeverityWarn,
+		Check:       checkOperationSummary,
+	},
+	{
+		ID:          "operation-tags",
+		Description: "Every operation has at least one tag",
+		Severity:    SeverityWarn,
+		Check:       checkOperationTags,
+	},
+	{
+		ID:          "no-unused-components",
+		Description: "Every reusable component is referenced somewhere",
+		Severity:    SeverityWarn,
+		Check:       checkUnusedComponents,
+	},
+	{
+		ID:           "error-response-schema",
+		Description:  "4xx and 5xx responses describe their body with a schema, the one named by the schemaRef option when it is set",
+		Severity:     SeverityWarn,
+		Check:        checkErrorResponseSchema,
+		CheckOptions: checkStringOptions("schemaRef"),
+	},
+	{
+		ID:           "versioned-base-path",
+		Description:  "Server URLs, or the basePath of Swagger 2.0, carry a version segment matching the pattern option",
+		Severity:     SeverityWarn,
+		Check:        checkVersionedBasePath,
+		CheckOptions: checkPatternOption,
+	},
+}
+
+// Rules returns the built-in rules
+func Rules() []Rule {
+	return append([]Rule(nil), builtinRules...)
+}
+
+func findRule(id string) (Rule, bool) {
+	for _, rule := range builtinRules {
+		if rule.ID == id {
+			return rule, true
+		}
+	}
+	return Rule{}, false
+}
+
+func checkPathKebabCase(doc *document, options map[string]interface{}, report func(path, message string)) {
+	for _, path := range doc.paths() {
+		for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+			// Template expressions name parameters, not resources
+			literal := templatePattern.ReplaceAllString(segment, "")
+			if literal == "" || kebabSegmentPattern.MatchString(strings.Trim(literal, "-.")) {
+				continue
+			}
+			report(resolver.JoinPointer("/paths", path),
+				fmt.Sprintf("path segment %q of %s is not kebab-case", segment, path))
+		}
+	}
+}
+
+func checkPropertyCamelCase(doc *document, options map[string]interface{}, report func(path, message string)) {
+	doc.schemaRoots(func(schema interface{}, pointer string) {
+		walkSchema(schema, pointer, func(object map[string]interface{}, pointer string) {
+			for _, name := range sortedKeys(mapValue(object["properties"])) {
+				if !camelCasePattern.MatchString(name) {
+					report(resolver.JoinPointer(pointer, "properties", name),
+						fmt.Sprintf("property %q is not camelCase", name))
+				}
+			}
+		})
+	})
+}
+
+func checkOperationSummary(doc *document, options map[string]interface{}, report func(path, message string)) {
+	for _, op := range doc.operations() {
+		if summary, _ := op.value["summary"].(string); strings.TrimSpace(summary) == "" {
+			report(op.pointer, fmt.Sprintf("%s %s has no summary", strings.ToUpper(op.method), op.path))
+		}
+	}
+}
+
+func checkOperationTags(doc *document, options map[string]interface{}, report func(path, message string)) {
+	for _, op := range doc.operations() {
+		if tags, _ := op.value["tags"].([]interface{}); len(tags) == 0 {
+			report(op.pointer, fmt.Sprintf("%s %s has no tags", strings.ToUpper(op.method), op.path))
+		}
+	}
+}
+
+func checkUnusedComponents(doc *document, options map[string]interface{}, report func(path, message string)) {
+	refs := doc.refs()
+	used := func(pointer string) bool {
+		ref := "#" + pointer
+		if refs[ref] {
+			return true
+		}
+		for candidate := range refs {
+			if strings.HasPrefix(candidate, ref+"/") {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, c := range doc.components() {
+		if !used(c.pointer) {
+			report(c.pointer, fmt.Sprintf("%s %s is never referenced", c.kind, c.name))
+		}
+	}
+
+	// Security schemes are used by name in security requirements
+	section := "/components/securitySchemes"
+	if doc.swagger2 {
+		section = "/securityDefinitions"
+	}
+	schemes, err := resolver.Get(doc.spec, section)
+	if err != nil {
+		return
+	}
+
+	required := make(map[string]bool)
+	addRequirements := func(value interface{}) {
+		list, _ := value.([]interface{})
+		for _, item := range list {
+			for name := range mapValue(item) {
+				required[name] = true
+			}
+		}
+	}
+	addRequirements(doc.spec["security"])
+	for _, op := range doc.operations() {
+		addRequirements(op.value["security"])
+	}
+
+	for _, name := range sortedKeys(mapValue(schemes)) {
+		if !required[name] {
+			report(resolver.JoinPointer(section, name), fmt.Sprintf("security scheme %s is never required", name))
+		}
+	}
+}
+
+func checkErrorResponseSchema(doc *document, options map[string]interface{}, report func(path, message string)) {
+	schemaRef, _ := options["schemaRef"].(string)
+
+	checkSchema := func(schema interface{}, pointer, code string) {
+		if schemaRef == "" {
+			return
+		}
+		if ref, _ := mapValue(schema)["$ref"].(string); ref != schemaRef {
+			report(pointer, fmt.Sprintf("%s response does not use the error schema %s", code, schemaRef))
+		}
+	}
+
+	for _, op := range doc.operations() {
+		responses := mapValue(op.value["responses"])
+		for _, code := range sortedKeys(responses) {
+			if !strings.HasPrefix(code, "4") && !strings.HasPrefix(code, "5") {
+				continue
+			}
+			pointer := resolver.JoinPointer(op.pointer, "responses", code)
+			response := mapValue(doc.resolve(responses[code]))
+
+			if doc.swagger2 {
+				schema, ok := response["schema"]
+				if !ok {
+					report(pointer, fmt.Sprintf("%s response of %s %s has no schema", code, strings.ToUpper(op.method), op.path))
+					continue
+				}
+				checkSchema(schema, resolver.JoinPointer(pointer, "schema"), code)
+				continue
+			}
+
+			content := mapValue(response["content"])
+			if len(content) == 0 {
+				report(pointer, fmt.Sprintf("%s response of %s %s has no content", code, strings.ToUpper(op.method), op.path))
+				continue
+			}
+			for _, mediaType := range sortedKeys(content) {
+				mediaPointer := resolver.JoinPointer(pointer, "content", mediaType)
+				schema, ok := mapValue(content[mediaType])["schema"]
+				if !ok {
+					report(mediaPointer, fmt.Sprintf("%s response content %s has no schema", code, mediaType))
+					continue
+				}
+				checkSchema(schema, resolver.JoinPointer(mediaPointer, "schema"), code)
+			}
+		}
+	}
+}
+
+func checkVersionedBasePath(doc *document, options map[string]interface{}, report func(path, message string)) {
+	pattern, _ := options["pattern"].(string)
+	if pattern == "" {
+		pattern = defaultVersionPattern
+	}
+	version, err := regexp.Compile(pattern)
+	if err != nil {
+		// Rejected when the ruleset is parsed
+		return
+	}
+
+	if doc.swagger2 {
+		basePath, _ := doc.spec["basePath"].(string)
+		if basePath == "" {
+			report("/", "no basePath is declared, so the API is not versioned")
+		} else if !version.MatchString(basePath) {
+			report("/basePath", fmt.Sprintf("basePath %s has no version segment", basePath))
+		}
+		return
+	}
+
+	servers, _ := doc.spec["servers"].([]interface{})
+	if len(servers) == 0 {
+		report("/", "no servers are declared, so the API is not versioned")
+		return
+	}
+	for i, server := range servers {
+		url, _ := mapValue(server)["url"].(string)
+		if !version.MatchString(urlPath(url)) {
+			report(fmt.Sprintf("/servers/%d/url", i), fmt.Sprintf("server URL %s has no version segment", url))
+		}
+	}
+}
+
+// urlPath returns the path part of an absolute or relative server URL
+func urlPath(url string) string {
+	if _, rest, ok := strings.Cut(url, "://"); ok {
+		if i := strings.Index(rest, "/"); i >= 0 {
+			return rest[i:]
+		}
+		return "/"
+	}
+	return url
+}
+
+func checkStringOptions(names ...string) func(options map[string]interface{}) error {
+	return func(options map[string]interface{}) error {
+		for _, name := range names {
+			if value, ok := options[name]; ok {
+				if _, isString := value.(string); !isString {
+					return fmt.Errorf("option %s must be a string", name)
+				}
+			}
+		}
+		return unknownOptions(options, names...)
+	}
+}
+
+func checkPatternOption(options map[string]interface{}) error {
+	if err := checkStringOptions("pattern")(options); err != nil {
+		return err
+	}
+	if pattern, ok := options["pattern"].(string); ok {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("option pattern is not a valid regular expression: %w", err)
+		}
+	}
+	return nil
+}
+
+func unknownOptions(options map[string]interface{}, known ...string) error {
+	var unknown []string
+	for name := range options {
+		found := false
+		for _, candidate := range known {
+			if name == candidate {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown options: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
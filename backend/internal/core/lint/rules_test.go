@@ -0,0 +1,192 @@
+package lint
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func decode(t *testing.T, content string) map[string]interface{} {
+	t.Helper()
+	var spec map[string]interface{}
+	if err := json.Unmarshal([]byte(content), &spec); err != nil {
+		t.Fatalf("bad test document: %v", err)
+	}
+	return spec
+}
+
+// only runs a single rule, with options
+func only(rule string, options map[string]interface{}) *Ruleset {
+	ruleset := DefaultRuleset()
+	for _, r := range builtinRules {
+		ruleset.Rules[r.ID] = RuleConfig{Severity: SeverityOff}
+	}
+	ruleset.Rules[rule] = RuleConfig{Severity: SeverityError, Options: options}
+	return ruleset
+}
+
+// TestRules runs each built-in rule alone over documents that break and
+// keep it, and lists the paths it reports
+func TestRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		options map[string]interface{}
+		spec    string
+		want    []string
+	}{
+		{
+			name: "kebab-case paths",
+			rule: "path-kebab-case",
+			spec: `{"openapi": "3.0.3", "paths": {
+				"/pet-stores/{storeId}/pets": {},
+				"/petStores": {},
+				"/pets/{id}.json": {},
+				"/pet_owners/{id}": {},
+				"x-note": {}
+			}}`,
+			want: []string{"/paths/~1petStores", "/paths/~1pet_owners~1{id}"},
+		},
+		{
+			name: "camelCase properties, nested and inline",
+			rule: "property-camel-case",
+			spec: `{"openapi": "3.0.3", "paths": {"/pets": {"get": {"responses": {"200": {"description": "Ok", "content": {
+				"application/json": {"schema": {"type": "array", "items": {"properties": {"pet_id": {}}}}}
+			}}}}}}, "components": {"schemas": {"Pet": {"properties": {
+				"name": {},
+				"Owner": {"properties": {"firstName": {}, "last-name": {}}},
+				"ref": {"$ref": "#/components/schemas/Other"}
+			}}}}}`,
+			want: []string{
+				"/components/schemas/Pet/properties/Owner",
+				"/components/schemas/Pet/properties/Owner/properties/last-name",
+				"/paths/~1pets/get/responses/200/content/application~1json/schema/items/properties/pet_id",
+			},
+		},
+		{
+			name: "operation summaries, webhooks included",
+			rule: "operation-summary",
+			spec: `{"openapi": "3.1.0", "paths": {"/pets": {
+				"get": {"summary": "List pets"},
+				"post": {"summary": "  "}
+			}}, "webhooks": {"newPet": {"post": {}}}}`,
+			want: []string{"/paths/~1pets/post", "/webhooks/newPet/post"},
+		},
+		{
+			name: "operation tags through a path item reference",
+			rule: "operation-tags",
+			spec: `{"openapi": "3.1.0", "paths": {
+				"/pets": {"$ref": "#/components/pathItems/Pets"},
+				"/owners": {"get": {"tags": ["owners"]}}
+			}, "components": {"pathItems": {"Pets": {"get": {"tags": []}}}}}`,
+			want: []string{"/paths/~1pets/get"},
+		},
+		{
+			name: "unused components and security schemes",
+			rule: "no-unused-components",
+			spec: `{"openapi": "3.0.3", "security": [{"key": []}], "paths": {"/pets": {"get": {
+				"security": [{"oauth": ["read"]}],
+				"parameters": [{"$ref": "#/components/parameters/limit"}],
+				"responses": {"200": {"description": "Ok", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Pet/properties/name"}}}}}
+			}}}, "components": {
+				"schemas": {"Pet": {"properties": {"name": {}}}, "Unused": {}},
+				"parameters": {"limit": {"name": "limit", "in": "query"}, "offset": {"name": "offset", "in": "query"}},
+				"securitySchemes": {"key": {}, "oauth": {}, "basic": {}}
+			}}`,
+			want: []string{"/components/schemas/Unused", "/components/parameters/offset", "/components/securitySchemes/basic"},
+		},
+		{
+			name: "unused Swagger 2.0 definitions",
+			rule: "no-unused-components",
+			spec: `{"swagger": "2.0", "paths": {}, "definitions": {"Pet": {}}, "securityDefinitions": {"key": {}}}`,
+			want: []string{"/definitions/Pet", "/securityDefinitions/key"},
+		},
+		{
+			name: "error responses need a schema",
+			rule: "error-response-schema",
+			spec: `{"openapi": "3.0.3", "paths": {"/pets": {"get": {"responses": {
+				"200": {"description": "Ok"},
+				"404": {"description": "Not found"},
+				"4XX": {"description": "Client error", "content": {"text/plain": {}}},
+				"500": {"$ref": "#/components/responses/Error"}
+			}}}}, "components": {"responses": {"Error": {"description": "Error", "content": {"application/json": {"schema": {}}}}}}}`,
+			want: []string{"/paths/~1pets/get/responses/404", "/paths/~1pets/get/responses/4XX/content/text~1plain"},
+		},
+		{
+			name:    "error responses use the named schema",
+			rule:    "error-response-schema",
+			options: map[string]interface{}{"schemaRef": "#/definitions/Error"},
+			spec: `{"swagger": "2.0", "paths": {"/pets": {"get": {"responses": {
+				"400": {"description": "Bad", "schema": {"$ref": "#/definitions/Error"}},
+				"404": {"description": "Not found", "schema": {"type": "string"}},
+				"500": {"description": "Error"}
+			}}}}}`,
+			want: []string{"/paths/~1pets/get/responses/404/schema", "/paths/~1pets/get/responses/500"},
+		},
+		{
+			name: "versioned server URLs",
+			rule: "versioned-base-path",
+			spec: `{"openapi": "3.0.3", "paths": {}, "servers": [
+				{"url": "https://api.example.com/v1"},
+				{"url": "https://api.example.com"},
+				{"url": "/v2.1/pets"},
+				{"url": "https://api.example.com/v1beta"}
+			]}`,
+			want: []string{"/servers/1/url", "/servers/3/url"},
+		},
+		{
+			name: "versioned base path without servers",
+			rule: "versioned-base-path",
+			spec: `{"openapi": "3.0.3", "paths": {}}`,
+			want: []string{"/"},
+		},
+		{
+			name:    "versioned Swagger 2.0 base path with a custom pattern",
+			rule:    "versioned-base-path",
+			options: map[string]interface{}{"pattern": "^/api/[0-9]+$"},
+			spec:    `{"swagger": "2.0", "paths": {}, "basePath": "/v1"}`,
+			want:    []string{"/basePath"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, finding := range only(tt.rule, tt.options).Lint(decode(t, tt.spec)) {
+				if finding.Rule != tt.rule || finding.Severity != SeverityError {
+					t.Errorf("finding %+v, want rule %s at severity error", finding, tt.rule)
+				}
+				got = append(got, finding.Path)
+			}
+			if strings.Join(got, ", ") != strings.Join(tt.want, ", ") {
+				t.Errorf("findings at %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestLintSeverities runs the default ruleset with one rule switched off and
+// one raised, and finds the others at their default severity
+func TestLintSeverities(t *testing.T) {
+	ruleset := DefaultRuleset().Override(&Ruleset{Rules: map[string]RuleConfig{
+		"operation-tags":    {Severity: SeverityOff},
+		"operation-summary": {Severity: SeverityError},
+	}})
+	spec := decode(t, `{"openapi": "3.0.3", "servers": [{"url": "/v1"}], "paths": {"/pets": {"get": {"responses": {}}}}}`)
+
+	got := map[string]Severity{}
+	for _, finding := range ruleset.Lint(spec) {
+		got[finding.Rule] = finding.Severity
+	}
+	if len(got) != 1 || got["operation-summary"] != SeverityError {
+		t.Errorf("findings by rule = %v, want only operation-summary at error", got)
+	}
+
+	got = map[string]Severity{}
+	for _, finding := range DefaultRuleset().Lint(spec) {
+		got[finding.Rule] = finding.Severity
+	}
+	if got["operation-tags"] != SeverityWarn || got["operation-summary"] != SeverityWarn {
+		t.Errorf("findings by rule = %v, want tags and summary at warn", got)
+	}
+}
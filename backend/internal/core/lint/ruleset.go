@@ -0,0 +1,197 @@
+// Package lint checks OpenAPI and Swagger documents against style rules, such
+// as naming conventions and required documentation, that go beyond what the
+// specification itself demands. Rules are switched on and off, and given a
+// severity, by a ruleset that can be loaded from YAML.
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Severity says how much a finding matters; SeverityOff disables a rule
+type Severity string
+
+const (
+	SeverityError Severity = "error"
+	SeverityWarn  Severity = "warn"
+	SeverityInfo  Severity = "info"
+	SeverityOff   Severity = "off"
+)
+
+// ErrUnknownRule is returned for ruleset entries naming no built-in rule
+var ErrUnknownRule = errors.New("unknown lint rule")
+
+func parseSeverity(value string) (Severity, error) {
+	switch Severity(value) {
+	case SeverityError, SeverityWarn, SeverityInfo, SeverityOff:
+		return Severity(value), nil
+	case "warning":
+		return SeverityWarn, nil
+	default:
+		return "", fmt.Errorf("invalid severity %q: use error, warn, info or off", value)
+	}
+}
+
+// Finding is one violation of a rule
+type Finding struct {
+	Rule     string
+	Severity Severity
+	Path     string
+	Message  string
+}
+
+// RuleConfig sets the severity and options of one rule. In YAML it is either
+// a bare severity or a mapping with severity and options.
+type RuleConfig struct {
+	Severity Severity               `yaml:"severity"`
+	Options  map[string]interface{} `yaml:"options"`
+}
+
+// UnmarshalYAML accepts both the short and the long form of a rule entry
+func (c *RuleConfig) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		severity, err := parseSeverity(value.Value)
+		if err != nil {
+			return err
+		}
+		c.Severity = severity
+		return nil
+	}
+
+	var raw struct {
+		Severity string                 `yaml:"severity"`
+		Options  map[string]interface{} `yaml:"options"`
+	}
+	if err := value.Decode(&raw); err != nil {
+		return err
+	}
+
+	if raw.Severity != "" {
+		severity, err := parseSeverity(raw.Severity)
+		if err != nil {
+			return err
+		}
+		c.Severity = severity
+	}
+	c.Options = raw.Options
+	return nil
+}
+
+// Ruleset selects the rules to run. Rules it does not mention run with their
+// default severity and options.
+type Ruleset struct {
+	Rules map[string]RuleConfig `yaml:"rules"`
+}
+
+// DefaultRuleset runs every built-in rule with its default severity
+func DefaultRuleset() *Ruleset {
+	return &Ruleset{Rules: map[string]RuleConfig{}}
+}
+
+// ParseRuleset reads a ruleset from YAML such as:
+//
+//	rules:
+//	  operation-tags: off
+//	  error-response-schema:
+//	    severity: error
+//	    options:
+//	      schemaRef: "#/components/schemas/Error"
+func ParseRuleset(data []byte) (*Ruleset, error) {
+	ruleset := DefaultRuleset()
+	if err := yaml.Unmarshal(data, ruleset); err != nil {
+		return nil, fmt.Errorf("invalid ruleset: %w", err)
+	}
+	if ruleset.Rules == nil {
+		ruleset.Rules = map[string]RuleConfig{}
+	}
+
+	for id, config := range ruleset.Rules {
+		rule, ok := findRule(id)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownRule, id)
+		}
+		if rule.CheckOptions != nil {
+			if err := rule.CheckOptions(config.Options); err != nil {
+				return nil, fmt.Errorf("invalid ruleset: %s: %w", id, err)
+			}
+		} else if len(config.Options) > 0 {
+			return nil, fmt.Errorf("invalid ruleset: %s takes no options", id)
+		}
+	}
+	return ruleset, nil
+}
+
+// LoadRuleset reads a ruleset from a YAML file
+func LoadRuleset(path string) (*Ruleset, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ruleset: %w", err)
+	}
+	return ParseRuleset(data)
+}
+
+// Override returns a ruleset with the entries of other applied over those
+// of r. An entry only replaces the severity it sets and the options it
+// names, so a bare severity keeps the options r gives the rule and options
+// alone keep its severity.
+func (r *Ruleset) Override(other *Ruleset) *Ruleset {
+	merged := DefaultRuleset()
+	for id, config := range r.Rules {
+		merged.Rules[id] = config
+	}
+	if other == nil {
+		return merged
+	}
+
+	for id, config := range other.Rules {
+		base := merged.Rules[id]
+		if config.Severity != "" {
+			base.Severity = config.Severity
+		}
+		if len(config.Options) > 0 {
+			options := make(map[string]interface{}, len(base.Options)+len(config.Options))
+			for name, value := range base.Options {
+				options[name] = value
+			}
+			for name, value := range config.Options {
+				options[name] = value
+			}
+			base.Options = options
+		}
+		merged.Rules[id] = base
+	}
+	return merged
+}
+
+// config returns the effective configuration of a rule
+func (r *Ruleset) config(rule Rule) RuleConfig {
+	config := r.Rules[rule.ID]
+	if config.Severity == "" {
+		config.Severity = rule.Severity
+	}
+	return config
+}
+
+// Lint runs the enabled rules over a decoded document and returns their
+// findings, ordered by rule and then by where they occur in the document
+func (r *Ruleset) Lint(spec map[string]interface{}) []Finding {
+	doc := newDocument(spec)
+
+	var findings []Finding
+	for _, rule := range builtinRules {
+		config := r.config(rule)
+		if config.Severity == SeverityOff {
+			continue
+		}
+
+		rule.Check(doc, config.Options, func(path, message string) {
+			findings = append(findings, Finding{Rule: rule.ID, Severity: config.Severity, Path: path, Message: message})
+		})
+	}
+
+	return findings
+}
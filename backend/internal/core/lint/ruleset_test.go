@@ -0,0 +1,133 @@
+package lint
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestParseRuleset reads both forms of rule entries and rejects entries the
+// rules cannot use
+func TestParseRuleset(t *testing.T) {
+	ruleset, err := ParseRuleset([]byte(`
+rules:
+  operation-tags: off
+  operation-summary: warning
+  error-response-schema:
+    severity: error
+    options:
+      schemaRef: "#/components/schemas/Error"
+  versioned-base-path:
+    options:
+      pattern: "^/api/v[0-9]+"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]RuleConfig{
+		"operation-tags":        {Severity: SeverityOff},
+		"operation-summary":     {Severity: SeverityWarn},
+		"error-response-schema": {Severity: SeverityError, Options: map[string]interface{}{"schemaRef": "#/components/schemas/Error"}},
+		"versioned-base-path":   {Options: map[string]interface{}{"pattern": "^/api/v[0-9]+"}},
+	}
+	if !reflect.DeepEqual(ruleset.Rules, want) {
+		t.Errorf("rules = %v, want %v", ruleset.Rules, want)
+	}
+
+	tests := []struct {
+		name    string
+		ruleset string
+		want    string
+	}{
+		{"unknown rule", "rules:\n  no-such-rule: error\n", "unknown lint rule"},
+		{"bad severity", "rules:\n  operation-tags: loud\n", "invalid severity"},
+		{"options for a rule without any", "rules:\n  operation-tags:\n    options:\n      strict: true\n", "takes no options"},
+		{"unknown option", "rules:\n  error-response-schema:\n    options:\n      schema: Error\n", "unknown options: schema"},
+		{"option of the wrong type", "rules:\n  error-response-schema:\n    options:\n      schemaRef: 3\n", "must be a string"},
+		{"bad pattern", "rules:\n  versioned-base-path:\n    options:\n      pattern: \"(\"\n", "not a valid regular expression"},
+		{"not YAML", "rules: [", "invalid ruleset"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseRuleset([]byte(tt.ruleset))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+
+	if _, err := ParseRuleset([]byte("rules:\n  no-such-rule: error\n")); !errors.Is(err, ErrUnknownRule) {
+		t.Errorf("error = %v, want ErrUnknownRule", err)
+	}
+	if ruleset, err := ParseRuleset(nil); err != nil || ruleset.Rules == nil {
+		t.Errorf("empty ruleset = %v, %v, want no rules configured", ruleset, err)
+	}
+}
+
+// TestRulesetOverride applies request rulesets over a configured one and
+// keeps whatever an entry does not set
+func TestRulesetOverride(t *testing.T) {
+	base := &Ruleset{Rules: map[string]RuleConfig{
+		"error-response-schema": {Severity: SeverityError, Options: map[string]interface{}{"schemaRef": "#/components/schemas/Error"}},
+		"versioned-base-path":   {Severity: SeverityInfo, Options: map[string]interface{}{"pattern": "^/v[0-9]+"}},
+		"operation-tags":        {Severity: SeverityOff},
+	}}
+
+	tests := []struct {
+		name     string
+		override map[string]RuleConfig
+		rule     string
+		want     RuleConfig
+	}{
+		{
+			name:     "severity alone keeps the options",
+			override: map[string]RuleConfig{"error-response-schema": {Severity: SeverityWarn}},
+			rule:     "error-response-schema",
+			want:     RuleConfig{Severity: SeverityWarn, Options: map[string]interface{}{"schemaRef": "#/components/schemas/Error"}},
+		},
+		{
+			name:     "options alone keep the severity",
+			override: map[string]RuleConfig{"versioned-base-path": {Options: map[string]interface{}{"pattern": "^/api/v[0-9]+"}}},
+			rule:     "versioned-base-path",
+			want:     RuleConfig{Severity: SeverityInfo, Options: map[string]interface{}{"pattern": "^/api/v[0-9]+"}},
+		},
+		{
+			name:     "both replaced",
+			override: map[string]RuleConfig{"operation-tags": {Severity: SeverityError}},
+			rule:     "operation-tags",
+			want:     RuleConfig{Severity: SeverityError},
+		},
+		{
+			name:     "rule the base does not mention",
+			override: map[string]RuleConfig{"operation-summary": {Severity: SeverityOff}},
+			rule:     "operation-summary",
+			want:     RuleConfig{Severity: SeverityOff},
+		},
+		{
+			name:     "untouched rule",
+			override: map[string]RuleConfig{"operation-summary": {Severity: SeverityOff}},
+			rule:     "error-response-schema",
+			want:     RuleConfig{Severity: SeverityError, Options: map[string]interface{}{"schemaRef": "#/components/schemas/Error"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged := base.Override(&Ruleset{Rules: tt.override})
+			if got := merged.Rules[tt.rule]; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s = %+v, want %+v", tt.rule, got, tt.want)
+			}
+		})
+	}
+
+	// Overriding leaves the base ruleset as it was
+	base.Override(&Ruleset{Rules: map[string]RuleConfig{
+		"versioned-base-path": {Options: map[string]interface{}{"pattern": "^/api"}},
+	}})
+	if pattern := base.Rules["versioned-base-path"].Options["pattern"]; pattern != "^/v[0-9]+" {
+		t.Errorf("base pattern changed to %v", pattern)
+	}
+	if merged := base.Override(nil); !reflect.DeepEqual(merged.Rules, base.Rules) {
+		t.Errorf("override with nothing = %v, want %v", merged.Rules, base.Rules)
+	}
+}
@@ -0,0 +1,165 @@
+// Package metaschema checks Swagger 2.0, OpenAPI 3.0 and OpenAPI 3.1
+// documents against the JSON Schemas that describe their structure.
+//
+// The Swagger 2.0 schema and the draft-04 meta-schema it builds on are the
+// published files. The OpenAPI 3.0 and 3.1 schemas follow the published ones
+// object by object, written against the keywords our jsonschema package
+// supports. Schema objects in 3.1 documents belong to the JSON Schema dialect
+// they declare and are only checked to be objects or booleans.
+package metaschema
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/jsonschema"
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+//go:embed schemas/*.json
+var schemaFiles embed.FS
+
+// The ids of the document schemas, as declared in the embedded files
+const (
+	Swagger20 = "http://swagger.io/v2/schema.json"
+	OpenAPI30 = "https://spec.openapis.org/oas/3.0/schema/2021-09-28"
+	OpenAPI31 = "https://spec.openapis.org/oas/3.1/schema/2022-10-07"
+)
+
+// ErrUnsupportedVersion is returned for documents no schema describes
+var ErrUnsupportedVersion = errors.New("no schema for this specification version")
+
+var (
+	loadOnce sync.Once
+	loaded   *registry
+	loadErr  error
+)
+
+// SchemaFor returns the id of the schema describing documents of version
+func SchemaFor(version string) (string, error) {
+	switch {
+	case version == "2.0":
+		return Swagger20, nil
+	case strings.HasPrefix(version, "3.0."):
+		return OpenAPI30, nil
+	case strings.HasPrefix(version, "3.1."):
+		return OpenAPI31, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedVersion, version)
+	}
+}
+
+// Validate checks a decoded document against the schema for its declared
+// version. Error paths are JSON Pointers into the document.
+func Validate(document interface{}, version string) ([]domain.ValidationError, error) {
+	id, err := SchemaFor(version)
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	// YAML decodes integers and timestamps to Go types JSON Schema does not know
+	data, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode document: %w", err)
+	}
+	var instance interface{}
+	if err := json.Unmarshal(data, &instance); err != nil {
+		return nil, fmt.Errorf("failed to decode document: %w", err)
+	}
+
+	return jsonschema.New(registry).Validate(registry.documents[id], instance), nil
+}
+
+// registry holds the embedded schemas keyed by id. Every $ref in them is made
+// absolute on load, so a reference keeps pointing into the file it came from
+// after the validator follows it into another file.
+type registry struct {
+	documents map[string]interface{}
+}
+
+func load() (*registry, error) {
+	loadOnce.Do(func() {
+		loaded, loadErr = loadRegistry()
+	})
+	return loaded, loadErr
+}
+
+func loadRegistry() (*registry, error) {
+	entries, err := schemaFiles.ReadDir("schemas")
+	if err != nil {
+		return nil, err
+	}
+
+	r := &registry{documents: make(map[string]interface{}, len(entries))}
+	for _, entry := range entries {
+		data, err := schemaFiles.ReadFile("schemas/" + entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		var document map[string]interface{}
+		if err := json.Unmarshal(data, &document); err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+
+		id, _ := document["$id"].(string)
+		if id == "" {
+			id, _ = document["id"].(string)
+		}
+		id = strings.TrimSuffix(id, "#")
+		if id == "" {
+			return nil, fmt.Errorf("%s: schema has no id", entry.Name())
+		}
+
+		absoluteRefs(document, id)
+		r.documents[id] = document
+	}
+
+	return r, nil
+}
+
+// Lookup resolves an absolute reference such as id#/definitions/info
+func (r *registry) Lookup(ref string) (interface{}, error) {
+	id, pointer, err := resolver.SplitRef(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	document, ok := r.documents[id]
+	if !ok {
+		return nil, fmt.Errorf("cannot resolve %s: unknown schema %s", ref, id)
+	}
+
+	target, err := resolver.Get(document, pointer)
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve %s: %w", ref, err)
+	}
+	return target, nil
+}
+
+// absoluteRefs prefixes the document-local references in value with id
+func absoluteRefs(value interface{}, id string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if ref, ok := v["$ref"].(string); ok && strings.HasPrefix(ref, "#") {
+			v["$ref"] = id + ref
+		}
+		for _, item := range v {
+			absoluteRefs(item, id)
+		}
+	case []interface{}:
+		for _, item := range v {
+			absoluteRefs(item, id)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+// Package mock answers requests on behalf of an API definition. Requests are
+// routed by the endpoints' path templates and checked against the linked
+// parameter and request body schemas; responses are built from the declared
+// examples, or from examples synthesized from the response schemas.
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/example"
+	"github.com/swagger-editor/backend/internal/core/jsonschema"
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// Mock serves one API definition
+type Mock struct {
+	api        *domain.APIDefinition
+	routes     []route
+	basePaths  []string
+	refs       *resolver.SchemaResolver
+	validator  *jsonschema.Validator
+	generator  *example.Generator
+	parameters map[string]domain.Parameter
+	bodies     map[string]domain.RequestBody
+	responses  map[string]domain.Response
+}
+
+// New creates a mock of an API definition
+func New(api *domain.APIDefinition) *Mock {
+	refs := resolver.NewSchemaResolver(api)
+	m := &Mock{
+		api:        api,
+		routes:     newRoutes(api.Endpoints),
+		basePaths:  basePaths(api.Metadata.Servers),
+		refs:       refs,
+		validator:  jsonschema.New(refs),
+		generator:  example.New(refs, 0),
+		parameters: make(map[string]domain.Parameter, len(api.Parameters)),
+		bodies:     make(map[string]domain.RequestBody, len(api.RequestBodies)),
+		responses:  make(map[string]domain.Response, len(api.Responses)),
+	}
+	for _, param := range api.Parameters {
+		m.parameters[param.ID] = param
+	}
+	for _, body := range api.RequestBodies {
+		m.bodies[body.ID] = body
+	}
+	for _, response := range api.Responses {
+		m.responses[response.ID] = response
+	}
+	return m
+}
+
+// Serve answers a request. Requests that match no endpoint get 404 or 405;
+// requests whose parameters or body break the definition get 400, or 415
+// for an undeclared media type, with the problems listed in the body.
+func (m *Mock) Serve(request *domain.MockRequest) *domain.MockResponse {
+	endpoint, allowed, errs, unsupported := m.check(request)
+	if endpoint == nil {
+		if len(allowed) > 0 {
+			response := errorResponse(http.StatusMethodNotAllowed,
+				fmt.Sprintf("method %s is not declared for %s", request.Method, request.Path), nil)
+			response.Headers["Allow"] = strings.Join(allowed, ", ")
+			return response
+		}
+		return errorResponse(http.StatusNotFound, fmt.Sprintf("no endpoint matches %s", request.Path), nil)
+	}
+	if unsupported != "" {
+		return errorResponse(http.StatusUnsupportedMediaType, unsupported, nil)
+	}
+	if len(errs) > 0 {
+		return errorResponse(http.StatusBadRequest, "request does not match the definition", errs)
+	}
+
+	return m.respond(endpoint, request)
+}
+
+// Check matches a request to an endpoint and checks it against the
+// definition, as Serve does before answering. The endpoint is nil when none
+// matches; allowed then lists the methods declared for the path, if any. An
+// undeclared media type is reported at /body with the keyword mediaType.
+func (m *Mock) Check(request *domain.MockRequest) (*domain.Endpoint, []string, []domain.ValidationError) {
+	endpoint, allowed, errs, unsupported := m.check(request)
+	if unsupported != "" {
+		errs = append(errs, domain.ValidationError{Path: "/body", Message: unsupported, Keyword: "mediaType"})
+	}
+	return endpoint, allowed, errs
+}
+
+func (m *Mock) check(request *domain.MockRequest) (*domain.Endpoint, []string, []domain.ValidationError, string) {
+	matched, params, allowed := m.match(request.Method, request.Path)
+	if matched == nil {
+		return nil, allowed, nil, ""
+	}
+
+	errs := m.checkParameters(matched.endpoint, request, params)
+	bodyErrs, unsupported := m.checkBody(matched.endpoint, request)
+	return matched.endpoint, nil, append(errs, bodyErrs...), unsupported
+}
+
+// match finds the endpoint for a request, also trying the path with the
+// base path of a server removed. When the path matches only under other
+// methods, those are returned instead.
+func (m *Mock) match(method, path string) (*route, map[string]string, []string) {
+	matched, params, allowed := matchRoute(m.routes, method, path)
+	if matched != nil || len(allowed) > 0 {
+		return matched, params, allowed
+	}
+
+	for _, base := range m.basePaths {
+		if rest, ok := strings.CutPrefix(path, base); ok && (rest == "" || rest[0] == '/') {
+			if rest == "" {
+				rest = "/"
+			}
+			if matched, params, allowed := matchRoute(m.routes, method, rest); matched != nil || len(allowed) > 0 {
+				return matched, params, allowed
+			}
+		}
+	}
+	return nil, nil, nil
+}
+
+// errorResponse reports a problem with a request in the shape the REST API
+// uses for errors
+func errorResponse(status int, message string, errs []domain.ValidationError) *domain.MockResponse {
+	body, _ := json.Marshal(struct {
+		Error  string                   `json:"error"`
+		Errors []domain.ValidationError `json:"errors,omitempty"`
+	}{message, errs})
+
+	return &domain.MockResponse{
+		Status:  status,
+		Headers: map[string]string{"Content-Type": "application/json"},
+		Body:    body,
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
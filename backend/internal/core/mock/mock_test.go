@@ -0,0 +1,201 @@
+package mock
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// petsAPI returns a definition with a mix of declared examples, synthesized
+// ones and parameters and bodies to check
+func petsAPI() *domain.APIDefinition {
+	maxLimit := 100.0
+	return &domain.APIDefinition{
+		Metadata: domain.APIMetadata{Servers: []domain.Server{{URL: "https://api.example.com/v1"}}},
+		Endpoints: []domain.Endpoint{
+			{
+				ID: "endpoint-listpets", Method: "GET", Path: "/pets",
+				Parameters: []string{"param-limit", "param-tags"},
+				Responses:  map[string]string{"200": "response-pets", "4XX": "response-error"},
+			},
+			{
+				ID: "endpoint-createpet", Method: "POST", Path: "/pets",
+				RequestBody: "body-pet",
+				Responses:   map[string]string{"201": "response-pet"},
+			},
+			{
+				ID: "endpoint-getpet", Method: "GET", Path: "/pets/{id}",
+				Parameters: []string{"param-id"},
+				Responses:  map[string]string{"200": "response-pet", "default": "response-error"},
+			},
+			{
+				ID: "endpoint-mypet", Method: "GET", Path: "/pets/mine",
+				Responses: map[string]string{"200": "response-mine"},
+			},
+		},
+		Parameters: []domain.Parameter{
+			{ID: "param-limit", Name: "limit", In: "query", Schema: map[string]interface{}{"type": "integer", "maximum": maxLimit}},
+			{ID: "param-tags", Name: "tags", In: "query", Schema: map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}}},
+			{ID: "param-id", Name: "id", In: "path", Required: true, Schema: map[string]interface{}{"type": "integer"}},
+		},
+		RequestBodies: []domain.RequestBody{
+			{ID: "body-pet", Required: true, Content: map[string]domain.MediaType{"application/json": {Schema: "schema-pet"}}},
+		},
+		Responses: []domain.Response{
+			{ID: "response-pets", Description: "The pets",
+				Headers: map[string]interface{}{"X-Total": map[string]interface{}{"schema": map[string]interface{}{"type": "integer"}, "example": 2}},
+				Content: map[string]domain.MediaType{
+					"application/json": {Examples: map[string]interface{}{
+						"cats": map[string]interface{}{"value": []interface{}{"Tom"}},
+						"dogs": map[string]interface{}{"value": []interface{}{"Rex", "Fido"}},
+					}},
+					"text/csv": {Example: "name\nRex"},
+				}},
+			{ID: "response-pet", Description: "The pet", Content: map[string]domain.MediaType{
+				"application/json": {Schema: "schema-pet"},
+			}},
+			{ID: "response-mine", Description: "My pet", Content: map[string]domain.MediaType{
+				"application/json": {Example: map[string]interface{}{"name": "Mine"}},
+			}},
+			{ID: "response-error", Description: "Error", Content: map[string]domain.MediaType{
+				"application/problem+json": {Example: map[string]interface{}{"title": "Not found"}},
+			}},
+		},
+		Schemas: []domain.Schema{
+			{ID: "schema-pet", Name: "Pet", Type: "object", Required: []string{"name"}, Properties: map[string]interface{}{
+				"name": map[string]interface{}{"type": "string", "minLength": 1},
+			}},
+		},
+	}
+}
+
+// TestServe sends requests to a mock and checks the status, headers and
+// body it answers with
+func TestServe(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		target   string
+		headers  map[string]string
+		body     string
+		status   int
+		want     map[string]string
+		contains string
+	}{
+		{name: "first declared example", method: "GET", target: "/pets", status: 200,
+			want: map[string]string{"Content-Type": "application/json", "X-Total": "2"}, contains: `["Tom"]`},
+		{name: "named example", method: "GET", target: "/pets", headers: map[string]string{"Prefer": "example=dogs"}, status: 200,
+			contains: `["Rex","Fido"]`},
+		{name: "accepted media type", method: "GET", target: "/pets", headers: map[string]string{"Accept": "text/csv"}, status: 200,
+			want: map[string]string{"Content-Type": "text/csv"}, contains: "name\nRex"},
+		{name: "nothing acceptable", method: "GET", target: "/pets", headers: map[string]string{"Accept": "application/xml"}, status: 406},
+		{name: "preferred code in a range", method: "GET", target: "/pets", headers: map[string]string{"Prefer": "code=404"}, status: 404,
+			want: map[string]string{"Content-Type": "application/problem+json"}, contains: `{"title":"Not found"}`},
+		{name: "preferred code not declared", method: "POST", target: "/pets", headers: map[string]string{"Prefer": "code=404"}, body: `{"name": "Rex"}`, status: 400},
+		{name: "under the server's base path", method: "GET", target: "/v1/pets/mine", status: 200, contains: `{"name":"Mine"}`},
+		{name: "literal path wins over a template", method: "GET", target: "/pets/mine", status: 200, contains: `{"name":"Mine"}`},
+		{name: "synthesized from the schema", method: "GET", target: "/pets/7", status: 200},
+		{name: "unknown path", method: "GET", target: "/owners", status: 404},
+		{name: "undeclared method", method: "DELETE", target: "/pets", status: 405, want: map[string]string{"Allow": "GET, POST"}},
+		{name: "query parameter of the wrong type", method: "GET", target: "/pets?limit=many", status: 400, contains: "/parameters/query/limit"},
+		{name: "query parameter out of range", method: "GET", target: "/pets?limit=500", status: 400, contains: "maximum"},
+		{name: "array query parameter", method: "GET", target: "/pets?tags=a&tags=b", status: 200},
+		{name: "path parameter of the wrong type", method: "GET", target: "/pets/seven", status: 400, contains: "/parameters/path/id"},
+		{name: "body created", method: "POST", target: "/pets", body: `{"name": "Rex"}`, status: 201},
+		{name: "body breaks the schema", method: "POST", target: "/pets", body: `{"name": ""}`, status: 400, contains: "/body/name"},
+		{name: "body is not JSON", method: "POST", target: "/pets", body: `{"name":`, status: 400, contains: "not valid JSON"},
+		{name: "body missing", method: "POST", target: "/pets", status: 400, contains: "request body is required"},
+		{name: "undeclared media type", method: "POST", target: "/pets", headers: map[string]string{"Content-Type": "text/plain"}, body: "Rex", status: 415},
+	}
+
+	m := New(petsAPI())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, rawQuery, _ := strings.Cut(tt.target, "?")
+			query := map[string][]string{}
+			for _, pair := range strings.Split(rawQuery, "&") {
+				if key, value, ok := strings.Cut(pair, "="); ok {
+					query[key] = append(query[key], value)
+				}
+			}
+			headers := map[string][]string{}
+			for name, value := range tt.headers {
+				headers[name] = []string{value}
+			}
+
+			response := m.Serve(&domain.MockRequest{Method: tt.method, Path: path, Query: query, Headers: headers, Body: []byte(tt.body)})
+			if response.Status != tt.status {
+				t.Errorf("status = %d, want %d: %s", response.Status, tt.status, response.Body)
+			}
+			for name, want := range tt.want {
+				if got := response.Headers[name]; got != want {
+					t.Errorf("%s = %q, want %q", name, got, want)
+				}
+			}
+			if !strings.Contains(string(response.Body), tt.contains) {
+				t.Errorf("body = %s, want it to contain %s", response.Body, tt.contains)
+			}
+		})
+	}
+}
+
+// TestServeSynthesizesValidExamples answers with an example that matches
+// the response schema when none is declared
+func TestServeSynthesizesValidExamples(t *testing.T) {
+	m := New(petsAPI())
+	response := m.Serve(&domain.MockRequest{Method: "GET", Path: "/pets/7"})
+
+	var pet interface{}
+	if err := json.Unmarshal(response.Body, &pet); err != nil {
+		t.Fatalf("body %s: %v", response.Body, err)
+	}
+	if errs := m.validator.Validate("schema-pet", pet); len(errs) != 0 {
+		t.Errorf("synthesized %s breaks the schema: %v", response.Body, errs)
+	}
+}
+
+// TestCheck reports an undeclared media type as an error rather than a
+// separate status
+func TestCheck(t *testing.T) {
+	m := New(petsAPI())
+
+	endpoint, _, errs := m.Check(&domain.MockRequest{
+		Method: "POST", Path: "/pets",
+		Headers: map[string][]string{"Content-Type": {"text/plain"}},
+		Body:    []byte("Rex"),
+	})
+	if endpoint == nil || endpoint.ID != "endpoint-createpet" {
+		t.Fatalf("endpoint = %v, want createpet", endpoint)
+	}
+	if len(errs) != 1 || errs[0].Path != "/body" || errs[0].Keyword != "mediaType" {
+		t.Errorf("errors = %v, want one mediaType error at /body", errs)
+	}
+
+	endpoint, allowed, _ := m.Check(&domain.MockRequest{Method: "PUT", Path: "/pets/7"})
+	if endpoint != nil || strings.Join(allowed, ",") != "GET" {
+		t.Errorf("endpoint = %v, allowed = %v, want no endpoint and GET allowed", endpoint, allowed)
+	}
+}
+
+// TestSplitValue splits array parameters by the delimiter of their style
+func TestSplitValue(t *testing.T) {
+	no := false
+	tests := []struct {
+		param domain.Parameter
+		value string
+		want  string
+	}{
+		{domain.Parameter{In: "query"}, "a,b", "a,b"},
+		{domain.Parameter{In: "query", Explode: &no}, "a,b", "a|b"},
+		{domain.Parameter{In: "path"}, "a,b", "a|b"},
+		{domain.Parameter{In: "query", Style: "pipeDelimited"}, "a|b", "a|b"},
+		{domain.Parameter{In: "query", Style: "spaceDelimited"}, "a b", "a|b"},
+	}
+	for _, tt := range tests {
+		if got := strings.Join(splitValue(tt.param, tt.value), "|"); got != tt.want {
+			t.Errorf("splitValue(%+v, %q) = %q, want %q", tt.param, tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// checkParameters checks that required parameters are present and that the
+// values given match their schemas. Errors are reported at
+// /parameters/{in}/{name}.
+func (m *Mock) checkParameters(endpoint *domain.Endpoint, request *domain.MockRequest, params map[string]string) []domain.ValidationError {
+	var cookies []*http.Cookie
+	if header := request.Headers["Cookie"]; len(header) > 0 {
+		cookies, _ = http.ParseCookie(strings.Join(header, "; "))
+	}
+
+	var errs []domain.ValidationError
+	for _, id := range endpoint.Parameters {
+		param, ok := m.parameters[id]
+		if !ok {
+			continue
+		}
+		pointer := resolver.JoinPointer("/parameters", param.In, param.Name)
+
+		var values []string
+		switch param.In {
+		case "path":
+			if value, ok := params[param.Name]; ok {
+				values = []string{value}
+			}
+		case "query":
+			values = request.Query[param.Name]
+		case "header":
+			values = request.Headers[http.CanonicalHeaderKey(param.Name)]
+		case "cookie":
+			for _, cookie := range cookies {
+				if cookie.Name == param.Name {
+					values = append(values, cookie.Value)
+				}
+			}
+		}
+
+		if len(values) == 0 {
+			if param.Required || param.In == "path" {
+				errs = append(errs, domain.ValidationError{
+					Path:    pointer,
+					Message: fmt.Sprintf("missing required %s parameter %s", param.In, param.Name),
+					Keyword: "required",
+				})
+			}
+			continue
+		}
+
+		schema, value, err := m.parameterValue(param, values)
+		if err != nil {
+			errs = append(errs, domain.ValidationError{Path: pointer, Message: err.Error(), Keyword: "content"})
+			continue
+		}
+		if schema != nil {
+			errs = append(errs, m.validate(schema, value, pointer)...)
+		}
+	}
+	return errs
+}
+
+// parameterValue decodes the values of a parameter into the type its schema
+// declares, following the parameter's style. Parameters described by
+// content carry a JSON document.
+func (m *Mock) parameterValue(param domain.Parameter, values []string) (interface{}, interface{}, error) {
+	if param.Schema == nil {
+		for _, mediaType := range sortedKeys(param.Content) {
+			var value interface{}
+			if err := json.Unmarshal([]byte(values[0]), &value); err != nil {
+				return nil, nil, fmt.Errorf("parameter %s is not valid JSON", param.Name)
+			}
+			return param.Content[mediaType].Schema, value, nil
+		}
+		return nil, nil, nil
+	}
+
+	schema := m.resolve(param.Schema)
+	switch typeOf(schema) {
+	case "array":
+		if len(values) == 1 {
+			values = splitValue(param, values[0])
+		}
+		items := m.resolve(schema["items"])
+		list := make([]interface{}, len(values))
+		for i, value := range values {
+			list[i] = coerce(value, typeOf(items))
+		}
+		return param.Schema, list, nil
+	case "object":
+		// Objects spread over several values are not reassembled
+		return nil, nil, nil
+	}
+	return param.Schema, coerce(values[0], typeOf(schema)), nil
+}
+
+// splitValue splits a single array value by the delimiter of the
+// parameter's style
+func splitValue(param domain.Parameter, value string) []string {
+	style := param.Style
+	if style == "" {
+		style = "simple"
+		if param.In == "query" || param.In == "cookie" {
+			style = "form"
+		}
+	}
+	explode := style == "form"
+	if param.Explode != nil {
+		explode = *param.Explode
+	}
+
+	switch {
+	case style == "spaceDelimited":
+		return strings.Split(value, " ")
+	case style == "pipeDelimited":
+		return strings.Split(value, "|")
+	case style == "form" && explode:
+		return []string{value}
+	}
+	return strings.Split(value, ",")
+}
+
+// coerce converts a parameter value to a number or boolean when the schema
+// asks for one; values that do not convert are left as strings for the
+// schema check to report
+func coerce(value, schemaType string) interface{} {
+	switch schemaType {
+	case "integer", "number":
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			return number
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return value
+}
+
+// checkBody checks a request body against the schema of its media type.
+// The second result is set when the media type is not declared at all.
+// Only JSON bodies are checked against their schema.
+func (m *Mock) checkBody(endpoint *domain.Endpoint, request *domain.MockRequest) ([]domain.ValidationError, string) {
+	if endpoint.RequestBody == "" {
+		return nil, ""
+	}
+	body, ok := m.bodies[endpoint.RequestBody]
+	if !ok {
+		return nil, ""
+	}
+
+	if len(request.Body) == 0 {
+		if body.Required {
+			return []domain.ValidationError{{
+				Path:    "/body",
+				Message: "request body is required",
+				Keyword: "required",
+			}}, ""
+		}
+		return nil, ""
+	}
+
+	mediaType := "application/json"
+	if header := request.Headers["Content-Type"]; len(header) > 0 {
+		if parsed, _, err := mime.ParseMediaType(header[0]); err == nil {
+			mediaType = parsed
+		}
+	}
+
+	declared, ok := findMediaType(body.Content, mediaType)
+	if !ok {
+		return nil, fmt.Sprintf("media type %s is not declared for this request body", mediaType)
+	}
+	if !isJSON(mediaType) {
+		return nil, ""
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(request.Body, &value); err != nil {
+		return []domain.ValidationError{{
+			Path:    "/body",
+			Message: fmt.Sprintf("request body is not valid JSON: %v", err),
+			Keyword: "json",
+		}}, ""
+	}
+
+	if declared.Schema == nil {
+		return nil, ""
+	}
+	return m.validate(declared.Schema, value, "/body"), ""
+}
+
+// validate checks a value against a schema, reporting errors below pointer
+func (m *Mock) validate(schema, value interface{}, pointer string) []domain.ValidationError {
+	errs := m.validator.Validate(schema, value)
+	for i := range errs {
+		if errs[i].Path == "/" {
+			errs[i].Path = pointer
+		} else {
+			errs[i].Path = pointer + errs[i].Path
+		}
+	}
+	return errs
+}
+
+// resolve follows references and returns the schema object, or nil
+func (m *Mock) resolve(schema interface{}) map[string]interface{} {
+	expanded, err := m.refs.Expand(schema)
+	if err != nil {
+		return nil
+	}
+	object, _ := expanded.(map[string]interface{})
+	return object
+}
+
+// typeOf returns the first non-null type a schema declares
+func typeOf(schema map[string]interface{}) string {
+	switch t := schema["type"].(type) {
+	case string:
+		return t
+	case []interface{}:
+		for _, item := range t {
+			if name, ok := item.(string); ok && name != "null" {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// findMediaType returns the declared media type that covers the given one,
+// preferring an exact match over type/* and */* ranges
+func findMediaType(content map[string]domain.MediaType, mediaType string) (domain.MediaType, bool) {
+	if media, ok := content[mediaType]; ok {
+		return media, true
+	}
+	major, _, _ := strings.Cut(mediaType, "/")
+	if media, ok := content[major+"/*"]; ok {
+		return media, true
+	}
+	media, ok := content["*/*"]
+	return media, ok
+}
+
+// isJSON reports whether a media type carries JSON, such as
+// application/json or application/problem+json
+func isJSON(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
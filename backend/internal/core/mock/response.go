@@ -0,0 +1,294 @@
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// preference is what a client asks for in a Prefer header, such as
+// "code=404, example=notFound"
+type preference struct {
+	code    string
+	example string
+}
+
+// respond builds the response for a request that matched an endpoint. The
+// status is the one the client prefers, or else the lowest 2xx code, a 2XX
+// range, default or the lowest code declared, in that order.
+func (m *Mock) respond(endpoint *domain.Endpoint, request *domain.MockRequest) *domain.MockResponse {
+	prefer := parsePrefer(request.Headers["Prefer"])
+
+	code, status, ok := selectResponse(endpoint.Responses, prefer.code)
+	if !ok {
+		if prefer.code != "" {
+			return errorResponse(http.StatusBadRequest,
+				fmt.Sprintf("no response with status %s is declared for %s %s", prefer.code, endpoint.Method, endpoint.Path), nil)
+		}
+		return &domain.MockResponse{Status: http.StatusNoContent, Headers: map[string]string{}}
+	}
+
+	declared := m.responses[endpoint.Responses[code]]
+	response := &domain.MockResponse{
+		Status:  status,
+		Headers: m.headers(declared),
+	}
+
+	if len(declared.Content) == 0 {
+		return response
+	}
+
+	mediaType, ok := negotiate(declared.Content, strings.Join(request.Headers["Accept"], ","))
+	if !ok {
+		return errorResponse(http.StatusNotAcceptable,
+			fmt.Sprintf("none of the media types declared for status %s is acceptable", code), nil)
+	}
+
+	value := m.example(declared.Content[mediaType], prefer.example)
+	if strings.Contains(mediaType, "*") {
+		mediaType = "application/json"
+		if _, isString := value.(string); isString {
+			mediaType = "text/plain"
+		}
+	}
+	response.Headers["Content-Type"] = mediaType
+
+	if text, isString := value.(string); isString && !isJSON(mediaType) {
+		response.Body = []byte(text)
+		return response
+	}
+	body, err := json.Marshal(value)
+	if err != nil {
+		return errorResponse(http.StatusInternalServerError, fmt.Sprintf("cannot encode example: %v", err), nil)
+	}
+	response.Body = body
+	return response
+}
+
+// selectResponse picks the declared response to send and its status
+func selectResponse(responses map[string]string, preferred string) (string, int, bool) {
+	if preferred != "" {
+		if _, ok := responses[preferred]; ok {
+			return preferred, statusOf(preferred), true
+		}
+		// A code without a response of its own is covered by its range,
+		// then by default
+		status, err := strconv.Atoi(preferred)
+		if err != nil {
+			return "", 0, false
+		}
+		for _, code := range []string{preferred[:1] + "XX", "default"} {
+			if _, ok := responses[code]; ok {
+				return code, status, true
+			}
+		}
+		return "", 0, false
+	}
+
+	codes := sortedKeys(responses)
+	for _, code := range codes {
+		if len(code) == 3 && code[0] == '2' && code[1] != 'X' {
+			return code, statusOf(code), true
+		}
+	}
+	for _, code := range []string{"2XX", "default"} {
+		if _, ok := responses[code]; ok {
+			return code, statusOf(code), true
+		}
+	}
+	if len(codes) > 0 {
+		return codes[0], statusOf(codes[0]), true
+	}
+	return "", 0, false
+}
+
+// statusOf returns the status to send for a response code: the code itself,
+// the first code of a range such as 4XX, or 200 for default
+func statusOf(code string) int {
+	if status, err := strconv.Atoi(code); err == nil {
+		return status
+	}
+	if len(code) == 3 && strings.HasSuffix(strings.ToUpper(code), "XX") {
+		if status, err := strconv.Atoi(code[:1] + "00"); err == nil {
+			return status
+		}
+	}
+	return http.StatusOK
+}
+
+// parsePrefer reads the code and example preferences of Prefer headers
+func parsePrefer(headers []string) preference {
+	var prefer preference
+	for _, header := range headers {
+		for _, part := range strings.FieldsFunc(header, func(r rune) bool { return r == ',' || r == ';' }) {
+			key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+			value = strings.Trim(strings.TrimSpace(value), `"`)
+			switch strings.ToLower(strings.TrimSpace(key)) {
+			case "code":
+				prefer.code = value
+			case "example":
+				prefer.example = value
+			}
+		}
+	}
+	return prefer
+}
+
+// negotiate picks the declared media type that best fits an Accept header.
+// Without a preference, JSON is picked when it is declared.
+func negotiate(content map[string]domain.MediaType, accept string) (string, bool) {
+	declared := sortedKeys(content)
+	sort.SliceStable(declared, func(i, j int) bool { return isJSON(declared[i]) && !isJSON(declared[j]) })
+
+	ranges := parseAccept(accept)
+	if len(ranges) == 0 {
+		return declared[0], true
+	}
+	for _, accepted := range ranges {
+		for _, mediaType := range declared {
+			if mediaMatches(accepted, mediaType) {
+				return mediaType, true
+			}
+		}
+	}
+	return "", false
+}
+
+// parseAccept returns the media ranges of an Accept header, most preferred
+// first, leaving out the ones with quality 0
+func parseAccept(accept string) []string {
+	type weighted struct {
+		mediaRange string
+		quality    float64
+	}
+
+	var ranges []weighted
+	for _, part := range strings.Split(accept, ",") {
+		mediaRange, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if q, err := strconv.ParseFloat(params["q"], 64); err == nil {
+			quality = q
+		}
+		if quality > 0 {
+			ranges = append(ranges, weighted{mediaRange, quality})
+		}
+	}
+	sort.SliceStable(ranges, func(i, j int) bool { return ranges[i].quality > ranges[j].quality })
+
+	result := make([]string, len(ranges))
+	for i, r := range ranges {
+		result[i] = r.mediaRange
+	}
+	return result
+}
+
+// mediaMatches reports whether two media types or ranges overlap
+func mediaMatches(a, b string) bool {
+	aMajor, aMinor, _ := strings.Cut(a, "/")
+	bMajor, bMinor, _ := strings.Cut(b, "/")
+	return (aMajor == "*" || bMajor == "*" || aMajor == bMajor) &&
+		(aMinor == "*" || bMinor == "*" || aMinor == bMinor)
+}
+
+// example returns the body for a media type: the named example when the
+// client asked for one, the declared example, the first of the declared
+// examples, or one synthesized from the schema
+func (m *Mock) example(media domain.MediaType, name string) interface{} {
+	if example, ok := media.Examples[name]; ok && name != "" {
+		return m.exampleValue(example)
+	}
+	if media.Example != nil {
+		return media.Example
+	}
+	if names := sortedKeys(media.Examples); len(names) > 0 {
+		return m.exampleValue(media.Examples[names[0]])
+	}
+	if media.Schema != nil {
+		return m.generator.Generate(media.Schema)
+	}
+	return nil
+}
+
+// exampleValue returns the value of an Example Object
+func (m *Mock) exampleValue(example interface{}) interface{} {
+	resolved := m.component("examples", example)
+	object, ok := resolved.(map[string]interface{})
+	if !ok {
+		return resolved
+	}
+	return object["value"]
+}
+
+// component follows a reference into a section of the components, such as
+// #/components/examples/One, and returns the object it points at
+func (m *Mock) component(section string, value interface{}) interface{} {
+	prefix := "#/components/" + section + "/"
+	for i := 0; i < 8; i++ {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return value
+		}
+		ref, isRef := object["$ref"].(string)
+		if !isRef {
+			return object
+		}
+		name, found := strings.CutPrefix(ref, prefix)
+		if !found {
+			return nil
+		}
+		components, _ := m.api.Components[section].(map[string]interface{})
+		value = components[name]
+	}
+	return nil
+}
+
+// headers returns example values for the headers a response declares
+func (m *Mock) headers(response domain.Response) map[string]string {
+	headers := make(map[string]string, len(response.Headers))
+	for _, name := range sortedKeys(response.Headers) {
+		if strings.EqualFold(name, "Content-Type") {
+			continue
+		}
+		header, _ := m.component("headers", response.Headers[name]).(map[string]interface{})
+
+		var value interface{}
+		switch {
+		case header["example"] != nil:
+			value = header["example"]
+		case header["schema"] != nil:
+			value = m.generator.Generate(header["schema"])
+		default:
+			continue
+		}
+		headers[http.CanonicalHeaderKey(name)] = headerText(value)
+	}
+	return headers
+}
+
+// headerText writes a header value in the simple style: arrays as
+// comma-separated lists
+func headerText(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = headerText(item)
+		}
+		return strings.Join(parts, ",")
+	case nil:
+		return ""
+	}
+	data, _ := json.Marshal(value)
+	return string(data)
+}
@@ -0,0 +1,104 @@
+package mock
+
+import (
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// templateParam matches a {name} path template expression
+var templateParam = regexp.MustCompile(`\{([^{}/]+)\}`)
+
+// route matches request paths against one endpoint's path template
+type route struct {
+	endpoint *domain.Endpoint
+	pattern  *regexp.Regexp
+	names    []string
+	literal  int
+}
+
+// newRoutes compiles the path templates of endpoints, most specific first:
+// fewer template expressions, then more literal text, so that /pets/mine
+// wins over /pets/{id}
+func newRoutes(endpoints []domain.Endpoint) []route {
+	routes := make([]route, 0, len(endpoints))
+	for i := range endpoints {
+		routes = append(routes, compileRoute(&endpoints[i]))
+	}
+
+	sort.SliceStable(routes, func(i, j int) bool {
+		if len(routes[i].names) != len(routes[j].names) {
+			return len(routes[i].names) < len(routes[j].names)
+		}
+		return routes[i].literal > routes[j].literal
+	})
+	return routes
+}
+
+func compileRoute(endpoint *domain.Endpoint) route {
+	r := route{endpoint: endpoint}
+	template := strings.TrimSuffix(endpoint.Path, "/")
+
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	last := 0
+	for _, match := range templateParam.FindAllStringSubmatchIndex(template, -1) {
+		pattern.WriteString(regexp.QuoteMeta(template[last:match[0]]))
+		pattern.WriteString("([^/]+)")
+		r.names = append(r.names, template[match[2]:match[3]])
+		r.literal += match[0] - last
+		last = match[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(template[last:]))
+	r.literal += len(template) - last
+	pattern.WriteString("/?$")
+
+	r.pattern = regexp.MustCompile(pattern.String())
+	return r
+}
+
+// matchRoute returns the route matching a method and path, with the values
+// of its template expressions. When only other methods match the path, they
+// are returned instead.
+func matchRoute(routes []route, method, path string) (*route, map[string]string, []string) {
+	var allowed []string
+	for i := range routes {
+		r := &routes[i]
+		values := r.pattern.FindStringSubmatch(path)
+		if values == nil {
+			continue
+		}
+		if !strings.EqualFold(r.endpoint.Method, method) {
+			allowed = append(allowed, strings.ToUpper(r.endpoint.Method))
+			continue
+		}
+
+		params := make(map[string]string, len(r.names))
+		for j, name := range r.names {
+			params[name] = values[j+1]
+		}
+		return r, params, nil
+	}
+	return nil, nil, allowed
+}
+
+// basePaths returns the paths of the server URLs, which clients may keep
+// in front of the endpoint paths, longest first
+func basePaths(servers []domain.Server) []string {
+	var paths []string
+	for _, server := range servers {
+		parsed, err := url.Parse(server.URL)
+		if err != nil || strings.Contains(parsed.Path, "{") {
+			continue
+		}
+		if path := strings.TrimSuffix(parsed.Path, "/"); path != "" {
+			paths = append(paths, path)
+		}
+	}
+
+	sort.SliceStable(paths, func(i, j int) bool { return len(paths[i]) > len(paths[j]) })
+	return paths
+}
@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// EventBus defines the interface for publishing changes to API definitions
+// to the clients that follow them
+type EventBus interface {
+	// Publish sends an event to the subscribers of its definition. It does
+	// not wait for subscribers to receive it.
+	Publish(ctx context.Context, event domain.DefinitionEvent)
+
+	// Subscribe returns the events of one definition, or of all definitions
+	// when definitionID is empty. The channel is closed once ctx is done.
+	Subscribe(ctx context.Context, definitionID string) <-chan domain.DefinitionEvent
+}
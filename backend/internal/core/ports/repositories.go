@@ -19,9 +19,22 @@ type APIRepository interface {
 	// Update updates an existing API definition
 	Update(ctx context.Context, api *domain.APIDefinition) error
 
-	// Delete removes an API definition
+	// Delete removes an API definition and its revisions
 	Delete(ctx context.Context, id string) error
 
 	// ExistsByID checks if an API definition exists
 	ExistsByID(ctx context.Context, id string) (bool, error)
+
+	// SaveRevision stores an API definition, creating or replacing it, and
+	// records it as a new revision in the same step. The repository numbers
+	// the revision and sets the number on both api and revision.
+	SaveRevision(ctx context.Context, api *domain.APIDefinition, revision *domain.Revision) error
+
+	// ListRevisions lists the revisions of an API definition, oldest first,
+	// without their snapshots
+	ListRevisions(ctx context.Context, id string) ([]*domain.Revision, error)
+
+	// FindRevision retrieves a revision with its snapshot, or nil when the
+	// definition has no revision with that number
+	FindRevision(ctx context.Context, id string, number int) (*domain.Revision, error)
 }
\ No newline at end of file
@@ -10,8 +10,10 @@ type ConverterService interface {
 	// ConvertSwaggerToJSON converts Swagger/OpenAPI to normalized JSON
 	ConvertSwaggerToJSON(ctx context.Context, request *domain.ConversionRequest) (*domain.ConversionResponse, error)
 
-	// ConvertJSONToSwagger converts normalized JSON back to Swagger/OpenAPI
-	ConvertJSONToSwagger(ctx context.Context, api *domain.APIDefinition, format string) (string, error)
+	// ConvertJSONToSwagger converts normalized JSON back to Swagger/OpenAPI,
+	// targeting the given OpenAPI version ("3.0.3", "3.1.0" or empty for the default)
+	// and warning about the keywords that version could not keep
+	ConvertJSONToSwagger(ctx context.Context, api *domain.APIDefinition, format string, version string) (string, []domain.Warning, error)
 }
 
 // ValidatorService defines the interface for validation operations
@@ -19,6 +21,10 @@ type ValidatorService interface {
 	// ValidateSwagger validates a Swagger/OpenAPI specification
 	ValidateSwagger(ctx context.Context, content string) (*domain.ValidationResponse, error)
 
+	// ValidateSwaggerBundle validates a multi-file specification, with the
+	// files its $refs point into keyed by path relative to the root document
+	ValidateSwaggerBundle(ctx context.Context, content string, files map[string]string) (*domain.ValidationResponse, error)
+
 	// ValidateJSON validates JSON against a schema
 	ValidateJSON(ctx context.Context, request *domain.ValidationRequest) (*domain.ValidationResponse, error)
 
@@ -28,8 +34,8 @@ type ValidatorService interface {
 
 // APIService defines the interface for API definition operations
 type APIService interface {
-	// CreateAPIDefinition creates a new API definition
-	CreateAPIDefinition(ctx context.Context, api *domain.APIDefinition) (*domain.APIDefinition, error)
+	// CreateAPIDefinition creates a new API definition as its first revision
+	CreateAPIDefinition(ctx context.Context, api *domain.APIDefinition, change domain.Change) (*domain.APIDefinition, error)
 
 	// GetAPIDefinition retrieves an API definition by ID
 	GetAPIDefinition(ctx context.Context, id string) (*domain.APIDefinition, error)
@@ -37,15 +43,111 @@ type APIService interface {
 	// ListAPIDefinitions lists all API definitions
 	ListAPIDefinitions(ctx context.Context) ([]*domain.APIDefinition, error)
 
-	// UpdateAPIDefinition updates an existing API definition
-	UpdateAPIDefinition(ctx context.Context, id string, api *domain.APIDefinition) (*domain.APIDefinition, error)
+	// FindByExtension lists the API definitions that set the given x- extension
+	FindByExtension(ctx context.Context, key string) ([]*domain.APIDefinition, error)
+
+	// GetExtensions lists the x- extensions of an API definition, optionally
+	// limited to one key
+	GetExtensions(ctx context.Context, id string, key string) ([]domain.ExtensionUsage, error)
+
+	// UpdateAPIDefinition updates an existing API definition, recording the
+	// new state as a revision
+	UpdateAPIDefinition(ctx context.Context, id string, api *domain.APIDefinition, change domain.Change) (*domain.APIDefinition, error)
+
+	// ListRevisions lists the revisions of an API definition, oldest first
+	ListRevisions(ctx context.Context, id string) ([]*domain.Revision, error)
+
+	// GetRevision retrieves an API definition as it was at a revision
+	GetRevision(ctx context.Context, id string, number int) (*domain.APIDefinition, error)
+
+	// RestoreRevision saves the state of an old revision as a new revision
+	RestoreRevision(ctx context.Context, id string, number int, change domain.Change) (*domain.APIDefinition, error)
 
 	// DeleteAPIDefinition deletes an API definition
 	DeleteAPIDefinition(ctx context.Context, id string) error
 
-	// ImportSwagger imports a Swagger/OpenAPI specification
-	ImportSwagger(ctx context.Context, content string) (*domain.APIDefinition, error)
+	// ImportSwagger imports a Swagger/OpenAPI specification, optionally split
+	// across files keyed by path relative to the root document
+	ImportSwagger(ctx context.Context, content string, files map[string]string, change domain.Change) (*domain.APIDefinition, error)
+
+	// ExportSwagger exports an API definition as Swagger/OpenAPI, with warnings
+	// for the keywords the target version could not keep
+	ExportSwagger(ctx context.Context, id string, format string, version string) (string, []domain.Warning, error)
+
+	// ValidateStored validates a stored API definition and lets its
+	// subscribers know the result
+	ValidateStored(ctx context.Context, id string) (*domain.ValidationResponse, error)
+}
+
+// LinterService defines the interface for style checks of specifications
+type LinterService interface {
+	// Lint checks a specification against the configured lint rules
+	Lint(ctx context.Context, request *domain.LintRequest) (*domain.ValidationResponse, error)
+}
+
+// DiffService defines the interface for comparing API definitions
+type DiffService interface {
+	// Diff lists the changes between two API definitions, stored or raw
+	Diff(ctx context.Context, request *domain.DiffRequest) (*domain.DiffResult, error)
+
+	// CheckCompatibility classifies the changes made to an API definition
+	// since a revision as breaking or not; revision 0 means the one before
+	// the current revision
+	CheckCompatibility(ctx context.Context, id string, against int) (*domain.CompatibilityReport, error)
+}
 
-	// ExportSwagger exports an API definition as Swagger/OpenAPI
-	ExportSwagger(ctx context.Context, id string, format string) (string, error)
-}
\ No newline at end of file
+// MockService defines the interface for serving mocks of API definitions
+type MockService interface {
+	// Serve answers a request made to the mock of an API definition
+	Serve(ctx context.Context, id string, request *domain.MockRequest) (*domain.MockResponse, error)
+}
+
+// ExampleService defines the interface for synthesizing examples from schemas
+type ExampleService interface {
+	// GenerateExample synthesizes an example for a named schema of an API
+	// definition; the same seed always gives the same example
+	GenerateExample(ctx context.Context, id string, schemaID string, seed uint64) (*domain.SchemaExample, error)
+
+	// WriteExamples stores synthesized examples in an API definition, for one
+	// schema or, when schemaID is empty, for every schema and media type
+	WriteExamples(ctx context.Context, id string, schemaID string, seed uint64, overwrite bool, change domain.Change) (*domain.APIDefinition, error)
+}
+
+// ContractService defines the interface for testing running services
+// against API definitions
+type ContractService interface {
+	// Run calls every endpoint of an API definition on a running service and
+	// checks the responses against the definition
+	Run(ctx context.Context, id string, request *domain.ContractTestRequest) (*domain.ContractReport, error)
+}
+
+// TrafficService defines the interface for validating recorded traffic
+type TrafficService interface {
+	// ValidateTraffic checks recorded exchanges in a format ("har", "jsonl"
+	// or empty to detect it) against an API definition
+	ValidateTraffic(ctx context.Context, id string, data []byte, format string) (*domain.TrafficReport, error)
+}
+
+// GraphQLExportService defines the interface for turning API definitions
+// into GraphQL schemas
+type GraphQLExportService interface {
+	// ExportGraphQL returns the GraphQL schema, in the schema definition
+	// language, of the queries and mutations that stand for the operations
+	// of an API definition
+	ExportGraphQL(ctx context.Context, id string) (string, error)
+}
+
+// CodegenService defines the interface for generating code from API
+// definitions
+type CodegenService interface {
+	// GenerateGo generates a Go package for an API definition: a type with
+	// json tags and a Validate method per schema, a client with a method per
+	// operation and a chi server interface with its router. The package is
+	// named packageName, or after the API when that is empty.
+	GenerateGo(ctx context.Context, id string, packageName string) (*domain.GeneratedCode, error)
+
+	// GenerateTypeScript generates TypeScript for an API definition: an
+	// interface or type per schema, with discriminated unions for oneOf,
+	// and a fetch client with a typed method per operation
+	GenerateTypeScript(ctx context.Context, id string) (*domain.GeneratedCode, error)
+}
@@ -0,0 +1,271 @@
+package resolver
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// componentSections maps the location a reference appears at to the
+// components section its target belongs to
+var componentSections = map[string]string{
+	"parameters":      "parameters",
+	"responses":       "responses",
+	"requestBody":     "requestBodies",
+	"requestBodies":   "requestBodies",
+	"headers":         "headers",
+	"examples":        "examples",
+	"links":           "links",
+	"callbacks":       "callbacks",
+	"securitySchemes": "securitySchemes",
+}
+
+// swagger2Sections maps components sections to their Swagger 2.0 top-level keys
+var swagger2Sections = map[string]string{
+	"schemas":    "definitions",
+	"parameters": "parameters",
+	"responses":  "responses",
+}
+
+// bundler copies external reference targets into the root document
+type bundler struct {
+	resolver *Resolver
+	root     map[string]interface{}
+	swagger2 bool
+	hoisted  map[string]string
+	errs     []domain.ValidationError
+}
+
+// Bundle returns a copy of the root document in which every reference into
+// another file of the bundle has been replaced by a local reference. Targets
+// are copied into the matching components section (definitions, parameters
+// and responses for Swagger 2.0), or inlined for path items. Recursive
+// references across files are preserved as local references.
+func (r *Resolver) Bundle() (map[string]interface{}, []domain.ValidationError) {
+	root, ok := deepCopy(r.Root()).(map[string]interface{})
+	if !ok {
+		return nil, []domain.ValidationError{{
+			Path:    "/",
+			Message: "root document must be an object",
+		}}
+	}
+
+	b := &bundler{
+		resolver: r,
+		root:     root,
+		hoisted:  make(map[string]string),
+	}
+	_, b.swagger2 = root["swagger"]
+
+	for _, key := range sortedMapKeys(root) {
+		root[key] = b.rewrite(root[key], r.root, JoinPointer("", key), true)
+	}
+
+	return root, b.errs
+}
+
+// rewrite walks a copied value that came from file, replacing references
+// that leave the root document. Local references are only kept as-is while
+// walking the root document itself.
+func (b *bundler) rewrite(value interface{}, file, pointer string, inRoot bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if ref, ok := v["$ref"].(string); ok {
+			refFile, _, err := SplitRef(ref)
+			if err == nil && (refFile != "" || !inRoot) {
+				if isPathItemLocation(pointer) {
+					return b.inline(v, file, pointer, ref, inRoot)
+				}
+				v["$ref"] = b.internalize(file, pointer, ref)
+			}
+		}
+		for _, key := range sortedMapKeys(v) {
+			if key != "$ref" {
+				v[key] = b.rewrite(v[key], file, JoinPointer(pointer, key), inRoot)
+			}
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = b.rewrite(item, file, JoinPointer(pointer, fmt.Sprint(i)), inRoot)
+		}
+		return v
+	default:
+		return value
+	}
+}
+
+// internalize copies the target of an external reference into the root
+// document and returns the local reference that replaces it
+func (b *bundler) internalize(file, pointer, ref string) string {
+	target, err := b.resolver.lookup(file, ref)
+	if err != nil {
+		b.errs = append(b.errs, b.resolver.refError(file, JoinPointer(pointer, "$ref"), ref, &RefError{Ref: ref, File: file, Err: err}))
+		return ref
+	}
+
+	if target.File == b.resolver.root {
+		return "#" + target.Pointer
+	}
+
+	key := target.File + "#" + target.Pointer
+	if local, ok := b.hoisted[key]; ok {
+		return local
+	}
+
+	section := b.sectionFor(pointer, target)
+	container := b.container(section)
+	name := uniqueName(container, componentName(target))
+
+	local := "#" + JoinPointer(b.sectionPointer(section), name)
+
+	// Register before walking the copy so recursive references find it
+	b.hoisted[key] = local
+	container[name] = b.rewrite(deepCopy(target.Value), target.File, target.Pointer, false)
+
+	return local
+}
+
+// inline replaces a referenced path item with a copy of its target, since
+// OpenAPI 3.0 has no components section for path items
+func (b *bundler) inline(object map[string]interface{}, file, pointer, ref string, inRoot bool) interface{} {
+	target, err := b.resolver.Resolve(file, ref)
+	if err != nil {
+		b.errs = append(b.errs, b.resolver.refError(file, JoinPointer(pointer, "$ref"), ref, err))
+		return object
+	}
+
+	item, ok := b.rewrite(deepCopy(target.Value), target.File, target.Pointer, target.File == b.resolver.root && inRoot).(map[string]interface{})
+	if !ok {
+		return object
+	}
+	for key, value := range object {
+		if key != "$ref" {
+			item[key] = b.rewrite(value, file, JoinPointer(pointer, key), inRoot)
+		}
+	}
+	return item
+}
+
+// sectionFor decides which components section an external target belongs to,
+// from the target's own location when it has one and from where the
+// reference was used otherwise
+func (b *bundler) sectionFor(pointer string, target *Target) string {
+	tokens, _ := SplitPointer(target.Pointer)
+	if len(tokens) >= 3 && tokens[0] == "components" {
+		return tokens[1]
+	}
+	if len(tokens) >= 2 && tokens[0] == "definitions" {
+		return "schemas"
+	}
+
+	usedAt, _ := SplitPointer(pointer)
+	for i := len(usedAt) - 1; i >= 0; i-- {
+		token := usedAt[i]
+		if token == "schema" || token == "properties" || token == "items" || token == "allOf" ||
+			token == "oneOf" || token == "anyOf" || token == "additionalProperties" || token == "schemas" {
+			return "schemas"
+		}
+		if section, ok := componentSections[token]; ok {
+			return section
+		}
+	}
+
+	return "schemas"
+}
+
+// container returns the map holding a components section, creating it if needed
+func (b *bundler) container(section string) map[string]interface{} {
+	parent := b.root
+	key := section
+
+	if b.swagger2 {
+		if topLevel, ok := swagger2Sections[section]; ok {
+			key = topLevel
+		} else {
+			key = "x-" + section
+		}
+	} else {
+		components, ok := b.root["components"].(map[string]interface{})
+		if !ok {
+			components = map[string]interface{}{}
+			b.root["components"] = components
+		}
+		parent = components
+	}
+
+	container, ok := parent[key].(map[string]interface{})
+	if !ok {
+		container = map[string]interface{}{}
+		parent[key] = container
+	}
+	return container
+}
+
+func (b *bundler) sectionPointer(section string) string {
+	if b.swagger2 {
+		if topLevel, ok := swagger2Sections[section]; ok {
+			return JoinPointer("", topLevel)
+		}
+		return JoinPointer("", "x-"+section)
+	}
+	return JoinPointer("", "components", section)
+}
+
+// componentName derives a readable component name from a target: the last
+// pointer token, or the file name without its extension for whole files
+func componentName(target *Target) string {
+	tokens, _ := SplitPointer(target.Pointer)
+	if len(tokens) > 0 {
+		return tokens[len(tokens)-1]
+	}
+	base := path.Base(target.File)
+	return strings.TrimSuffix(base, path.Ext(base))
+}
+
+// isPathItemLocation reports whether pointer addresses an entry of paths or webhooks
+func isPathItemLocation(pointer string) bool {
+	tokens, _ := SplitPointer(pointer)
+	return len(tokens) == 2 && (tokens[0] == "paths" || tokens[0] == "webhooks")
+}
+
+func uniqueName(container map[string]interface{}, name string) string {
+	candidate := name
+	for i := 2; ; i++ {
+		if _, exists := container[candidate]; !exists {
+			return candidate
+		}
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+}
+
+func sortedMapKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func deepCopy(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = deepCopy(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = deepCopy(item)
+		}
+		return result
+	default:
+		return value
+	}
+}
@@ -0,0 +1,91 @@
+package resolver
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// EscapeToken escapes a single reference token for use in a JSON Pointer (RFC 6901)
+func EscapeToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// UnescapeToken reverses EscapeToken
+func UnescapeToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+}
+
+// JoinPointer appends unescaped tokens to a JSON Pointer
+func JoinPointer(pointer string, tokens ...string) string {
+	var b strings.Builder
+	b.WriteString(pointer)
+	for _, token := range tokens {
+		b.WriteByte('/')
+		b.WriteString(EscapeToken(token))
+	}
+	return b.String()
+}
+
+// SplitPointer returns the unescaped tokens of a JSON Pointer
+func SplitPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q: must start with /", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = UnescapeToken(token)
+	}
+	return tokens, nil
+}
+
+// Get returns the value a JSON Pointer designates inside a decoded document
+func Get(document interface{}, pointer string) (interface{}, error) {
+	tokens, err := SplitPointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+
+	current := document
+	for i, token := range tokens {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			value, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("%s does not exist", JoinPointer("", tokens[:i+1]...))
+			}
+			current = value
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, fmt.Errorf("%s does not exist", JoinPointer("", tokens[:i+1]...))
+			}
+			current = node[index]
+		default:
+			return nil, fmt.Errorf("%s does not exist", JoinPointer("", tokens[:i+1]...))
+		}
+	}
+
+	return current, nil
+}
+
+// SplitRef separates a reference into its document part and its JSON Pointer
+// fragment, decoding any percent-escapes in the fragment
+func SplitRef(ref string) (string, string, error) {
+	file, fragment, _ := strings.Cut(ref, "#")
+
+	pointer, err := url.PathUnescape(fragment)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid reference %q: %w", ref, err)
+	}
+	if pointer != "" && !strings.HasPrefix(pointer, "/") {
+		return "", "", fmt.Errorf("invalid reference %q: only JSON Pointer fragments are supported", ref)
+	}
+
+	return file, pointer, nil
+}
@@ -0,0 +1,225 @@
+// Package resolver follows $ref pointers in OpenAPI documents, within a single
+// file and across the relative files of an uploaded bundle, and in the
+// schema references of normalized API definitions.
+package resolver
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+var (
+	// ErrNotFound is returned when a reference points at nothing
+	ErrNotFound = errors.New("reference target not found")
+
+	// ErrCircular is returned when references only point at each other
+	ErrCircular = errors.New("circular reference")
+
+	// ErrRemote is returned for references to remote URLs, which are not fetched
+	ErrRemote = errors.New("remote references are not supported")
+)
+
+// RefError describes a reference that could not be resolved
+type RefError struct {
+	Ref  string
+	File string
+	Err  error
+}
+
+func (e *RefError) Error() string {
+	return fmt.Sprintf("cannot resolve %s: %v", e.Ref, e.Err)
+}
+
+func (e *RefError) Unwrap() error {
+	return e.Err
+}
+
+// Target is the resolved destination of a reference
+type Target struct {
+	File    string
+	Pointer string
+	Value   interface{}
+}
+
+// Resolver resolves references between the decoded documents of a bundle.
+// Files are keyed by their slash-separated path relative to the bundle root.
+type Resolver struct {
+	root  string
+	files map[string]interface{}
+}
+
+// New creates a resolver for the documents in files, with root naming the entry document
+func New(root string, files map[string]interface{}) *Resolver {
+	cleaned := make(map[string]interface{}, len(files))
+	for name, document := range files {
+		cleaned[CleanPath(name)] = document
+	}
+
+	return &Resolver{
+		root:  CleanPath(root),
+		files: cleaned,
+	}
+}
+
+// Root returns the entry document
+func (r *Resolver) Root() interface{} {
+	return r.files[r.root]
+}
+
+// Resolve follows ref, as found in file, to the value it designates. Chains
+// of references are followed until a value that is not a reference is reached.
+func (r *Resolver) Resolve(file, ref string) (*Target, error) {
+	seen := make(map[string]bool)
+
+	for {
+		target, err := r.lookup(file, ref)
+		if err != nil {
+			return nil, &RefError{Ref: ref, File: file, Err: err}
+		}
+
+		key := target.File + "#" + target.Pointer
+		if seen[key] {
+			return nil, &RefError{Ref: ref, File: file, Err: ErrCircular}
+		}
+		seen[key] = true
+
+		next, ok := refOf(target.Value)
+		if !ok {
+			return target, nil
+		}
+		file, ref = target.File, next
+	}
+}
+
+// lookup resolves a single reference without following chains
+func (r *Resolver) lookup(file, ref string) (*Target, error) {
+	refFile, pointer, err := SplitRef(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.Contains(refFile, "://") {
+		return nil, ErrRemote
+	}
+
+	targetFile := r.resolveFile(file, refFile)
+	document, ok := r.files[targetFile]
+	if !ok {
+		return nil, fmt.Errorf("%w: file %s is not part of the bundle", ErrNotFound, targetFile)
+	}
+
+	value, err := Get(document, pointer)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrNotFound, err)
+	}
+
+	return &Target{File: targetFile, Pointer: pointer, Value: value}, nil
+}
+
+// resolveFile turns the document part of a reference into a bundle path
+func (r *Resolver) resolveFile(base, refFile string) string {
+	if refFile == "" {
+		return CleanPath(base)
+	}
+	if strings.HasPrefix(refFile, "/") {
+		return CleanPath(refFile)
+	}
+	return CleanPath(path.Join(path.Dir(CleanPath(base)), refFile))
+}
+
+// Check resolves every reference reachable from the root document and
+// reports each one that is broken. Paths point at the offending $ref; for
+// files other than the root they are prefixed with the file name.
+func (r *Resolver) Check() []domain.ValidationError {
+	var errs []domain.ValidationError
+
+	visited := map[string]bool{r.root: true}
+	queue := []string{r.root}
+
+	for len(queue) > 0 {
+		file := queue[0]
+		queue = queue[1:]
+
+		walkRefs(r.files[file], "", func(pointer, ref string) {
+			target, err := r.Resolve(file, ref)
+			if err != nil {
+				errs = append(errs, r.refError(file, pointer, ref, err))
+				return
+			}
+			if !visited[target.File] {
+				visited[target.File] = true
+				queue = append(queue, target.File)
+			}
+		})
+	}
+
+	return errs
+}
+
+func (r *Resolver) refError(file, pointer, ref string, err error) domain.ValidationError {
+	location := pointer
+	if file != r.root {
+		location = file + "#" + pointer
+	}
+
+	return domain.ValidationError{
+		Path:    location,
+		Message: err.Error(),
+		Keyword: "$ref",
+		Params:  map[string]interface{}{"ref": ref},
+	}
+}
+
+// FindRef returns the JSON Pointer of the first $ref in document whose value
+// is ref, or an empty string when there is none
+func FindRef(document interface{}, ref string) string {
+	found := ""
+	walkRefs(document, "", func(pointer, value string) {
+		if found == "" && value == ref {
+			found = pointer
+		}
+	})
+	return found
+}
+
+// walkRefs calls fn for every $ref string found in value, in a stable order,
+// with the JSON Pointer of the object holding the reference
+func walkRefs(value interface{}, pointer string, fn func(pointer, ref string)) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if ref, ok := v["$ref"].(string); ok {
+			fn(JoinPointer(pointer, "$ref"), ref)
+		}
+		for _, key := range sortedMapKeys(v) {
+			if key != "$ref" {
+				walkRefs(v[key], JoinPointer(pointer, key), fn)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			walkRefs(item, JoinPointer(pointer, fmt.Sprint(i)), fn)
+		}
+	}
+}
+
+// refOf returns the reference held by an object such as {"$ref": "..."}
+func refOf(value interface{}) (string, bool) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	ref, ok := object["$ref"].(string)
+	return ref, ok
+}
+
+// CleanPath turns a file name into the form files are keyed by in a bundle
+func CleanPath(name string) string {
+	if name == "" {
+		return ""
+	}
+	return strings.TrimPrefix(path.Clean("/"+name), "/")
+}
@@ -0,0 +1,232 @@
+package resolver
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+func decode(t *testing.T, content string) interface{} {
+	t.Helper()
+	var value interface{}
+	if err := json.Unmarshal([]byte(content), &value); err != nil {
+		t.Fatalf("bad test document: %v", err)
+	}
+	return value
+}
+
+// TestResolveFollowsChainsAcrossFiles resolves a reference that leads
+// through two other files, each relative to the file it appears in
+func TestResolveFollowsChainsAcrossFiles(t *testing.T) {
+	r := New("api.json", map[string]interface{}{
+		"api.json":            decode(t, `{"components": {"schemas": {"Pet": {"$ref": "schemas/pet.json#/Pet"}}}}`),
+		"schemas/pet.json":    decode(t, `{"Pet": {"$ref": "../common/name.json"}}`),
+		"common/name.json":    decode(t, `{"type": "string", "x-path": "a/b"}`),
+		"schemas/unused.json": decode(t, `{}`),
+	})
+
+	target, err := r.Resolve("api.json", "#/components/schemas/Pet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target.File != "common/name.json" || target.Pointer != "" {
+		t.Errorf("resolved to %s#%s, want common/name.json", target.File, target.Pointer)
+	}
+	if schema, _ := target.Value.(map[string]interface{}); schema["type"] != "string" {
+		t.Errorf("resolved value = %v, want the name schema", target.Value)
+	}
+}
+
+// TestResolveReportsCycles resolves references that only lead to each
+// other, directly and through another file
+func TestResolveReportsCycles(t *testing.T) {
+	r := New("api.json", map[string]interface{}{
+		"api.json": decode(t, `{
+			"self": {"$ref": "#/self"},
+			"a": {"$ref": "#/b"},
+			"b": {"$ref": "other.json#/c"}
+		}`),
+		"other.json": decode(t, `{"c": {"$ref": "api.json#/a"}}`),
+	})
+
+	for _, ref := range []string{"#/self", "#/a", "#/b"} {
+		if _, err := r.Resolve("api.json", ref); !errors.Is(err, ErrCircular) {
+			t.Errorf("%s: %v, want a circular reference error", ref, err)
+		}
+	}
+}
+
+// TestResolveReportsBrokenRefs resolves references to nothing and gets the
+// matching error for each
+func TestResolveReportsBrokenRefs(t *testing.T) {
+	r := New("api.json", map[string]interface{}{
+		"api.json": decode(t, `{"list": [1, 2]}`),
+	})
+
+	tests := []struct {
+		ref  string
+		want error
+	}{
+		{"#/missing", ErrNotFound},
+		{"#/list/2", ErrNotFound},
+		{"#/list/0/deeper", ErrNotFound},
+		{"absent.json#/Pet", ErrNotFound},
+		{"https://example.com/pet.json", ErrRemote},
+	}
+	for _, tt := range tests {
+		if _, err := r.Resolve("api.json", tt.ref); !errors.Is(err, tt.want) {
+			t.Errorf("%s: %v, want %v", tt.ref, err, tt.want)
+		}
+	}
+	if _, err := r.Resolve("api.json", "#definitions/Pet"); err == nil {
+		t.Error("a fragment that is not a JSON Pointer resolved, want an error")
+	}
+}
+
+// TestResolveNamesBrokenLink resolves a chain of references whose last link
+// is broken and gets an error naming that link and its file
+func TestResolveNamesBrokenLink(t *testing.T) {
+	r := New("api.json", map[string]interface{}{
+		"api.json":  decode(t, `{"Pet": {"$ref": "pets.json#/Pet"}}`),
+		"pets.json": decode(t, `{"Pet": {"$ref": "#/Gone"}}`),
+	})
+
+	_, err := r.Resolve("api.json", "#/Pet")
+	var refErr *RefError
+	if !errors.As(err, &refErr) || !errors.Is(err, ErrNotFound) {
+		t.Fatalf("%v, want a reference error for a missing target", err)
+	}
+	if refErr.File != "pets.json" || refErr.Ref != "#/Gone" {
+		t.Errorf("error names %s in %s, want #/Gone in pets.json", refErr.Ref, refErr.File)
+	}
+}
+
+// TestCheckReportsEveryBrokenRef checks a bundle with a broken reference in
+// the root, one in a referenced file and a cycle, and gets one error at
+// each $ref. Files that nothing references are not checked.
+func TestCheckReportsEveryBrokenRef(t *testing.T) {
+	r := New("api.json", map[string]interface{}{
+		"api.json": decode(t, `{
+			"paths": {"/pets": {"get": {"responses": {
+				"200": {"$ref": "#/components/responses/Missing"},
+				"404": {"$ref": "errors.json#/NotFound"}
+			}}}},
+			"components": {"schemas": {
+				"Loop": {"$ref": "#/components/schemas/Loop"},
+				"Name": {"type": "string"}
+			}}
+		}`),
+		"errors.json": decode(t, `{"NotFound": {"description": "Not found", "schema": {"$ref": "#/Gone"}}}`),
+		"unused.json": decode(t, `{"x": {"$ref": "#/nothing"}}`),
+	})
+
+	errs := r.Check()
+	got := map[string]string{}
+	for _, err := range errs {
+		if err.Keyword != "$ref" {
+			t.Errorf("%s: keyword %q, want $ref", err.Path, err.Keyword)
+		}
+		got[err.Path] = err.Message
+	}
+	want := map[string]string{
+		"/components/schemas/Loop/$ref":        "circular reference",
+		"/paths/~1pets/get/responses/200/$ref": "not found",
+		"errors.json#/NotFound/schema/$ref":    "not found",
+	}
+	if len(got) != len(want) {
+		t.Errorf("errors = %v, want %d", got, len(want))
+	}
+	for path, message := range want {
+		if !strings.Contains(got[path], message) {
+			t.Errorf("%s: %q, want it to mention %q", path, got[path], message)
+		}
+	}
+}
+
+// TestSchemaResolverExpandKeepsRecursion expands a recursive schema and
+// finds the inner reference left in place
+func TestSchemaResolverExpandKeepsRecursion(t *testing.T) {
+	api := &domain.APIDefinition{Schemas: []domain.Schema{
+		{ID: "schema-node", Name: "Node", Type: "object", Properties: map[string]interface{}{
+			"children": map[string]interface{}{"type": "array", "items": "schema-node"},
+			"label":    map[string]interface{}{"$ref": "#/components/schemas/Label"},
+		}},
+		{ID: "schema-label", Name: "Label", Type: "string"},
+	}}
+	r := NewSchemaResolver(api)
+
+	expanded, err := r.Expand("schema-node")
+	if err != nil {
+		t.Fatal(err)
+	}
+	properties := expanded.(map[string]interface{})["properties"].(map[string]interface{})
+	items := properties["children"].(map[string]interface{})["items"]
+	if ref, _ := items.(map[string]interface{})["$ref"].(string); ref != "schema-node" {
+		t.Errorf("children items = %v, want the reference back to Node", items)
+	}
+	if label := properties["label"].(map[string]interface{}); label["type"] != "string" {
+		t.Errorf("label = %v, want the Label schema inlined", label)
+	}
+
+	if _, err := r.Expand(map[string]interface{}{"items": "schema-missing"}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expanding a broken reference: %v, want not found", err)
+	}
+}
+
+// TestSchemaResolverCheck reports broken schema references and endpoint
+// links to parts the definition does not have
+func TestSchemaResolverCheck(t *testing.T) {
+	api := &domain.APIDefinition{
+		Schemas: []domain.Schema{{ID: "schema-pet", Name: "Pet", Type: "object", Properties: map[string]interface{}{
+			"owner": map[string]interface{}{"$ref": "#/components/schemas/Owner"},
+			"name":  map[string]interface{}{"$ref": "#/components/schemas/Pet/properties/nick"},
+		}}},
+		Responses: []domain.Response{{ID: "response-ok", Content: map[string]domain.MediaType{
+			"application/json": {Schema: "schema-pet"},
+		}}},
+		Endpoints: []domain.Endpoint{{
+			ID: "endpoint-listpets", Method: "GET", Path: "/pets",
+			Parameters:  []string{"param-limit"},
+			RequestBody: "body-pet",
+			Responses:   map[string]string{"200": "response-ok", "404": "response-missing"},
+			Security:    []domain.SecurityRequirement{{"apiKey": {}}},
+		}},
+	}
+
+	got := map[string]string{}
+	for _, err := range NewSchemaResolver(api).Check(api) {
+		got[err.Path] = err.Message
+	}
+	want := map[string]string{
+		"/schemas/0/properties/owner/$ref": "not found",
+		"/schemas/0/properties/name/$ref":  "not found",
+		"/endpoints/0/parameters/0":        "parameter param-limit does not exist",
+		"/endpoints/0/requestBody":         "request body body-pet does not exist",
+		"/endpoints/0/responses/404":       "response response-missing does not exist",
+		"/endpoints/0/security/0/apiKey":   "security scheme apiKey does not exist",
+	}
+	if len(got) != len(want) {
+		t.Errorf("errors = %v, want %d", got, len(want))
+	}
+	for path, message := range want {
+		if !strings.Contains(got[path], message) {
+			t.Errorf("%s: %q, want it to mention %q", path, got[path], message)
+		}
+	}
+}
+
+// TestSchemaMapKeepsZeroLimits keeps limits that are set to zero, as
+// maxItems: 0 only allows the empty array
+func TestSchemaMapKeepsZeroLimits(t *testing.T) {
+	zero := 0
+	schema := domain.Schema{ID: "tags", Name: "Tags", Type: "array", MinItems: &zero, MaxItems: &zero}
+
+	want := map[string]interface{}{"type": "array", "minItems": 0.0, "maxItems": 0.0}
+	if got := SchemaMap(schema); !reflect.DeepEqual(got, want) {
+		t.Errorf("SchemaMap = %v, want %v", got, want)
+	}
+}
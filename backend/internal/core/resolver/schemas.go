@@ -0,0 +1,359 @@
+package resolver
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// subschemaMapKeywords hold a map of names to schemas
+var subschemaMapKeywords = []string{"properties", "patternProperties", "$defs", "definitions", "dependentSchemas"}
+
+// subschemaListKeywords hold a list of schemas
+var subschemaListKeywords = []string{"allOf", "oneOf", "anyOf", "prefixItems"}
+
+// subschemaKeywords hold a single schema
+var subschemaKeywords = []string{
+	"items", "additionalProperties", "unevaluatedProperties", "unevaluatedItems",
+	"not", "if", "then", "else", "contains", "propertyNames",
+}
+
+// SchemaResolver resolves the schema references used inside a normalized API
+// definition. A reference may be a Schema ID, as produced by the converter,
+// or a components/definitions pointer, optionally followed by a pointer into
+// that schema.
+type SchemaResolver struct {
+	schemas map[string]map[string]interface{}
+	byName  map[string]string
+}
+
+// NewSchemaResolver indexes the schemas of a definition
+func NewSchemaResolver(api *domain.APIDefinition) *SchemaResolver {
+	r := &SchemaResolver{
+		schemas: make(map[string]map[string]interface{}, len(api.Schemas)),
+		byName:  make(map[string]string, len(api.Schemas)),
+	}
+
+	for _, schema := range api.Schemas {
+		r.schemas[schema.ID] = SchemaMap(schema)
+		r.byName[schema.Name] = schema.ID
+	}
+
+	return r
+}
+
+// SchemaMap returns the JSON Schema object form of a normalized schema
+func SchemaMap(schema domain.Schema) map[string]interface{} {
+	var raw map[string]interface{}
+	data, _ := json.Marshal(schema)
+	_ = json.Unmarshal(data, &raw)
+
+	delete(raw, "id")
+	delete(raw, "name")
+	if raw["type"] == "" {
+		delete(raw, "type")
+	}
+
+	// A numeric exclusive bound takes the keyword, as in JSON Schema 2020-12
+	for _, keyword := range []string{"exclusiveMinimum", "exclusiveMaximum"} {
+		if bound, ok := raw[keyword+"Value"]; ok {
+			raw[keyword] = bound
+			delete(raw, keyword+"Value")
+		}
+	}
+
+	// Several types are written as a type list, as in JSON Schema
+	if types, ok := raw["types"]; ok {
+		raw["type"] = types
+		delete(raw, "types")
+	}
+
+	// Vendor extensions sit next to the keywords, as in the source document
+	if extensions, ok := raw["extensions"].(map[string]interface{}); ok {
+		for key, value := range extensions {
+			raw[key] = value
+		}
+	}
+	delete(raw, "extensions")
+
+	return raw
+}
+
+// Lookup returns the schema a reference designates
+func (r *SchemaResolver) Lookup(ref string) (interface{}, error) {
+	name, pointer, _ := strings.Cut(ref, "#")
+
+	switch {
+	case name == "" && strings.HasPrefix(pointer, "/components/schemas/"):
+		name, pointer = splitComponentPointer(strings.TrimPrefix(pointer, "/components/schemas/"))
+		name = r.byName[name]
+	case name == "" && strings.HasPrefix(pointer, "/definitions/"):
+		name, pointer = splitComponentPointer(strings.TrimPrefix(pointer, "/definitions/"))
+		name = r.byName[name]
+	}
+
+	schema, ok := r.schemas[name]
+	if !ok {
+		return nil, &RefError{Ref: ref, Err: ErrNotFound}
+	}
+
+	value, err := Get(schema, pointer)
+	if err != nil {
+		return nil, &RefError{Ref: ref, Err: fmt.Errorf("%w: %v", ErrNotFound, err)}
+	}
+	return value, nil
+}
+
+// Expand returns a copy of schema with every reference replaced by its
+// target. A reference back to a schema that is already being expanded is
+// left in place, so recursive schemas stay finite.
+func (r *SchemaResolver) Expand(schema interface{}) (interface{}, error) {
+	return r.expand(schema, map[string]bool{})
+}
+
+func (r *SchemaResolver) expand(schema interface{}, active map[string]bool) (interface{}, error) {
+	if ref, ok := schema.(string); ok {
+		schema = map[string]interface{}{"$ref": ref}
+	}
+
+	object, ok := schema.(map[string]interface{})
+	if !ok {
+		return schema, nil
+	}
+
+	if ref, ok := object["$ref"].(string); ok {
+		if active[ref] {
+			return map[string]interface{}{"$ref": ref}, nil
+		}
+
+		target, err := r.Lookup(ref)
+		if err != nil {
+			return nil, err
+		}
+
+		active[ref] = true
+		expanded, err := r.expand(target, active)
+		delete(active, ref)
+		return expanded, err
+	}
+
+	result := make(map[string]interface{}, len(object))
+	for key, value := range object {
+		result[key] = value
+	}
+
+	err := forEachSubschema(result, func(value interface{}, set func(interface{})) error {
+		expanded, err := r.expand(value, active)
+		if err != nil {
+			return err
+		}
+		set(expanded)
+		return nil
+	})
+
+	return result, err
+}
+
+// Check reports every schema reference in the definition that does not
+// resolve, and every endpoint link to a missing parameter, request body,
+// response or security scheme
+func (r *SchemaResolver) Check(api *domain.APIDefinition) []domain.ValidationError {
+	var errs []domain.ValidationError
+
+	checkSchema := func(value interface{}, pointer string) {
+		walkSchemaRefs(value, pointer, func(at, ref string) {
+			if _, err := r.Lookup(ref); err != nil {
+				errs = append(errs, domain.ValidationError{
+					Path:    at,
+					Message: err.Error(),
+					Keyword: "$ref",
+					Params:  map[string]interface{}{"ref": ref},
+				})
+			}
+		})
+	}
+
+	checkContent := func(content map[string]domain.MediaType, pointer string) {
+		for mediaType, media := range content {
+			if media.Schema != nil {
+				checkSchema(media.Schema, JoinPointer(pointer, "content", mediaType, "schema"))
+			}
+		}
+	}
+
+	for i, schema := range api.Schemas {
+		checkSchema(r.schemas[schema.ID], JoinPointer("/schemas", fmt.Sprint(i)))
+	}
+
+	parameters := make(map[string]bool, len(api.Parameters))
+	for i, param := range api.Parameters {
+		parameters[param.ID] = true
+		pointer := JoinPointer("/parameters", fmt.Sprint(i))
+		if param.Schema != nil {
+			checkSchema(param.Schema, JoinPointer(pointer, "schema"))
+		}
+		checkContent(param.Content, pointer)
+	}
+
+	responses := make(map[string]bool, len(api.Responses))
+	for i, response := range api.Responses {
+		responses[response.ID] = true
+		pointer := JoinPointer("/responses", fmt.Sprint(i))
+		checkContent(response.Content, pointer)
+		for name, header := range response.Headers {
+			if headerObject, ok := header.(map[string]interface{}); ok && headerObject["schema"] != nil {
+				checkSchema(headerObject["schema"], JoinPointer(pointer, "headers", name, "schema"))
+			}
+		}
+	}
+
+	requestBodies := make(map[string]bool, len(api.RequestBodies))
+	for i, body := range api.RequestBodies {
+		requestBodies[body.ID] = true
+		checkContent(body.Content, JoinPointer("/requestBodies", fmt.Sprint(i)))
+	}
+
+	schemes := make(map[string]bool, len(api.SecuritySchemes))
+	for _, scheme := range api.SecuritySchemes {
+		schemes[scheme.ID] = true
+	}
+
+	missing := func(pointer, kind, id string) {
+		errs = append(errs, domain.ValidationError{
+			Path:    pointer,
+			Message: fmt.Sprintf("%s %s does not exist", kind, id),
+			Keyword: "$ref",
+			Params:  map[string]interface{}{"ref": id},
+		})
+	}
+
+	checkEndpoints := func(endpoints []domain.Endpoint, collection string) {
+		for i, endpoint := range endpoints {
+			pointer := JoinPointer(collection, fmt.Sprint(i))
+			for j, id := range endpoint.Parameters {
+				if !parameters[id] {
+					missing(JoinPointer(pointer, "parameters", fmt.Sprint(j)), "parameter", id)
+				}
+			}
+			if endpoint.RequestBody != "" && !requestBodies[endpoint.RequestBody] {
+				missing(JoinPointer(pointer, "requestBody"), "request body", endpoint.RequestBody)
+			}
+			for code, id := range endpoint.Responses {
+				if !responses[id] {
+					missing(JoinPointer(pointer, "responses", code), "response", id)
+				}
+			}
+			for j, requirement := range endpoint.Security {
+				for id := range requirement {
+					if !schemes[id] {
+						missing(JoinPointer(pointer, "security", fmt.Sprint(j), id), "security scheme", id)
+					}
+				}
+			}
+		}
+	}
+	checkEndpoints(api.Endpoints, "/endpoints")
+	checkEndpoints(api.Webhooks, "/webhooks")
+
+	return errs
+}
+
+// walkSchemaRefs calls fn for every reference in a schema and its subschemas
+func walkSchemaRefs(schema interface{}, pointer string, fn func(pointer, ref string)) {
+	if ref, ok := schema.(string); ok {
+		fn(pointer, ref)
+		return
+	}
+
+	object, ok := schema.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	if ref, ok := object["$ref"].(string); ok {
+		fn(JoinPointer(pointer, "$ref"), ref)
+	}
+
+	for _, keyword := range subschemaMapKeywords {
+		if members, ok := object[keyword].(map[string]interface{}); ok {
+			for _, name := range sortedMapKeys(members) {
+				walkSchemaRefs(members[name], JoinPointer(pointer, keyword, name), fn)
+			}
+		}
+	}
+	for _, keyword := range subschemaListKeywords {
+		if members, ok := object[keyword].([]interface{}); ok {
+			for i, member := range members {
+				walkSchemaRefs(member, JoinPointer(pointer, keyword, fmt.Sprint(i)), fn)
+			}
+		}
+	}
+	for _, keyword := range subschemaKeywords {
+		if member, ok := object[keyword].(map[string]interface{}); ok {
+			walkSchemaRefs(member, JoinPointer(pointer, keyword), fn)
+		} else if ref, ok := object[keyword].(string); ok {
+			fn(JoinPointer(pointer, keyword), ref)
+		}
+	}
+}
+
+// forEachSubschema calls fn with every direct subschema of object and a
+// setter that replaces it
+func forEachSubschema(object map[string]interface{}, fn func(value interface{}, set func(interface{})) error) error {
+	for _, keyword := range subschemaMapKeywords {
+		members, ok := object[keyword].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		copied := make(map[string]interface{}, len(members))
+		for name, member := range members {
+			name := name
+			if err := fn(member, func(v interface{}) { copied[name] = v }); err != nil {
+				return err
+			}
+		}
+		object[keyword] = copied
+	}
+
+	for _, keyword := range subschemaListKeywords {
+		members, ok := object[keyword].([]interface{})
+		if !ok {
+			continue
+		}
+		copied := make([]interface{}, len(members))
+		for i, member := range members {
+			i := i
+			if err := fn(member, func(v interface{}) { copied[i] = v }); err != nil {
+				return err
+			}
+		}
+		object[keyword] = copied
+	}
+
+	for _, keyword := range subschemaKeywords {
+		member, ok := object[keyword]
+		if !ok {
+			continue
+		}
+		if _, isBool := member.(bool); isBool {
+			continue
+		}
+		keyword := keyword
+		if err := fn(member, func(v interface{}) { object[keyword] = v }); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// splitComponentPointer separates the escaped component name from the rest of a pointer
+func splitComponentPointer(rest string) (string, string) {
+	name, pointer, found := strings.Cut(rest, "/")
+	if found {
+		pointer = "/" + pointer
+	}
+	return UnescapeToken(name), pointer
+}
@@ -0,0 +1,106 @@
+package sdl
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/swagger-editor/backend/internal/core/codegen"
+)
+
+var (
+	// validName matches the names GraphQL allows
+	validName = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+	// invalidRun matches the characters a name may not contain
+	invalidRun = regexp.MustCompile(`[^_0-9A-Za-z]+`)
+)
+
+// builtinNames are the type names the generated schema declares itself
+var builtinNames = []string{"Query", "Mutation", "Subscription", "String", "Int", "Float", "Boolean", "ID", "JSON", "BigInt"}
+
+// isValid reports whether a name can be used as is. Names starting with
+// two underscores are reserved for introspection.
+func isValid(name string) bool {
+	return validName.MatchString(name) && !strings.HasPrefix(name, "__")
+}
+
+// typeName returns the GraphQL name for a type: the name itself when GraphQL
+// allows it, or else its words in PascalCase
+func typeName(name string) string {
+	if isValid(name) {
+		return name
+	}
+	return pascal(name)
+}
+
+// fieldName returns the GraphQL name for a field, argument or enum value:
+// the name itself when GraphQL allows it, or else its words in camelCase
+func fieldName(name string) string {
+	if isValid(name) {
+		return name
+	}
+	pascalName := pascal(name)
+	if pascalName == "_" {
+		return pascalName
+	}
+	runes := []rune(pascalName)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+// pascal joins the words of a name, such as "pet_id" or "/pets/{id}", as
+// PetId. A name that does not start with a letter gets a leading
+// underscore.
+func pascal(name string) string {
+	var b strings.Builder
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool {
+		return !(r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)))
+	}) {
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+
+	result := b.String()
+	if result == "" || !unicode.IsLetter(rune(result[0])) {
+		result = "_" + result
+	}
+	return result
+}
+
+// enumValue returns the GraphQL name for an enum value. true, false and
+// null cannot be enum values.
+func enumValue(value string) string {
+	name := value
+	if !isValid(name) {
+		name = strings.Trim(invalidRun.ReplaceAllString(name, "_"), "_")
+		if name == "" || !unicode.IsLetter(rune(name[0])) {
+			name = "_" + name
+		}
+	}
+	switch name {
+	case "true", "false", "null":
+		name = "_" + name
+	}
+	return name
+}
+
+// writeDescription writes a description above a definition, as a block
+// string when it spans several lines
+func writeDescription(b *strings.Builder, text string, indent string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+	if !strings.Contains(text, "\n") {
+		b.WriteString(indent + codegen.Quote(text) + "\n")
+		return
+	}
+
+	b.WriteString(indent + `"""` + "\n")
+	for _, line := range strings.Split(strings.ReplaceAll(text, `"""`, `\"""`), "\n") {
+		if line = strings.TrimRight(line, " \t\r"); line != "" {
+			b.WriteString(indent + line)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(indent + `"""` + "\n")
+}
@@ -0,0 +1,237 @@
+// Package sdl turns an API definition into a GraphQL schema, for gateways
+// that put GraphQL in front of REST services. Named schemas become object,
+// input and enum types, GET endpoints become queries and the endpoints that
+// change data become mutations. Every query and mutation records the
+// operation it stands for in an @http directive, and every argument the
+// parameter or body it fills in a @param directive.
+package sdl
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/codegen"
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// directives declares the directives that map fields to REST operations
+const directives = `"The REST operation a field stands for"
+directive @http(method: String!, path: String!) on FIELD_DEFINITION
+
+"The parameter, or request body when in is body, an argument fills"
+directive @param(in: String!, name: String!) on ARGUMENT_DEFINITION
+`
+
+// mutationMethods are the methods whose endpoints become mutations
+var mutationMethods = map[string]bool{"POST": true, "PUT": true, "PATCH": true, "DELETE": true}
+
+// Generator builds the GraphQL schema of one API definition
+type Generator struct {
+	api         *domain.APIDefinition
+	schemas     *codegen.Schemas
+	schemaNames map[string]string
+	parameters  map[string]domain.Parameter
+	bodies      map[string]domain.RequestBody
+	responses   map[string]domain.Response
+
+	types       codegen.Names
+	made        map[typeKey]string
+	enums       map[string]string
+	definitions map[string]string
+	scalars     map[string]string
+	depth       int
+}
+
+// New creates a generator for an API definition
+func New(api *domain.APIDefinition) *Generator {
+	g := &Generator{
+		api:         api,
+		schemas:     codegen.NewSchemas(api),
+		schemaNames: make(map[string]string, len(api.Schemas)),
+		parameters:  make(map[string]domain.Parameter, len(api.Parameters)),
+		bodies:      make(map[string]domain.RequestBody, len(api.RequestBodies)),
+		responses:   make(map[string]domain.Response, len(api.Responses)),
+		types:       make(codegen.Names),
+		made:        make(map[typeKey]string),
+		enums:       make(map[string]string),
+		definitions: make(map[string]string),
+		scalars:     make(map[string]string),
+	}
+
+	for _, name := range builtinNames {
+		g.types[name] = true
+	}
+	// Named schemas keep their own names; types made for inline schemas
+	// get the names left over
+	for _, schema := range api.Schemas {
+		g.schemaNames[schema.ID] = g.types.Unique(typeName(schema.Name))
+	}
+	for _, param := range api.Parameters {
+		g.parameters[param.ID] = param
+	}
+	for _, body := range api.RequestBodies {
+		g.bodies[body.ID] = body
+	}
+	for _, response := range api.Responses {
+		g.responses[response.ID] = response
+	}
+	return g
+}
+
+// Generate returns the schema in the GraphQL schema definition language,
+// checked with gqlparser. Every named schema gets a type, whether or not an
+// operation uses it.
+func (g *Generator) Generate() (string, error) {
+	for i := range g.api.Schemas {
+		g.namedType(&g.api.Schemas[i], false)
+	}
+
+	var queries, mutations []string
+	queryNames, mutationNames := make(codegen.Names), make(codegen.Names)
+	for _, endpoint := range g.api.Endpoints {
+		method := strings.ToUpper(endpoint.Method)
+		switch {
+		case method == "GET":
+			queries = append(queries, g.operation(endpoint, queryNames))
+		case mutationMethods[method]:
+			mutations = append(mutations, g.operation(endpoint, mutationNames))
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# GraphQL schema generated from %s %s\n\n", g.api.Metadata.Name, g.api.Metadata.Version)
+	b.WriteString(directives)
+	for _, name := range codegen.SortedKeys(g.scalars) {
+		b.WriteString("\n" + codegen.Quote(g.scalars[name]) + "\nscalar " + name + "\n")
+	}
+
+	// A schema needs a query type, even when the API has nothing to query
+	if len(queries) == 0 {
+		queries = append(queries, "  \"The API declares no GET operations\"\n  _empty: Boolean\n")
+	}
+	b.WriteString("\ntype Query {\n" + strings.Join(queries, "") + "}\n")
+	if len(mutations) > 0 {
+		b.WriteString("\ntype Mutation {\n" + strings.Join(mutations, "") + "}\n")
+	}
+	for _, name := range codegen.SortedKeys(g.definitions) {
+		b.WriteString("\n" + g.definitions[name])
+	}
+
+	sdl := b.String()
+	if _, err := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: sdl}); err != nil {
+		return "", fmt.Errorf("generated GraphQL schema is invalid: %w", err)
+	}
+	return sdl, nil
+}
+
+// operation writes the query or mutation field for an endpoint. Arguments
+// are the endpoint's parameters and, as input, its request body; the field
+// returns what the success response holds, or Boolean when it has no body.
+func (g *Generator) operation(endpoint domain.Endpoint, fields codegen.Names) string {
+	name := fields.Unique(operationName(endpoint))
+	hint := pascal(name)
+
+	var b strings.Builder
+	writeDescription(&b, codegen.Describe(endpoint.Summary, endpoint.Description), "  ")
+
+	var args []string
+	argNames := make(codegen.Names)
+	for _, id := range endpoint.Parameters {
+		param, ok := g.parameters[id]
+		if !ok {
+			continue
+		}
+		var arg strings.Builder
+		writeDescription(&arg, param.Description, "    ")
+
+		ref := g.typeRef(parameterSchema(param), hint+pascal(param.Name), true)
+		if param.Required || param.In == "path" {
+			ref += "!"
+		}
+		fmt.Fprintf(&arg, "    %s: %s @param(in: %s, name: %s)\n",
+			argNames.Unique(fieldName(param.Name)), ref, codegen.Quote(param.In), codegen.Quote(param.Name))
+		args = append(args, arg.String())
+	}
+
+	if body, ok := g.bodies[endpoint.RequestBody]; ok && endpoint.RequestBody != "" {
+		if mediaType, media, ok := codegen.PickMedia(body.Content); ok {
+			var arg strings.Builder
+			writeDescription(&arg, body.Description, "    ")
+
+			ref := g.jsonScalar()
+			if media.Schema != nil {
+				ref = g.typeRef(media.Schema, hint+"Input", true)
+			}
+			if body.Required {
+				ref += "!"
+			}
+			fmt.Fprintf(&arg, "    %s: %s @param(in: \"body\", name: %s)\n", argNames.Unique("input"), ref, codegen.Quote(mediaType))
+			args = append(args, arg.String())
+		}
+	}
+
+	b.WriteString("  " + name)
+	if len(args) > 0 {
+		b.WriteString("(\n" + strings.Join(args, "") + "  )")
+	}
+	b.WriteString(": " + g.result(endpoint, hint))
+	fmt.Fprintf(&b, " @http(method: %s, path: %s)", codegen.Quote(strings.ToUpper(endpoint.Method)), codegen.Quote(endpoint.Path))
+	if endpoint.Deprecated {
+		b.WriteString(" @deprecated")
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// result returns the type of the body of the success response: the lowest
+// 2xx code, a 2XX range or default
+func (g *Generator) result(endpoint domain.Endpoint, hint string) string {
+	id, ok := codegen.SuccessResponse(endpoint.Responses)
+	if !ok {
+		return "Boolean"
+	}
+	response := g.responses[id]
+	mediaType, media, ok := codegen.PickMedia(response.Content)
+	switch {
+	case !ok:
+		return "Boolean"
+	case media.Schema == nil:
+		if strings.HasPrefix(mediaType, "text/") {
+			return "String"
+		}
+		return g.jsonScalar()
+	}
+	return g.typeRef(media.Schema, hint+"Result", false)
+}
+
+// operationName returns the field name for an endpoint: its operationId, or
+// else the method and path, such as getPetsByPetId for GET /pets/{petId}
+func operationName(endpoint domain.Endpoint) string {
+	if endpoint.OperationID != "" {
+		return fieldName(endpoint.OperationID)
+	}
+
+	name := strings.ToLower(endpoint.Method)
+	for _, segment := range strings.Split(endpoint.Path, "/") {
+		if param, ok := strings.CutPrefix(segment, "{"); ok {
+			name += "By" + pascal(strings.TrimSuffix(param, "}"))
+		} else if segment != "" {
+			name += pascal(segment)
+		}
+	}
+	return fieldName(name)
+}
+
+// parameterSchema returns the schema of a parameter, from its content when
+// it has no schema of its own
+func parameterSchema(param domain.Parameter) interface{} {
+	if param.Schema != nil {
+		return param.Schema
+	}
+	if _, media, ok := codegen.PickMedia(param.Content); ok {
+		return media.Schema
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package sdl
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/swagger-editor/backend/internal/core/codegen/codegentest"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestGenerateMapsOperationsAndSchemas(t *testing.T) {
+	sdl, err := New(codegentest.PetStore()).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schema, err := gqlparser.LoadSchema(&ast.Source{Input: sdl})
+	if err != nil {
+		t.Fatalf("schema does not load: %v\n%s", err, sdl)
+	}
+
+	field := func(typeName, name string) *ast.FieldDefinition {
+		t.Helper()
+		definition := schema.Types[typeName]
+		if definition == nil {
+			t.Fatalf("type %s is missing:\n%s", typeName, sdl)
+		}
+		if field := definition.Fields.ForName(name); field != nil {
+			return field
+		}
+		t.Fatalf("field %s.%s is missing:\n%s", typeName, name, sdl)
+		return nil
+	}
+	typeOf := func(typeName, name string) string {
+		t.Helper()
+		return field(typeName, name).Type.String()
+	}
+
+	tests := []struct {
+		typeName, field, want string
+	}{
+		{"Query", "listPets", "[Pet!]"},
+		{"Query", "getPetsByPetId", "Pet"},
+		{"Mutation", "createPet", "Pet"},
+		{"Mutation", "deletePet", "Boolean"},
+		{"Pet", "id", "BigInt!"},
+		{"Pet", "name", "String!"},
+		{"Pet", "status", "PetStatus"},
+		{"Pet", "parent", "Pet"},
+		{"Pet", "owner", "Owner!"},
+		{"Pet", "tags", "[String!]"},
+		{"Pet", "metadata", "JSON"},
+		{"PetInput", "name", "String!"},
+		{"PetInput", "status", "PetStatus"},
+		{"PetInput", "parent", "PetInput"},
+		{"PetInput", "owner", "JSON!"},
+	}
+	for _, tt := range tests {
+		if got := typeOf(tt.typeName, tt.field); got != tt.want {
+			t.Errorf("%s.%s: %s, want %s", tt.typeName, tt.field, got, tt.want)
+		}
+	}
+
+	if schema.Types["PetInput"].Fields.ForName("id") != nil {
+		t.Error("PetInput has the readOnly id field")
+	}
+	if owner := schema.Types["Owner"]; owner.Kind != ast.Union || len(owner.Types) != 2 {
+		t.Errorf("Owner is a %s of %v, want a union of Person and Shelter", owner.Kind, owner.Types)
+	}
+	if values := schema.Types["PetStatus"].EnumValues; len(values) != 3 || values[2].Name != "on_hold" {
+		t.Errorf("PetStatus values are %v, want available, sold and on_hold", values)
+	}
+
+	getPet := field("Query", "getPetsByPetId")
+	if arg := getPet.Arguments.ForName("petId"); arg == nil || arg.Type.String() != "BigInt!" {
+		t.Errorf("getPetsByPetId arguments are %v, want petId: BigInt!", getPet.Arguments)
+	}
+	http := getPet.Directives.ForName("http")
+	if http == nil || http.Arguments.ForName("path").Value.Raw != "/pets/{petId}" {
+		t.Errorf("getPetsByPetId does not record its path in @http")
+	}
+	if input := field("Mutation", "createPet").Arguments.ForName("input"); input == nil || input.Type.String() != "PetInput!" {
+		t.Errorf("createPet does not take the body as input: PetInput!")
+	}
+	if field("Mutation", "deletePet").Directives.ForName("deprecated") == nil {
+		t.Error("deletePet is not deprecated")
+	}
+	if !strings.Contains(sdl, `"What the pet answers to"`) {
+		t.Error("property descriptions are missing")
+	}
+}
+
+func TestGenerateWithoutQueries(t *testing.T) {
+	api := codegentest.PetStore()
+	api.Endpoints = api.Endpoints[2:3]
+
+	sdl, err := New(api).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(sdl, "type Query {") {
+		t.Errorf("schema has no query type:\n%s", sdl)
+	}
+}
@@ -0,0 +1,256 @@
+package sdl
+
+import (
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/codegen"
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// Kinds of GraphQL type a schema maps to
+const (
+	kindObject = "object"
+	kindEnum   = "enum"
+	kindUnion  = "union"
+	kindList   = "list"
+	kindScalar = "scalar"
+	kindJSON   = "json"
+)
+
+// typeKey identifies the type made for a named schema, which may need an
+// object type and an input type of its own
+type typeKey struct {
+	id    string
+	input bool
+}
+
+// typeRef returns the GraphQL type for a schema, without the non-null mark,
+// making the types it needs. hint names the types made for inline schemas.
+func (g *Generator) typeRef(schema interface{}, hint string, input bool) string {
+	g.depth++
+	defer func() { g.depth-- }()
+	if g.depth > 4*codegen.MaxDepth {
+		return g.jsonScalar()
+	}
+
+	object, named := g.schemas.Resolve(schema)
+	if named != nil {
+		return g.namedType(named, input)
+	}
+	if object == nil {
+		return g.jsonScalar()
+	}
+
+	// allOf with a single member, and nothing else, often only adds a
+	// description to a reference
+	if members, ok := object["allOf"].([]interface{}); ok && len(members) == 1 && object["properties"] == nil {
+		return g.typeRef(members[0], hint, input)
+	}
+	return g.inlineType(object, hint, input)
+}
+
+// namedType returns the type made for a named schema, making it on first use
+func (g *Generator) namedType(schema *domain.Schema, input bool) string {
+	object := g.schemas.Map(schema)
+	base := g.schemaNames[schema.ID]
+
+	switch g.kind(object, input) {
+	case kindEnum:
+		input = false
+	case kindObject, kindUnion:
+	default:
+		return g.inlineType(object, base, input)
+	}
+
+	key := typeKey{schema.ID, input}
+	if name, ok := g.made[key]; ok {
+		return name
+	}
+	name := base
+	if input {
+		name = g.types.Unique(base + "Input")
+	}
+	g.made[key] = name
+	g.makeType(name, base, object, input)
+	return name
+}
+
+// inlineType returns the type for a schema declared in place, making a
+// type named after hint when it needs one
+func (g *Generator) inlineType(object map[string]interface{}, hint string, input bool) string {
+	switch g.kind(object, input) {
+	case kindEnum:
+		// Object and input types share the enums of their properties
+		key := hint + "\x00" + strings.Join(codegen.EnumValues(object), "\x00")
+		if name, ok := g.enums[key]; ok {
+			return name
+		}
+		name := g.types.Unique(hint)
+		g.enums[key] = name
+		g.makeType(name, hint, object, false)
+		return name
+	case kindObject, kindUnion:
+		name := hint
+		if input && !strings.HasSuffix(name, "Input") {
+			name += "Input"
+		}
+		name = g.types.Unique(name)
+		g.makeType(name, hint, object, input)
+		return name
+	case kindList:
+		items, ok := object["items"]
+		if !ok {
+			return "[" + g.jsonScalar() + "]"
+		}
+		item := g.typeRef(items, hint+"Item", input)
+		if resolved := g.schemas.Object(items); resolved != nil && !codegen.Nullable(resolved) {
+			item += "!"
+		}
+		return "[" + item + "]"
+	case kindScalar:
+		return g.scalar(object)
+	}
+	return g.jsonScalar()
+}
+
+// makeType writes the definition of an object, input, enum or union type
+func (g *Generator) makeType(name, base string, object map[string]interface{}, input bool) {
+	var b strings.Builder
+	description, _ := object["description"].(string)
+	if title, ok := object["title"].(string); ok && description == "" {
+		description = title
+	}
+	writeDescription(&b, description, "")
+
+	switch g.kind(object, input) {
+	case kindEnum:
+		b.WriteString("enum " + name + " {\n")
+		values := make(codegen.Names)
+		for _, value := range codegen.EnumValues(object) {
+			b.WriteString("  " + values.Unique(enumValue(value)) + "\n")
+		}
+		b.WriteString("}\n")
+	case kindUnion:
+		b.WriteString("union " + name + " = " + strings.Join(g.unionMembers(object, base), " | ") + "\n")
+	default:
+		keyword := "type"
+		if input {
+			keyword = "input"
+		}
+		b.WriteString(keyword + " " + name + " {\n")
+		g.writeFields(&b, base, object, input)
+		b.WriteString("}\n")
+	}
+
+	g.definitions[name] = b.String()
+}
+
+// writeFields writes a field for each property an object has in the given
+// direction: readOnly properties are left out of input types, writeOnly
+// ones out of object types
+func (g *Generator) writeFields(b *strings.Builder, base string, object map[string]interface{}, input bool) {
+	properties, required := g.properties(object, input)
+	fields := make(codegen.Names)
+
+	for _, name := range codegen.SortedKeys(properties) {
+		property := properties[name]
+		resolved := g.schemas.Object(property)
+
+		description, _ := property["description"].(string)
+		writeDescription(b, description, "  ")
+
+		ref := g.typeRef(property, base+pascal(name), input)
+		if required[name] && resolved != nil && !codegen.Nullable(resolved) {
+			ref += "!"
+		}
+		b.WriteString("  " + fields.Unique(fieldName(name)) + ": " + ref)
+		if !input && (property["deprecated"] == true || resolved["deprecated"] == true) {
+			b.WriteString(" @deprecated")
+		}
+		b.WriteString("\n")
+	}
+}
+
+// unionMembers returns the object types of the branches of a oneOf or anyOf
+func (g *Generator) unionMembers(object map[string]interface{}, base string) []string {
+	var members []string
+	seen := make(map[string]bool)
+	for i, branch := range codegen.Branches(object) {
+		member := g.typeRef(branch, base+"Option"+pascal(string(rune('A'+i%26))), false)
+		if !seen[member] {
+			seen[member] = true
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+// kind decides what GraphQL type a schema maps to. Objects without any
+// property for the direction, maps and schemas that mix types are JSON; so
+// are oneOf and anyOf in input types, as GraphQL has no input unions.
+func (g *Generator) kind(object map[string]interface{}, input bool) string {
+	if len(codegen.EnumValues(object)) > 0 {
+		return kindEnum
+	}
+	if branches := codegen.Branches(object); len(branches) > 0 {
+		if input {
+			return kindJSON
+		}
+		for _, branch := range branches {
+			resolved := g.schemas.Object(branch)
+			if resolved == nil || g.kind(resolved, false) != kindObject {
+				return kindJSON
+			}
+		}
+		return kindUnion
+	}
+
+	switch codegen.SchemaType(object) {
+	case "object", "":
+		if properties, _ := g.properties(object, input); len(properties) > 0 {
+			return kindObject
+		}
+		return kindJSON
+	case "array":
+		return kindList
+	case "string", "integer", "number", "boolean":
+		return kindScalar
+	}
+	return kindJSON
+}
+
+// properties returns the properties an object has in the given direction,
+// with those of its allOf members, and which of them are required
+func (g *Generator) properties(object map[string]interface{}, input bool) (map[string]map[string]interface{}, map[string]bool) {
+	properties, required := g.schemas.Properties(object)
+	for name, property := range properties {
+		resolved := g.schemas.Object(property)
+		if resolved == nil || (input && resolved["readOnly"] == true) || (!input && resolved["writeOnly"] == true) {
+			delete(properties, name)
+		}
+	}
+	return properties, required
+}
+
+// scalar returns the built-in scalar for a primitive schema. 64-bit
+// integers do not fit Int, so they get the BigInt scalar.
+func (g *Generator) scalar(object map[string]interface{}) string {
+	switch codegen.SchemaType(object) {
+	case "integer":
+		if object["format"] == "int64" {
+			g.scalars["BigInt"] = "64-bit integers, which do not fit Int"
+			return "BigInt"
+		}
+		return "Int"
+	case "number":
+		return "Float"
+	case "boolean":
+		return "Boolean"
+	}
+	return "String"
+}
+
+func (g *Generator) jsonScalar() string {
+	g.scalars["JSON"] = "Any JSON value, for schemas that have no GraphQL type"
+	return "JSON"
+}
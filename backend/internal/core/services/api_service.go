@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,23 +17,27 @@ type APIService struct {
 	repo      ports.APIRepository
 	converter ports.ConverterService
 	validator ports.ValidatorService
+	events    ports.EventBus
 }
 
-// NewAPIService creates a new API service
+// NewAPIService creates a new API service. Every successful save, delete
+// and validation of a stored definition is published to events.
 func NewAPIService(
 	repo ports.APIRepository,
 	converter ports.ConverterService,
 	validator ports.ValidatorService,
+	events ports.EventBus,
 ) *APIService {
 	return &APIService{
 		repo:      repo,
 		converter: converter,
 		validator: validator,
+		events:    events,
 	}
 }
 
-// CreateAPIDefinition creates a new API definition
-func (s *APIService) CreateAPIDefinition(ctx context.Context, api *domain.APIDefinition) (*domain.APIDefinition, error) {
+// CreateAPIDefinition creates a new API definition as its first revision
+func (s *APIService) CreateAPIDefinition(ctx context.Context, api *domain.APIDefinition, change domain.Change) (*domain.APIDefinition, error) {
 	if api == nil {
 		return nil, errors.New("api definition is required")
 	}
@@ -44,12 +49,21 @@ func (s *APIService) CreateAPIDefinition(ctx context.Context, api *domain.APIDef
 	}
 
 	if !validationResult.Valid {
-		return nil, fmt.Errorf("api definition is invalid: %v", validationResult.Errors)
+		return nil, fmt.Errorf("api definition is %w: %v", domain.ErrInvalid, validationResult.Errors)
 	}
 
-	// Generate ID if not provided
+	// Generate ID if not provided; a given ID must not be taken, or the save
+	// would become a revision of another definition
 	if api.ID == "" {
 		api.ID = uuid.New().String()
+	} else {
+		exists, err := s.repo.ExistsByID(ctx, api.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check api definition: %w", err)
+		}
+		if exists {
+			return nil, fmt.Errorf("api definition %w: %s", domain.ErrConflict, api.ID)
+		}
 	}
 
 	// Set timestamps
@@ -58,10 +72,12 @@ func (s *APIService) CreateAPIDefinition(ctx context.Context, api *domain.APIDef
 	api.UpdatedAt = now
 
 	// Save to repository
-	if err := s.repo.Save(ctx, api); err != nil {
+	revision := newRevision(change, "Create definition", now)
+	if err := s.repo.SaveRevision(ctx, api, revision); err != nil {
 		return nil, fmt.Errorf("failed to save api definition: %w", err)
 	}
 
+	s.publishSave(ctx, domain.DefinitionCreated, api, revision)
 	return api, nil
 }
 
@@ -77,7 +93,7 @@ func (s *APIService) GetAPIDefinition(ctx context.Context, id string) (*domain.A
 	}
 
 	if api == nil {
-		return nil, fmt.Errorf("api definition not found: %s", id)
+		return nil, fmt.Errorf("api definition %w: %s", domain.ErrNotFound, id)
 	}
 
 	return api, nil
@@ -93,8 +109,41 @@ func (s *APIService) ListAPIDefinitions(ctx context.Context) ([]*domain.APIDefin
 	return apis, nil
 }
 
-// UpdateAPIDefinition updates an existing API definition
-func (s *APIService) UpdateAPIDefinition(ctx context.Context, id string, api *domain.APIDefinition) (*domain.APIDefinition, error) {
+// FindByExtension lists the API definitions that set the given x- extension anywhere
+func (s *APIService) FindByExtension(ctx context.Context, key string) ([]*domain.APIDefinition, error) {
+	if !strings.HasPrefix(key, "x-") {
+		return nil, fmt.Errorf("extension key must start with x-: %s", key)
+	}
+
+	apis, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api definitions: %w", err)
+	}
+
+	matches := []*domain.APIDefinition{}
+	for _, api := range apis {
+		if len(collectExtensions(api, key)) > 0 {
+			matches = append(matches, api)
+		}
+	}
+
+	return matches, nil
+}
+
+// GetExtensions lists the x- extensions of an API definition, limited to one
+// key when key is not empty
+func (s *APIService) GetExtensions(ctx context.Context, id string, key string) ([]domain.ExtensionUsage, error) {
+	api, err := s.GetAPIDefinition(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExtensions(api, key), nil
+}
+
+// UpdateAPIDefinition updates an existing API definition, recording the new
+// state as a revision
+func (s *APIService) UpdateAPIDefinition(ctx context.Context, id string, api *domain.APIDefinition, change domain.Change) (*domain.APIDefinition, error) {
 	if id == "" {
 		return nil, errors.New("id is required")
 	}
@@ -110,7 +159,7 @@ func (s *APIService) UpdateAPIDefinition(ctx context.Context, id string, api *do
 	}
 
 	if existing == nil {
-		return nil, fmt.Errorf("api definition not found: %s", id)
+		return nil, fmt.Errorf("api definition %w: %s", domain.ErrNotFound, id)
 	}
 
 	// Validate the updated API definition
@@ -120,7 +169,7 @@ func (s *APIService) UpdateAPIDefinition(ctx context.Context, id string, api *do
 	}
 
 	if !validationResult.Valid {
-		return nil, fmt.Errorf("api definition is invalid: %v", validationResult.Errors)
+		return nil, fmt.Errorf("api definition is %w: %v", domain.ErrInvalid, validationResult.Errors)
 	}
 
 	// Preserve original ID and creation time
@@ -129,13 +178,108 @@ func (s *APIService) UpdateAPIDefinition(ctx context.Context, id string, api *do
 	api.UpdatedAt = time.Now()
 
 	// Update in repository
-	if err := s.repo.Update(ctx, api); err != nil {
+	revision := newRevision(change, "Update definition", api.UpdatedAt)
+	if err := s.repo.SaveRevision(ctx, api, revision); err != nil {
 		return nil, fmt.Errorf("failed to update api definition: %w", err)
 	}
 
+	s.publishSave(ctx, domain.DefinitionUpdated, api, revision)
 	return api, nil
 }
 
+// ListRevisions lists the revisions of an API definition, oldest first
+func (s *APIService) ListRevisions(ctx context.Context, id string) ([]*domain.Revision, error) {
+	if _, err := s.GetAPIDefinition(ctx, id); err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.repo.ListRevisions(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+
+	return revisions, nil
+}
+
+// GetRevision retrieves an API definition as it was at a revision
+func (s *APIService) GetRevision(ctx context.Context, id string, number int) (*domain.APIDefinition, error) {
+	revision, err := s.findRevision(ctx, id, number)
+	if err != nil {
+		return nil, err
+	}
+
+	return revision.Definition, nil
+}
+
+// RestoreRevision makes an old revision the current state of an API
+// definition. History is kept: the restored state is saved as a new revision.
+func (s *APIService) RestoreRevision(ctx context.Context, id string, number int, change domain.Change) (*domain.APIDefinition, error) {
+	existing, err := s.GetAPIDefinition(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := s.findRevision(ctx, id, number)
+	if err != nil {
+		return nil, err
+	}
+
+	api := revision.Definition
+	api.ID = id
+	api.CreatedAt = existing.CreatedAt
+	api.UpdatedAt = time.Now()
+
+	restore := newRevision(change, fmt.Sprintf("Restore revision %d", number), api.UpdatedAt)
+	restore.RestoredFrom = number
+	if err := s.repo.SaveRevision(ctx, api, restore); err != nil {
+		return nil, fmt.Errorf("failed to restore revision: %w", err)
+	}
+
+	s.publishSave(ctx, domain.DefinitionUpdated, api, restore)
+	return api, nil
+}
+
+func (s *APIService) findRevision(ctx context.Context, id string, number int) (*domain.Revision, error) {
+	if id == "" {
+		return nil, errors.New("id is required")
+	}
+
+	revision, err := s.repo.FindRevision(ctx, id, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get revision: %w", err)
+	}
+
+	if revision == nil || revision.Definition == nil {
+		return nil, fmt.Errorf("revision %d of api definition %s %w", number, id, domain.ErrNotFound)
+	}
+
+	return revision, nil
+}
+
+// newRevision describes a save, with message used when the change has none
+func newRevision(change domain.Change, message string, at time.Time) *domain.Revision {
+	if change.Message != "" {
+		message = change.Message
+	}
+	return &domain.Revision{
+		Author:    change.Author,
+		Message:   message,
+		CreatedAt: at,
+	}
+}
+
+// publishSave lets subscribers know that a definition was saved as a revision
+func (s *APIService) publishSave(ctx context.Context, eventType domain.DefinitionEventType, api *domain.APIDefinition, revision *domain.Revision) {
+	s.events.Publish(ctx, domain.DefinitionEvent{
+		Type:         eventType,
+		DefinitionID: api.ID,
+		Revision:     revision.Number,
+		Author:       revision.Author,
+		Message:      revision.Message,
+		OccurredAt:   revision.CreatedAt,
+	})
+}
+
 // DeleteAPIDefinition deletes an API definition
 func (s *APIService) DeleteAPIDefinition(ctx context.Context, id string) error {
 	if id == "" {
@@ -149,7 +293,7 @@ func (s *APIService) DeleteAPIDefinition(ctx context.Context, id string) error {
 	}
 
 	if !exists {
-		return fmt.Errorf("api definition not found: %s", id)
+		return fmt.Errorf("api definition %w: %s", domain.ErrNotFound, id)
 	}
 
 	// Delete from repository
@@ -157,17 +301,22 @@ func (s *APIService) DeleteAPIDefinition(ctx context.Context, id string) error {
 		return fmt.Errorf("failed to delete api definition: %w", err)
 	}
 
+	s.events.Publish(ctx, domain.DefinitionEvent{
+		Type:         domain.DefinitionDeleted,
+		DefinitionID: id,
+		OccurredAt:   time.Now(),
+	})
 	return nil
 }
 
 // ImportSwagger imports a Swagger/OpenAPI specification
-func (s *APIService) ImportSwagger(ctx context.Context, content string) (*domain.APIDefinition, error) {
+func (s *APIService) ImportSwagger(ctx context.Context, content string, files map[string]string, change domain.Change) (*domain.APIDefinition, error) {
 	if content == "" {
 		return nil, errors.New("swagger content is required")
 	}
 
 	// Validate the Swagger content
-	validationResult, err := s.validator.ValidateSwagger(ctx, content)
+	validationResult, err := s.validator.ValidateSwaggerBundle(ctx, content, files)
 	if err != nil {
 		return nil, fmt.Errorf("swagger validation failed: %w", err)
 	}
@@ -179,6 +328,7 @@ func (s *APIService) ImportSwagger(ctx context.Context, content string) (*domain
 	// Convert to normalized API definition
 	conversionRequest := &domain.ConversionRequest{
 		SwaggerContent: content,
+		Files:          files,
 		Format:         "yaml", // Auto-detect format
 	}
 
@@ -196,13 +346,17 @@ func (s *APIService) ImportSwagger(ctx context.Context, content string) (*domain
 	}
 
 	// Create the API definition
-	return s.CreateAPIDefinition(ctx, conversionResult.Data)
+	if change.Message == "" {
+		change.Message = "Import specification"
+	}
+	return s.CreateAPIDefinition(ctx, conversionResult.Data, change)
 }
 
-// ExportSwagger exports an API definition as Swagger/OpenAPI
-func (s *APIService) ExportSwagger(ctx context.Context, id string, format string) (string, error) {
+// ExportSwagger exports an API definition as Swagger/OpenAPI, with warnings
+// for the keywords the target version could not keep
+func (s *APIService) ExportSwagger(ctx context.Context, id string, format string, version string) (string, []domain.Warning, error) {
 	if id == "" {
-		return "", errors.New("id is required")
+		return "", nil, errors.New("id is required")
 	}
 
 	// Validate format
@@ -213,14 +367,38 @@ func (s *APIService) ExportSwagger(ctx context.Context, id string, format string
 	// Get the API definition
 	api, err := s.GetAPIDefinition(ctx, id)
 	if err != nil {
-		return "", fmt.Errorf("failed to get api definition: %w", err)
+		return "", nil, fmt.Errorf("failed to get api definition: %w", err)
 	}
 
 	// Convert to Swagger
-	swagger, err := s.converter.ConvertJSONToSwagger(ctx, api, format)
+	swagger, warnings, err := s.converter.ConvertJSONToSwagger(ctx, api, format, version)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to convert to swagger: %w", err)
+	}
+
+	return swagger, warnings, nil
+}
+
+// ValidateStored validates a stored API definition and publishes the result
+func (s *APIService) ValidateStored(ctx context.Context, id string) (*domain.ValidationResponse, error) {
+	api, err := s.GetAPIDefinition(ctx, id)
 	if err != nil {
-		return "", fmt.Errorf("failed to convert to swagger: %w", err)
+		return nil, err
 	}
 
-	return swagger, nil
-}
\ No newline at end of file
+	result, err := s.validator.ValidateAPIDefinition(ctx, api)
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	valid := result.Valid
+	s.events.Publish(ctx, domain.DefinitionEvent{
+		Type:         domain.DefinitionValidated,
+		DefinitionID: id,
+		Revision:     api.Revision,
+		Valid:        &valid,
+		Errors:       result.Errors,
+		OccurredAt:   time.Now(),
+	})
+	return result, nil
+}
@@ -0,0 +1,211 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/swagger-editor/backend/internal/adapters/secondary/events"
+	"github.com/swagger-editor/backend/internal/adapters/secondary/repository"
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// traceSpec has an operation for each method OpenAPI allows, trace included
+const traceSpec = `
+openapi: 3.0.3
+info:
+  title: Diagnostics
+  version: 1.0.0
+paths:
+  /echo:
+    get:
+      operationId: getEcho
+      responses:
+        "200":
+          description: The echo
+    trace:
+      operationId: traceEcho
+      responses:
+        "200":
+          description: The request as received
+          content:
+            message/http:
+              schema:
+                type: string
+`
+
+func newTestAPIService() *APIService {
+	converter := &ConverterService{}
+	repo := repository.NewInMemoryAPIRepository()
+	return NewAPIService(repo, converter, NewValidatorService(repo), events.NewInMemoryEventBus())
+}
+
+// TestImportTraceOperation imports a trace operation, finds it stored and
+// exports it again
+func TestImportTraceOperation(t *testing.T) {
+	ctx := context.Background()
+	service := newTestAPIService()
+
+	api, err := service.ImportSwagger(ctx, traceSpec, nil, domain.Change{})
+	if err != nil {
+		t.Fatalf("import failed: %v", err)
+	}
+
+	stored, err := service.GetAPIDefinition(ctx, api.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var methods []string
+	for _, endpoint := range stored.Endpoints {
+		methods = append(methods, endpoint.Method)
+	}
+	if strings.Join(methods, ",") != "GET,TRACE" {
+		t.Errorf("methods = %v, want GET and TRACE", methods)
+	}
+
+	exported := exportYAML(t, stored, "")
+	echo, _ := getMap(exported, "paths")["/echo"].(map[string]interface{})
+	if trace, _ := echo["trace"].(map[string]interface{}); trace["operationId"] != "traceEcho" {
+		t.Errorf("exported /echo = %v, want the traceEcho operation", echo)
+	}
+}
+
+// TestImportKeepsDefinitionsApart imports two unrelated specifications and
+// finds both, each with an ID of its own and a single revision
+func TestImportKeepsDefinitionsApart(t *testing.T) {
+	ctx := context.Background()
+	service := newTestAPIService()
+
+	first, err := service.ImportSwagger(ctx, traceSpec, nil, domain.Change{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := service.ImportSwagger(ctx, strings.Replace(traceSpec, "Diagnostics", "Echo", 1), nil, domain.Change{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.ID == "" || first.ID == second.ID {
+		t.Fatalf("imports got IDs %q and %q, want two different IDs", first.ID, second.ID)
+	}
+
+	all, err := service.ListAPIDefinitions(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 {
+		t.Fatalf("%d definitions stored, want 2", len(all))
+	}
+	for id, name := range map[string]string{first.ID: "Diagnostics", second.ID: "Echo"} {
+		api, err := service.GetAPIDefinition(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if api.Metadata.Name != name || api.Revision != 1 {
+			t.Errorf("definition %s is %s at revision %d, want %s at revision 1", id, api.Metadata.Name, api.Revision, name)
+		}
+	}
+}
+
+// newTestDefinition builds a definition the validator accepts
+func newTestDefinition(id, name string) *domain.APIDefinition {
+	return &domain.APIDefinition{
+		ID:       id,
+		Metadata: domain.APIMetadata{Name: name, Version: "1.0.0"},
+		Endpoints: []domain.Endpoint{{
+			ID: "endpoint-listpets", Path: "/pets", Method: "GET",
+			Responses: map[string]string{"200": "response-ok"},
+		}},
+		Responses: []domain.Response{{ID: "response-ok", Description: "OK"}},
+	}
+}
+
+// TestCreateRejectsTakenID creates a definition with the ID of another and
+// gets a conflict, leaving the other's history alone
+func TestCreateRejectsTakenID(t *testing.T) {
+	ctx := context.Background()
+	service := newTestAPIService()
+
+	original, err := service.CreateAPIDefinition(ctx, newTestDefinition("pets", "Pets"), domain.Change{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	createdAt := original.CreatedAt
+
+	_, err = service.CreateAPIDefinition(ctx, newTestDefinition("pets", "Other"), domain.Change{})
+	if !errors.Is(err, domain.ErrConflict) {
+		t.Fatalf("second create: %v, want a conflict", err)
+	}
+
+	stored, err := service.GetAPIDefinition(ctx, "pets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Metadata.Name != "Pets" || !stored.CreatedAt.Equal(createdAt) {
+		t.Errorf("stored definition is %s created at %s, want the original", stored.Metadata.Name, stored.CreatedAt)
+	}
+	if revisions, _ := service.ListRevisions(ctx, "pets"); len(revisions) != 1 {
+		t.Errorf("%d revisions, want only the first create", len(revisions))
+	}
+}
+
+// TestRevisionsListFetchAndRestore records three revisions, reads an old
+// one back and restores it as a fourth
+func TestRevisionsListFetchAndRestore(t *testing.T) {
+	ctx := context.Background()
+	service := newTestAPIService()
+
+	api, err := service.CreateAPIDefinition(ctx, newTestDefinition("", "Pets"), domain.Change{Author: "ann"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := api.ID
+	for _, name := range []string{"Pet Store", "Pet Shop"} {
+		if _, err := service.UpdateAPIDefinition(ctx, id, newTestDefinition(id, name), domain.Change{Author: "bob", Message: "Rename to " + name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	revisions, err := service.ListRevisions(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var messages []string
+	for i, revision := range revisions {
+		if revision.Number != i+1 {
+			t.Errorf("revision %d is numbered %d", i+1, revision.Number)
+		}
+		messages = append(messages, revision.Author+": "+revision.Message)
+	}
+	want := "ann: Create definition, bob: Rename to Pet Store, bob: Rename to Pet Shop"
+	if got := strings.Join(messages, ", "); got != want {
+		t.Errorf("revisions = %s, want %s", got, want)
+	}
+
+	old, err := service.GetRevision(ctx, id, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if old.Metadata.Name != "Pet Store" || old.Revision != 2 {
+		t.Errorf("revision 2 is %s at revision %d, want Pet Store at 2", old.Metadata.Name, old.Revision)
+	}
+	if _, err := service.GetRevision(ctx, id, 9); err == nil {
+		t.Error("revision 9 was found, want an error")
+	}
+
+	restored, err := service.RestoreRevision(ctx, id, 1, domain.Change{Author: "cy"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.Metadata.Name != "Pets" || restored.Revision != 4 || !restored.CreatedAt.Equal(api.CreatedAt) {
+		t.Errorf("restored definition is %s at revision %d created %s, want Pets at 4 created %s",
+			restored.Metadata.Name, restored.Revision, restored.CreatedAt, api.CreatedAt)
+	}
+	revisions, _ = service.ListRevisions(ctx, id)
+	if last := revisions[len(revisions)-1]; last.RestoredFrom != 1 || last.Message != "Restore revision 1" || last.Author != "cy" {
+		t.Errorf("last revision = %+v, want cy's restore of revision 1", last)
+	}
+	if current, _ := service.GetAPIDefinition(ctx, id); current.Metadata.Name != "Pets" {
+		t.Errorf("current definition is %s, want Pets", current.Metadata.Name)
+	}
+}
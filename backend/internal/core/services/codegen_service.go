@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+
+	"github.com/swagger-editor/backend/internal/core/codegen/golang"
+	"github.com/swagger-editor/backend/internal/core/codegen/typescript"
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/ports"
+)
+
+// CodegenService implements the code generation service interface
+type CodegenService struct {
+	apiService ports.APIService
+}
+
+// NewCodegenService creates a new code generation service
+func NewCodegenService(apiService ports.APIService) *CodegenService {
+	return &CodegenService{
+		apiService: apiService,
+	}
+}
+
+// GenerateGo generates a Go package with the models, client and server of
+// a stored API definition
+func (s *CodegenService) GenerateGo(ctx context.Context, id string, packageName string) (*domain.GeneratedCode, error) {
+	api, err := s.apiService.GetAPIDefinition(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	generator := golang.New(api, packageName)
+	files, err := generator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.GeneratedCode{Name: generator.Package(), Files: files}, nil
+}
+
+// GenerateTypeScript generates the TypeScript types and fetch client of a
+// stored API definition
+func (s *CodegenService) GenerateTypeScript(ctx context.Context, id string) (*domain.GeneratedCode, error) {
+	api, err := s.apiService.GetAPIDefinition(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	generator := typescript.New(api)
+	files, err := generator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.GeneratedCode{Name: generator.Name(), Files: files}, nil
+}
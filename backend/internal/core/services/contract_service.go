@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/swagger-editor/backend/internal/core/contract"
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/ports"
+)
+
+// contractTimeout bounds each request of a contract test
+const contractTimeout = 30 * time.Second
+
+// ContractService implements the contract service interface
+type ContractService struct {
+	apiService ports.APIService
+	targets    *contractTargets
+	client     *http.Client
+}
+
+// NewContractService creates a new contract service. Contract tests may
+// call public addresses and, in addition, the allowed targets: host names,
+// IP addresses or CIDR ranges. Loopback, private and link-local addresses
+// are refused unless allowed.
+func NewContractService(apiService ports.APIService, allowedTargets []string) (*ContractService, error) {
+	targets, err := newContractTargets(allowedTargets)
+	if err != nil {
+		return nil, err
+	}
+	dialer := &net.Dialer{Timeout: contractTimeout}
+	return &ContractService{
+		apiService: apiService,
+		targets:    targets,
+		client: &http.Client{
+			Timeout: contractTimeout,
+			Transport: &http.Transport{
+				DialContext:         targets.dialContext(dialer),
+				TLSHandshakeTimeout: 10 * time.Second,
+			},
+		},
+	}, nil
+}
+
+// Run calls every endpoint of a stored API definition on the service at the
+// requested base URL and reports which responses conform to the definition
+func (s *ContractService) Run(ctx context.Context, id string, request *domain.ContractTestRequest) (*domain.ContractReport, error) {
+	if request.BaseURL == "" {
+		return nil, errors.New("baseUrl is required")
+	}
+	base, err := url.Parse(request.BaseURL)
+	if err != nil || (base.Scheme != "http" && base.Scheme != "https") || base.Host == "" {
+		return nil, fmt.Errorf("invalid baseUrl: %s", request.BaseURL)
+	}
+	// Checked again for every connection, which covers redirects and host
+	// names that resolve differently later
+	if _, err := s.targets.resolve(ctx, base.Hostname()); err != nil {
+		return nil, err
+	}
+
+	api, err := s.apiService.GetAPIDefinition(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return contract.New(api, s.client, request.Seed).Run(ctx, request.BaseURL, request.Headers), nil
+}
+
+// contractTargets decides which hosts contract tests may call
+type contractTargets struct {
+	hosts    map[string]bool
+	networks []*net.IPNet
+}
+
+func newContractTargets(allowed []string) (*contractTargets, error) {
+	targets := &contractTargets{hosts: map[string]bool{}}
+	for _, entry := range allowed {
+		entry = strings.TrimSpace(entry)
+		switch {
+		case entry == "":
+		case strings.Contains(entry, "/"):
+			_, network, err := net.ParseCIDR(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid contract test target %q: %w", entry, err)
+			}
+			targets.networks = append(targets.networks, network)
+		case net.ParseIP(entry) != nil:
+			ip := net.ParseIP(entry)
+			if ip4 := ip.To4(); ip4 != nil {
+				ip = ip4
+			}
+			targets.networks = append(targets.networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)})
+		default:
+			targets.hosts[strings.ToLower(entry)] = true
+		}
+	}
+	return targets, nil
+}
+
+// resolve looks host up and returns its addresses, or an error if any of
+// them may not be called
+func (t *contractTargets) resolve(ctx context.Context, host string) ([]net.IP, error) {
+	if ip := net.ParseIP(host); ip != nil {
+		if !t.allows(host, ip) {
+			return nil, fmt.Errorf("contract tests may not call %s: the address is not public", host)
+		}
+		return []net.IP{ip}, nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve %s: %w", host, err)
+	}
+	ips := make([]net.IP, 0, len(addrs))
+	for _, addr := range addrs {
+		if !t.allows(host, addr.IP) {
+			return nil, fmt.Errorf("contract tests may not call %s: it resolves to %s, which is not public", host, addr.IP)
+		}
+		ips = append(ips, addr.IP)
+	}
+	return ips, nil
+}
+
+// allows reports whether ip, an address of host, may be called
+func (t *contractTargets) allows(host string, ip net.IP) bool {
+	if t.hosts[strings.ToLower(strings.TrimSuffix(host, "."))] {
+		return true
+	}
+	for _, network := range t.networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return !internalAddress(ip)
+}
+
+// dialContext connects only to the addresses resolve accepts, and to the
+// address it checked rather than one the host name resolves to later
+func (t *contractTargets) dialContext(dialer *net.Dialer) func(ctx context.Context, network, address string) (net.Conn, error) {
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+		ips, err := t.resolve(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		var firstErr error
+		for _, ip := range ips {
+			conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(ip.String(), port))
+			if err == nil {
+				return conn, nil
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+		return nil, firstErr
+	}
+}
+
+// cgnat is the shared address space carriers use behind their NAT
+var cgnat = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// internalAddress reports whether ip belongs to this machine or a private
+// network rather than the public internet
+func internalAddress(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || cgnat.Contains(ip)
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// TestContractTargets decides which addresses contract tests may call, by
+// default and with an allow-list
+func TestContractTargets(t *testing.T) {
+	tests := []struct {
+		name    string
+		allowed []string
+		host    string
+		ip      string
+		want    bool
+	}{
+		{"public address", nil, "93.184.216.34", "93.184.216.34", true},
+		{"public IPv6 address", nil, "2606:2800:220:1::", "2606:2800:220:1::", true},
+		{"loopback", nil, "127.0.0.1", "127.0.0.1", false},
+		{"IPv6 loopback", nil, "::1", "::1", false},
+		{"IPv4-mapped loopback", nil, "::ffff:127.0.0.1", "::ffff:127.0.0.1", false},
+		{"private network", nil, "10.1.2.3", "10.1.2.3", false},
+		{"home network", nil, "192.168.0.10", "192.168.0.10", false},
+		{"cloud metadata", nil, "169.254.169.254", "169.254.169.254", false},
+		{"unspecified", nil, "0.0.0.0", "0.0.0.0", false},
+		{"carrier NAT", nil, "100.64.0.1", "100.64.0.1", false},
+		{"unique local IPv6", nil, "fd00::1", "fd00::1", false},
+		{"host name resolving to loopback", nil, "internal.example", "127.0.0.1", false},
+		{"allowed address", []string{"127.0.0.1"}, "127.0.0.1", "127.0.0.1", true},
+		{"allowed range", []string{" 10.0.0.0/8 "}, "10.1.2.3", "10.1.2.3", true},
+		{"address outside the allowed range", []string{"10.0.0.0/8"}, "192.168.0.10", "192.168.0.10", false},
+		{"allowed host name", []string{"Localhost"}, "localhost.", "127.0.0.1", true},
+		{"allowed host name does not allow its address", []string{"localhost"}, "127.0.0.1", "127.0.0.1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targets, err := newContractTargets(tt.allowed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := targets.allows(tt.host, net.ParseIP(tt.ip)); got != tt.want {
+				t.Errorf("allows(%s, %s) = %t, want %t", tt.host, tt.ip, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := newContractTargets([]string{"10.0.0.0/33"}); err == nil {
+		t.Error("invalid range accepted, want an error")
+	}
+}
+
+// TestContractServiceRefusesInternalTargets runs contract tests against a
+// local service, which is refused until it is allowed, and follows a
+// redirect from an allowed host to one that is not
+func TestContractServiceRefusesInternalTargets(t *testing.T) {
+	ctx := context.Background()
+	apiService := newTestAPIService()
+	if _, err := apiService.CreateAPIDefinition(ctx, newTestDefinition("pets", "Pets"), domain.Change{}); err != nil {
+		t.Fatal(err)
+	}
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host != server.Listener.Addr().String() {
+			http.Redirect(w, r, server.URL+r.URL.Path, http.StatusFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+
+	refused, err := NewContractService(apiService, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := refused.Run(ctx, "pets", &domain.ContractTestRequest{BaseURL: server.URL}); err == nil || !strings.Contains(err.Error(), "not public") {
+		t.Errorf("test of %s: %v, want it refused", server.URL, err)
+	}
+
+	allowed, err := NewContractService(apiService, []string{"127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := allowed.Run(ctx, "pets", &domain.ContractTestRequest{BaseURL: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Passed != 1 {
+		t.Errorf("report = %+v, want the allowed service tested", report)
+	}
+
+	byName, err := NewContractService(apiService, []string{"localhost"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err = byName.Run(ctx, "pets", &domain.ContractTestRequest{BaseURL: "http://localhost:" + port})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Failed != 1 || len(report.Results[0].Failures) == 0 || !strings.Contains(report.Results[0].Failures[0].Message, "not public") {
+		t.Errorf("report = %+v, want the redirect to 127.0.0.1 refused", report)
+	}
+}
@@ -0,0 +1,890 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// Supported OpenAPI versions for export
+const (
+	OpenAPIVersion30 = "3.0.3"
+	OpenAPIVersion31 = "3.1.0"
+)
+
+// swaggerExporter rebuilds an OpenAPI document from a normalized API definition
+type swaggerExporter struct {
+	api        *domain.APIDefinition
+	version    string
+	schemaRefs map[string]string
+	refs       map[string]string
+	parameters map[string]domain.Parameter
+	responses  map[string]domain.Response
+	bodies     map[string]domain.RequestBody
+	schemes    map[string]domain.SecurityScheme
+	warnings   []domain.Warning
+}
+
+// newSwaggerExporter prepares an exporter for the requested OpenAPI version.
+// An empty version keeps 3.1 documents on 3.1 and exports everything else as 3.0.
+func newSwaggerExporter(api *domain.APIDefinition, version string) (*swaggerExporter, error) {
+	switch {
+	case version == "":
+		version = OpenAPIVersion30
+		if strings.HasPrefix(api.Metadata.SpecVersion, "3.1") {
+			version = OpenAPIVersion31
+		}
+	case strings.HasPrefix(version, "3.0"):
+		version = OpenAPIVersion30
+	case strings.HasPrefix(version, "3.1"):
+		version = OpenAPIVersion31
+	default:
+		return nil, fmt.Errorf("unsupported OpenAPI version for export: %s", version)
+	}
+
+	exporter := &swaggerExporter{
+		api:        api,
+		version:    version,
+		schemaRefs: make(map[string]string, len(api.Schemas)),
+		refs:       make(map[string]string),
+		parameters: make(map[string]domain.Parameter, len(api.Parameters)),
+		responses:  make(map[string]domain.Response, len(api.Responses)),
+		bodies:     make(map[string]domain.RequestBody, len(api.RequestBodies)),
+		schemes:    make(map[string]domain.SecurityScheme, len(api.SecuritySchemes)),
+	}
+
+	// Every ID that stands for a component maps back to its pointer
+	for _, schema := range api.Schemas {
+		exporter.schemaRefs[schema.ID] = "#/components/schemas/" + escapePointerToken(schema.Name)
+		exporter.refs[schema.ID] = exporter.schemaRefs[schema.ID]
+	}
+	for _, param := range api.Parameters {
+		exporter.parameters[param.ID] = param
+		if param.Component != "" {
+			exporter.refs[param.ID] = "#/components/parameters/" + escapePointerToken(param.Component)
+		}
+	}
+	for _, response := range api.Responses {
+		exporter.responses[response.ID] = response
+		if response.Component != "" {
+			exporter.refs[response.ID] = "#/components/responses/" + escapePointerToken(response.Component)
+		}
+	}
+	for _, body := range api.RequestBodies {
+		exporter.bodies[body.ID] = body
+		if body.Component != "" {
+			exporter.refs[body.ID] = "#/components/requestBodies/" + escapePointerToken(body.Component)
+		}
+	}
+	for _, scheme := range api.SecuritySchemes {
+		exporter.schemes[scheme.ID] = scheme
+		if scheme.Component != "" {
+			exporter.refs[scheme.ID] = "#/components/securitySchemes/" + escapePointerToken(scheme.Component)
+		}
+	}
+
+	return exporter, nil
+}
+
+func (e *swaggerExporter) is31() bool {
+	return e.version == OpenAPIVersion31
+}
+
+// export returns the document as generic maps ready for marshalling, with a
+// warning for every keyword that the target version could not keep as it was
+func (e *swaggerExporter) export() (map[string]interface{}, []domain.Warning) {
+	api := e.api
+
+	swagger := map[string]interface{}{
+		"openapi": e.version,
+		"info":    e.info(),
+	}
+
+	// OpenAPI 3.1 made paths optional, which webhook-only documents use
+	hasWebhooks := len(api.Webhooks) > 0 || len(api.WebhookItems) > 0
+	if len(api.Endpoints) > 0 || len(api.PathItems) > 0 || !e.is31() || !hasWebhooks {
+		swagger["paths"] = e.paths(api.Endpoints, api.PathItems, "/paths")
+	}
+
+	switch {
+	case len(api.Metadata.Servers) > 0:
+		swagger["servers"] = serverList(api.Metadata.Servers)
+	case api.Metadata.BaseURL != "":
+		swagger["servers"] = []interface{}{
+			map[string]interface{}{"url": api.Metadata.BaseURL},
+		}
+	}
+
+	if hasWebhooks {
+		// OpenAPI 3.0 has no webhooks; x-webhooks is the common stand-in
+		key := "x-webhooks"
+		if e.is31() {
+			key = "webhooks"
+		} else {
+			e.warn("/x-webhooks", "webhooks were moved to x-webhooks, as OpenAPI 3.0 has no webhooks")
+		}
+		swagger[key] = e.paths(api.Webhooks, api.WebhookItems, "/"+key)
+	}
+
+	if components := e.components(); len(components) > 0 {
+		swagger["components"] = components
+	}
+
+	if api.Security != nil {
+		swagger["security"] = e.securityList(api.Security)
+	}
+
+	if len(api.Tags) > 0 {
+		tags := make([]interface{}, 0, len(api.Tags))
+		for _, tag := range api.Tags {
+			tagObj := map[string]interface{}{"name": tag.Name}
+			setString(tagObj, "description", tag.Description)
+			if tag.ExternalDocs != nil {
+				tagObj["externalDocs"] = externalDocsObject(tag.ExternalDocs)
+			}
+			addExtensions(tagObj, tag.Extensions)
+			tags = append(tags, tagObj)
+		}
+		swagger["tags"] = tags
+	}
+
+	if api.Metadata.ExternalDocs != nil {
+		swagger["externalDocs"] = externalDocsObject(api.Metadata.ExternalDocs)
+	}
+
+	addExtensions(swagger, api.Extensions)
+
+	sort.SliceStable(e.warnings, func(i, j int) bool { return e.warnings[i].Path < e.warnings[j].Path })
+	return swagger, e.warnings
+}
+
+// warn records a warning about the value at pointer in the exported document
+func (e *swaggerExporter) warn(pointer, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	for _, existing := range e.warnings {
+		if existing.Path == pointer && existing.Message == message {
+			return
+		}
+	}
+	e.warnings = append(e.warnings, domain.Warning{Path: pointer, Message: message})
+}
+
+func (e *swaggerExporter) info() map[string]interface{} {
+	metadata := e.api.Metadata
+
+	info := map[string]interface{}{
+		"title":   metadata.Name,
+		"version": metadata.Version,
+	}
+	setString(info, "description", metadata.Description)
+	setString(info, "termsOfService", metadata.TermsOfService)
+	if e.is31() {
+		setString(info, "summary", metadata.Summary)
+	} else if metadata.Summary != "" {
+		e.warn("/info", "summary was dropped, as OpenAPI 3.0 has no info summary")
+	}
+
+	if contact := metadata.Contact; contact != nil {
+		contactObj := map[string]interface{}{}
+		setString(contactObj, "name", contact.Name)
+		setString(contactObj, "url", contact.URL)
+		setString(contactObj, "email", contact.Email)
+		info["contact"] = contactObj
+	}
+
+	if license := metadata.License; license != nil {
+		licenseObj := map[string]interface{}{"name": license.Name}
+		if license.URL != "" {
+			licenseObj["url"] = license.URL
+		} else if license.Identifier != "" && e.is31() {
+			licenseObj["identifier"] = license.Identifier
+		} else if license.Identifier != "" {
+			e.warn("/info/license", "identifier was dropped, as OpenAPI 3.0 has no license identifier")
+		}
+		info["license"] = licenseObj
+	}
+
+	addExtensions(info, metadata.Extensions)
+
+	return info
+}
+
+// paths groups endpoints into path items, one operation per method, with
+// the fields items declares for each path. pointer locates the map in the
+// exported document.
+func (e *swaggerExporter) paths(endpoints []domain.Endpoint, items []domain.PathItem, pointer string) map[string]interface{} {
+	paths := make(map[string]interface{})
+	pathItem := func(path string) map[string]interface{} {
+		raw, ok := paths[path].(map[string]interface{})
+		if !ok {
+			raw = make(map[string]interface{})
+			paths[path] = raw
+		}
+		return raw
+	}
+
+	for _, endpoint := range endpoints {
+		method := strings.ToLower(endpoint.Method)
+		pathItem(endpoint.Path)[method] = e.operation(endpoint, resolver.JoinPointer(pointer, endpoint.Path, method))
+	}
+	for _, item := range items {
+		raw := pathItem(item.Path)
+		setString(raw, "summary", item.Summary)
+		setString(raw, "description", item.Description)
+		if len(item.Servers) > 0 {
+			raw["servers"] = serverList(item.Servers)
+		}
+		addExtensions(raw, item.Extensions)
+	}
+
+	return paths
+}
+
+func (e *swaggerExporter) operation(endpoint domain.Endpoint, pointer string) map[string]interface{} {
+	operation := map[string]interface{}{}
+
+	if len(endpoint.Tags) > 0 {
+		operation["tags"] = endpoint.Tags
+	}
+	setString(operation, "summary", endpoint.Summary)
+	setString(operation, "description", endpoint.Description)
+	setString(operation, "operationId", endpoint.OperationID)
+	if endpoint.ExternalDocs != nil {
+		operation["externalDocs"] = externalDocsObject(endpoint.ExternalDocs)
+	}
+
+	if len(endpoint.Parameters) > 0 {
+		params := make([]interface{}, 0, len(endpoint.Parameters))
+		for i, id := range endpoint.Parameters {
+			params = append(params, e.parameterValue(id, resolver.JoinPointer(pointer, "parameters", strconv.Itoa(i))))
+		}
+		operation["parameters"] = params
+	}
+
+	if endpoint.RequestBody != "" {
+		operation["requestBody"] = e.requestBodyValue(endpoint.RequestBody, resolver.JoinPointer(pointer, "requestBody"))
+	}
+
+	// responses is required, even when it ends up empty
+	responses := make(map[string]interface{}, len(endpoint.Responses))
+	for code, id := range endpoint.Responses {
+		responses[code] = e.responseValue(id, resolver.JoinPointer(pointer, "responses", code))
+	}
+	operation["responses"] = responses
+
+	if len(endpoint.Callbacks) > 0 {
+		operation["callbacks"] = e.restoreRefs(endpoint.Callbacks)
+	}
+	if endpoint.Deprecated {
+		operation["deprecated"] = true
+	}
+	if endpoint.Security != nil {
+		operation["security"] = e.securityList(endpoint.Security)
+	}
+	if len(endpoint.Servers) > 0 {
+		operation["servers"] = serverList(endpoint.Servers)
+	}
+
+	addExtensions(operation, endpoint.Extensions)
+
+	return operation
+}
+
+// components rebuilds the components section from every normalized
+// component plus the sections that were kept raw
+func (e *swaggerExporter) components() map[string]interface{} {
+	api := e.api
+	components := map[string]interface{}{}
+
+	section := func(name string) map[string]interface{} {
+		existing, ok := components[name].(map[string]interface{})
+		if !ok {
+			existing = map[string]interface{}{}
+			components[name] = existing
+		}
+		return existing
+	}
+
+	for _, schema := range api.Schemas {
+		section("schemas")[schema.Name] = e.schemaObject(schema, resolver.JoinPointer("/components/schemas", schema.Name))
+	}
+	for _, param := range api.Parameters {
+		if param.Component != "" {
+			section("parameters")[param.Component] = e.parameterObject(param, resolver.JoinPointer("/components/parameters", param.Component))
+		}
+	}
+	for _, response := range api.Responses {
+		if response.Component != "" {
+			section("responses")[response.Component] = e.responseObject(response, resolver.JoinPointer("/components/responses", response.Component))
+		}
+	}
+	for _, body := range api.RequestBodies {
+		if body.Component != "" {
+			section("requestBodies")[body.Component] = e.requestBodyObject(body, resolver.JoinPointer("/components/requestBodies", body.Component))
+		}
+	}
+	for _, scheme := range api.SecuritySchemes {
+		if scheme.Component != "" {
+			section("securitySchemes")[scheme.Component] = securitySchemeObject(scheme)
+		}
+	}
+
+	for name, raw := range api.Components {
+		if name == "pathItems" && !e.is31() {
+			name = "x-pathItems"
+			e.warn("/components/x-pathItems", "pathItems were moved to x-pathItems, as OpenAPI 3.0 has no path item components")
+		}
+		components[name] = e.restoreRefs(raw)
+	}
+
+	return components
+}
+
+// parameterValue returns a reference for component parameters and the
+// inline parameter object otherwise
+func (e *swaggerExporter) parameterValue(id, pointer string) interface{} {
+	if ref, ok := e.refs[id]; ok {
+		return map[string]interface{}{"$ref": ref}
+	}
+	if param, ok := e.parameters[id]; ok {
+		return e.parameterObject(param, pointer)
+	}
+	return map[string]interface{}{"$ref": id}
+}
+
+func (e *swaggerExporter) requestBodyValue(id, pointer string) interface{} {
+	if ref, ok := e.refs[id]; ok {
+		return map[string]interface{}{"$ref": ref}
+	}
+	if body, ok := e.bodies[id]; ok {
+		return e.requestBodyObject(body, pointer)
+	}
+	return map[string]interface{}{"$ref": id}
+}
+
+func (e *swaggerExporter) responseValue(id, pointer string) interface{} {
+	if ref, ok := e.refs[id]; ok {
+		return map[string]interface{}{"$ref": ref}
+	}
+	if response, ok := e.responses[id]; ok {
+		return e.responseObject(response, pointer)
+	}
+	return map[string]interface{}{"$ref": id}
+}
+
+func (e *swaggerExporter) parameterObject(param domain.Parameter, pointer string) map[string]interface{} {
+	raw := map[string]interface{}{
+		"name": param.Name,
+		"in":   param.In,
+	}
+	setString(raw, "description", param.Description)
+	setString(raw, "style", param.Style)
+	if param.Required {
+		raw["required"] = true
+	}
+	if param.Deprecated {
+		raw["deprecated"] = true
+	}
+	if param.AllowEmptyValue {
+		raw["allowEmptyValue"] = true
+	}
+	if param.AllowReserved {
+		raw["allowReserved"] = true
+	}
+	if param.Explode != nil {
+		raw["explode"] = *param.Explode
+	}
+	if param.Schema != nil {
+		raw["schema"] = e.schemaValue(param.Schema, resolver.JoinPointer(pointer, "schema"))
+	}
+	if len(param.Content) > 0 {
+		raw["content"] = e.contentObject(param.Content, resolver.JoinPointer(pointer, "content"))
+	}
+	if param.Example != nil {
+		raw["example"] = param.Example
+	}
+	if len(param.Examples) > 0 {
+		raw["examples"] = param.Examples
+	}
+	addExtensions(raw, param.Extensions)
+
+	return raw
+}
+
+func (e *swaggerExporter) responseObject(response domain.Response, pointer string) map[string]interface{} {
+	// description is required, even when empty
+	raw := map[string]interface{}{"description": response.Description}
+
+	if len(response.Headers) > 0 {
+		headers := make(map[string]interface{}, len(response.Headers))
+		for name, header := range response.Headers {
+			headers[name] = e.headerObject(header, resolver.JoinPointer(pointer, "headers", name))
+		}
+		raw["headers"] = headers
+	}
+	if len(response.Content) > 0 {
+		raw["content"] = e.contentObject(response.Content, resolver.JoinPointer(pointer, "content"))
+	}
+	if len(response.Links) > 0 {
+		raw["links"] = response.Links
+	}
+	addExtensions(raw, response.Extensions)
+
+	return raw
+}
+
+func (e *swaggerExporter) requestBodyObject(body domain.RequestBody, pointer string) map[string]interface{} {
+	raw := map[string]interface{}{"content": e.contentObject(body.Content, resolver.JoinPointer(pointer, "content"))}
+	setString(raw, "description", body.Description)
+	if body.Required {
+		raw["required"] = true
+	}
+	addExtensions(raw, body.Extensions)
+
+	return raw
+}
+
+// headerObject restores a raw header, converting its schema for the target version
+func (e *swaggerExporter) headerObject(header interface{}, pointer string) interface{} {
+	raw, ok := e.restoreRefs(header).(map[string]interface{})
+	if !ok {
+		return header
+	}
+	if schema, ok := raw["schema"].(map[string]interface{}); ok {
+		raw["schema"] = e.schemaMap(schema, resolver.JoinPointer(pointer, "schema"))
+	}
+	return raw
+}
+
+func (e *swaggerExporter) contentObject(content map[string]domain.MediaType, pointer string) map[string]interface{} {
+	result := make(map[string]interface{}, len(content))
+	for mediaType, media := range content {
+		raw := map[string]interface{}{}
+		if media.Schema != nil {
+			raw["schema"] = e.schemaValue(media.Schema, resolver.JoinPointer(pointer, mediaType, "schema"))
+		}
+		if media.Example != nil {
+			raw["example"] = media.Example
+		}
+		if len(media.Examples) > 0 {
+			raw["examples"] = media.Examples
+		}
+		if len(media.Encoding) > 0 {
+			raw["encoding"] = e.restoreRefs(media.Encoding)
+		}
+		addExtensions(raw, media.Extensions)
+		result[mediaType] = raw
+	}
+	return result
+}
+
+func securitySchemeObject(scheme domain.SecurityScheme) map[string]interface{} {
+	raw := map[string]interface{}{"type": scheme.Type}
+	setString(raw, "description", scheme.Description)
+	setString(raw, "name", scheme.Name)
+	setString(raw, "in", scheme.In)
+	setString(raw, "scheme", scheme.Scheme)
+	setString(raw, "bearerFormat", scheme.BearerFormat)
+	setString(raw, "openIdConnectUrl", scheme.OpenIDConnectURL)
+	if scheme.Flows != nil {
+		raw["flows"] = scheme.Flows
+	}
+	addExtensions(raw, scheme.Extensions)
+
+	return raw
+}
+
+// securityList rewrites requirement keys from scheme IDs back to scheme names
+func (e *swaggerExporter) securityList(requirements []domain.SecurityRequirement) []interface{} {
+	result := make([]interface{}, 0, len(requirements))
+	for _, requirement := range requirements {
+		raw := make(map[string]interface{}, len(requirement))
+		for id, scopes := range requirement {
+			name := id
+			if scheme, ok := e.schemes[id]; ok && scheme.Component != "" {
+				name = scheme.Component
+			}
+			if scopes == nil {
+				scopes = []string{}
+			}
+			raw[name] = scopes
+		}
+		result = append(result, raw)
+	}
+	return result
+}
+
+// restoreRefs copies a raw fragment, turning every $ref that holds a
+// normalized ID back into a component pointer
+func (e *swaggerExporter) restoreRefs(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			if id, ok := item.(string); ok && key == "$ref" {
+				if ref, ok := e.refs[id]; ok {
+					item = ref
+				}
+			}
+			result[key] = e.restoreRefs(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = e.restoreRefs(item)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+func serverList(servers []domain.Server) []interface{} {
+	result := make([]interface{}, 0, len(servers))
+	for _, server := range servers {
+		raw := map[string]interface{}{"url": server.URL}
+		setString(raw, "description", server.Description)
+		if len(server.Variables) > 0 {
+			raw["variables"] = server.Variables
+		}
+		addExtensions(raw, server.Extensions)
+		result = append(result, raw)
+	}
+	return result
+}
+
+func externalDocsObject(docs *domain.ExternalDocs) map[string]interface{} {
+	raw := map[string]interface{}{"url": docs.URL}
+	setString(raw, "description", docs.Description)
+	return raw
+}
+
+func addExtensions(raw map[string]interface{}, extensions map[string]interface{}) {
+	for key, value := range extensions {
+		raw[key] = value
+	}
+}
+
+// setString sets key only for non-empty values, so that optional fields are
+// left out instead of being emitted as empty strings
+func setString(raw map[string]interface{}, key, value string) {
+	if value != "" {
+		raw[key] = value
+	}
+}
+
+// schemaObject converts a normalized schema into an OpenAPI schema object
+func (e *swaggerExporter) schemaObject(schema domain.Schema, pointer string) map[string]interface{} {
+	raw := map[string]interface{}{}
+
+	set := func(key string, value interface{}, present bool) {
+		if present {
+			raw[key] = value
+		}
+	}
+
+	switch {
+	case len(schema.Types) > 0:
+		types := make([]interface{}, len(schema.Types))
+		for i, t := range schema.Types {
+			types[i] = t
+		}
+		raw["type"] = types
+	case schema.Type != "":
+		raw["type"] = schema.Type
+	}
+
+	set("$ref", schema.Ref, schema.Ref != "")
+	set("title", schema.Title, schema.Title != "")
+	set("description", schema.Description, schema.Description != "")
+	set("required", schema.Required, len(schema.Required) > 0)
+	set("properties", schema.Properties, schema.Properties != nil)
+	set("patternProperties", schema.PatternProperties, schema.PatternProperties != nil)
+	set("additionalProperties", schema.AdditionalProperties, schema.AdditionalProperties != nil)
+	set("unevaluatedProperties", schema.UnevaluatedProperties, schema.UnevaluatedProperties != nil)
+	set("propertyNames", schema.PropertyNames, schema.PropertyNames != nil)
+	set("dependentRequired", schema.DependentRequired, schema.DependentRequired != nil)
+	set("dependentSchemas", schema.DependentSchemas, schema.DependentSchemas != nil)
+	set("items", schema.Items, schema.Items != nil)
+	set("prefixItems", schema.PrefixItems, len(schema.PrefixItems) > 0)
+	set("unevaluatedItems", schema.UnevaluatedItems, schema.UnevaluatedItems != nil)
+	set("contains", schema.Contains, schema.Contains != nil)
+	set("minContains", schema.MinContains, schema.MinContains != nil)
+	set("maxContains", schema.MaxContains, schema.MaxContains != nil)
+	set("allOf", schema.AllOf, len(schema.AllOf) > 0)
+	set("oneOf", schema.OneOf, len(schema.OneOf) > 0)
+	set("anyOf", schema.AnyOf, len(schema.AnyOf) > 0)
+	set("not", schema.Not, schema.Not != nil)
+	set("if", schema.If, schema.If != nil)
+	set("then", schema.Then, schema.Then != nil)
+	set("else", schema.Else, schema.Else != nil)
+	set("discriminator", schema.Discriminator, schema.Discriminator != nil)
+	set("enum", schema.Enum, len(schema.Enum) > 0)
+	set("const", schema.Const, schema.Const != nil)
+	set("format", schema.Format, schema.Format != "")
+	set("pattern", schema.Pattern, schema.Pattern != "")
+	set("minLength", schema.MinLength, schema.MinLength != nil)
+	set("maxLength", schema.MaxLength, schema.MaxLength != nil)
+	set("minimum", schema.Minimum, schema.Minimum != nil)
+	set("maximum", schema.Maximum, schema.Maximum != nil)
+	set("exclusiveMinimum", true, schema.ExclusiveMinimum)
+	set("exclusiveMaximum", true, schema.ExclusiveMaximum)
+	set("exclusiveMinimum", schema.ExclusiveMinimumValue, schema.ExclusiveMinimumValue != nil)
+	set("exclusiveMaximum", schema.ExclusiveMaximumValue, schema.ExclusiveMaximumValue != nil)
+	set("multipleOf", schema.MultipleOf, schema.MultipleOf != nil)
+	set("minItems", schema.MinItems, schema.MinItems != nil)
+	set("maxItems", schema.MaxItems, schema.MaxItems != nil)
+	set("uniqueItems", true, schema.UniqueItems)
+	set("minProperties", schema.MinProperties, schema.MinProperties != nil)
+	set("maxProperties", schema.MaxProperties, schema.MaxProperties != nil)
+	set("nullable", true, schema.Nullable)
+	set("readOnly", true, schema.ReadOnly)
+	set("writeOnly", true, schema.WriteOnly)
+	set("deprecated", true, schema.Deprecated)
+	set("default", schema.Default, schema.Default != nil)
+	set("example", schema.Example, schema.Example != nil)
+	set("examples", schema.Examples, len(schema.Examples) > 0)
+	set("$defs", schema.Defs, len(schema.Defs) > 0)
+	set("xml", schema.XML, schema.XML != nil)
+	if schema.ExternalDocs != nil {
+		raw["externalDocs"] = externalDocsObject(schema.ExternalDocs)
+	}
+	addExtensions(raw, schema.Extensions)
+
+	return e.schemaMap(raw, pointer)
+}
+
+// schemaValue exports a schema link: a schema ID becomes a $ref, an inline
+// schema is converted for the target version
+func (e *swaggerExporter) schemaValue(value interface{}, pointer string) interface{} {
+	switch v := value.(type) {
+	case string:
+		if ref, ok := e.schemaRefs[v]; ok {
+			return map[string]interface{}{"$ref": ref}
+		}
+		return map[string]interface{}{"$ref": v}
+	case map[string]interface{}:
+		return e.schemaMap(v, pointer)
+	case bool:
+		if e.is31() {
+			return v
+		}
+		// OpenAPI 3.0 schemas are always objects
+		e.warn(pointer, "boolean schema %t became an equivalent schema object, as OpenAPI 3.0 has no boolean schemas", v)
+		if v {
+			return map[string]interface{}{}
+		}
+		return map[string]interface{}{"not": map[string]interface{}{}}
+	default:
+		return value
+	}
+}
+
+// schemaMap converts a raw schema object for the target version. Only
+// keywords known to hold subschemas are walked, so property names and
+// example values are never mistaken for keywords. pointer locates the schema
+// in the exported document.
+func (e *swaggerExporter) schemaMap(raw map[string]interface{}, pointer string) map[string]interface{} {
+	result := make(map[string]interface{}, len(raw))
+
+	for key, value := range raw {
+		if !e.is31() && droppedIn30[key] {
+			e.warn(resolver.JoinPointer(pointer, key), "%s was dropped, as OpenAPI 3.0 has no equivalent", key)
+			continue
+		}
+
+		switch key {
+		case "$ref":
+			if id, ok := value.(string); ok {
+				if ref, ok := e.schemaRefs[id]; ok {
+					value = ref
+				}
+			}
+			result[key] = value
+		case "properties", "patternProperties", "$defs", "definitions", "dependentSchemas":
+			if members, ok := value.(map[string]interface{}); ok {
+				converted := make(map[string]interface{}, len(members))
+				for name, member := range members {
+					converted[name] = e.schemaValue(member, resolver.JoinPointer(pointer, key, name))
+				}
+				value = converted
+			}
+			result[key] = value
+		case "allOf", "oneOf", "anyOf", "prefixItems":
+			if members, ok := value.([]interface{}); ok {
+				converted := make([]interface{}, len(members))
+				for i, member := range members {
+					converted[i] = e.schemaValue(member, resolver.JoinPointer(pointer, key, strconv.Itoa(i)))
+				}
+				value = converted
+			}
+			result[key] = value
+		case "additionalProperties":
+			// The one keyword where OpenAPI 3.0 allows a boolean
+			if _, ok := value.(bool); !ok {
+				value = e.schemaValue(value, resolver.JoinPointer(pointer, key))
+			}
+			result[key] = value
+		case "items", "unevaluatedProperties", "unevaluatedItems",
+			"not", "if", "then", "else", "contains", "propertyNames":
+			result[key] = e.schemaValue(value, resolver.JoinPointer(pointer, key))
+		default:
+			result[key] = value
+		}
+	}
+
+	if e.is31() {
+		upgradeSchemaTo31(result)
+	} else {
+		e.downgradeSchemaTo30(result, pointer)
+	}
+
+	return result
+}
+
+// upgradeSchemaTo31 rewrites OpenAPI 3.0 constructs into JSON Schema 2020-12
+func upgradeSchemaTo31(schema map[string]interface{}) {
+	if nullable, _ := schema["nullable"].(bool); nullable {
+		switch t := schema["type"].(type) {
+		case string:
+			schema["type"] = []interface{}{t, "null"}
+		case []interface{}:
+			if !containsValue(t, "null") {
+				schema["type"] = append(t, "null")
+			}
+		}
+	}
+	delete(schema, "nullable")
+
+	for _, bound := range []struct{ flag, limit string }{
+		{"exclusiveMinimum", "minimum"},
+		{"exclusiveMaximum", "maximum"},
+	} {
+		if exclusive, ok := schema[bound.flag].(bool); ok {
+			delete(schema, bound.flag)
+			if limit, ok := schema[bound.limit]; ok && exclusive {
+				schema[bound.flag] = limit
+				delete(schema, bound.limit)
+			}
+		}
+	}
+}
+
+// droppedIn30 lists the JSON Schema 2020-12 keywords that have no OpenAPI
+// 3.0 equivalent, so exporting to 3.0 drops them
+var droppedIn30 = map[string]bool{
+	"prefixItems":           true,
+	"$defs":                 true,
+	"unevaluatedProperties": true,
+	"unevaluatedItems":      true,
+	"patternProperties":     true,
+	"propertyNames":         true,
+	"dependentRequired":     true,
+	"dependentSchemas":      true,
+	"contains":              true,
+	"minContains":           true,
+	"maxContains":           true,
+	"if":                    true,
+	"then":                  true,
+	"else":                  true,
+}
+
+// downgradeSchemaTo30 rewrites JSON Schema 2020-12 constructs into their
+// OpenAPI 3.0 equivalents, warning about each keyword it rewrites
+func (e *swaggerExporter) downgradeSchemaTo30(schema map[string]interface{}, pointer string) {
+	if types, ok := schema["type"].([]interface{}); ok {
+		var nonNull []interface{}
+		for _, t := range types {
+			if t == "null" {
+				schema["nullable"] = true
+			} else {
+				nonNull = append(nonNull, t)
+			}
+		}
+
+		delete(schema, "type")
+		switch len(nonNull) {
+		case 0:
+			e.warn(resolver.JoinPointer(pointer, "type"), "type list %v became nullable, as OpenAPI 3.0 has no null type", types)
+		case 1:
+			schema["type"] = nonNull[0]
+			e.warn(resolver.JoinPointer(pointer, "type"), "type list %v became type %v, as OpenAPI 3.0 allows a single type", types, nonNull[0])
+		default:
+			alternatives := make([]interface{}, len(nonNull))
+			for i, t := range nonNull {
+				alternatives[i] = map[string]interface{}{"type": t}
+			}
+			if existing, ok := schema["anyOf"]; ok {
+				// Keep both constraints when the schema already uses anyOf
+				schema["allOf"] = append(toInterfaceSlice(schema["allOf"]), map[string]interface{}{"anyOf": existing})
+			}
+			schema["anyOf"] = alternatives
+			e.warn(resolver.JoinPointer(pointer, "type"), "type list %v became anyOf, as OpenAPI 3.0 allows a single type", types)
+		}
+	}
+
+	for _, bound := range []struct {
+		flag, limit string
+		// tighter reports whether the exclusive bound excludes more than the
+		// inclusive one
+		tighter func(exclusive, inclusive float64) bool
+	}{
+		{"exclusiveMinimum", "minimum", func(exclusive, inclusive float64) bool { return exclusive >= inclusive }},
+		{"exclusiveMaximum", "maximum", func(exclusive, inclusive float64) bool { return exclusive <= inclusive }},
+	} {
+		exclusive := getFloatPtr(schema, bound.flag)
+		if exclusive == nil {
+			continue
+		}
+		// OpenAPI 3.0 has a single bound per side, so keep the tighter one
+		if inclusive := getFloatPtr(schema, bound.limit); inclusive != nil && !bound.tighter(*exclusive, *inclusive) {
+			delete(schema, bound.flag)
+			e.warn(resolver.JoinPointer(pointer, bound.flag), "numeric %s %v was dropped, as %s %v is tighter", bound.flag, *exclusive, bound.limit, *inclusive)
+			continue
+		}
+		schema[bound.limit] = *exclusive
+		schema[bound.flag] = true
+		e.warn(resolver.JoinPointer(pointer, bound.flag), "numeric %s became %s with %s: true", bound.flag, bound.limit, bound.flag)
+	}
+
+	if ref, ok := schema["$ref"]; ok && len(schema) > 1 {
+		schema["allOf"] = append(toInterfaceSlice(schema["allOf"]), map[string]interface{}{"$ref": ref})
+		delete(schema, "$ref")
+		e.warn(resolver.JoinPointer(pointer, "$ref"), "$ref with sibling keywords became allOf, as OpenAPI 3.0 ignores keywords next to $ref")
+	}
+
+	if value, ok := schema["const"]; ok {
+		schema["enum"] = []interface{}{value}
+		delete(schema, "const")
+		e.warn(resolver.JoinPointer(pointer, "const"), "const became a single-value enum, as OpenAPI 3.0 has no const")
+	}
+
+	if examples, ok := schema["examples"].([]interface{}); ok {
+		if _, exists := schema["example"]; !exists && len(examples) > 0 {
+			schema["example"] = examples[0]
+			e.warn(resolver.JoinPointer(pointer, "examples"), "examples became example, keeping the first of %d", len(examples))
+		} else {
+			e.warn(resolver.JoinPointer(pointer, "examples"), "examples was dropped, as OpenAPI 3.0 schemas have a single example")
+		}
+		delete(schema, "examples")
+	}
+}
+
+func toInterfaceSlice(value interface{}) []interface{} {
+	list, _ := value.([]interface{})
+	return list
+}
+
+func containsValue(list []interface{}, value interface{}) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// TestExportTo30WarnsAboutLostKeywords exports the 3.1 fixture as 3.0 and
+// expects a warning for every keyword that was dropped or rewritten, and no
+// 3.1 keyword left in the document
+func TestExportTo30WarnsAboutLostKeywords(t *testing.T) {
+	content, err := os.ReadFile(filepath.Join(fixturesDir, "openapi31-example.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := importSpec(t, string(content))
+	converter := &ConverterService{}
+
+	output, warnings, err := converter.ConvertJSONToSwagger(context.Background(), api, "yaml", "3.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	for _, warning := range warnings {
+		paths = append(paths, warning.Path)
+	}
+	reading := "/components/schemas/Reading"
+	want := []string{
+		"/components/schemas/Alert/$defs",
+		reading + "/properties/notes/examples",
+		reading + "/properties/position/items",
+		reading + "/properties/position/prefixItems",
+		reading + "/properties/quality/exclusiveMinimum",
+		reading + "/properties/unit/const",
+		reading + "/properties/value/type",
+		reading + "/unevaluatedProperties",
+		"/info",
+		"/info/license",
+		resolver.JoinPointer("/paths", "/sensors/{sensorId}/readings", "get", "parameters", "1", "schema", "type"),
+		"/x-webhooks",
+	}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("warnings at:\n%v\nwant:\n%v", paths, want)
+	}
+
+	exported, err := parseSpec(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schemas := getMap(getMap(exported, "components"), "schemas")
+	properties := getMap(schemas["Reading"].(map[string]interface{}), "properties")
+	if value := properties["value"].(map[string]interface{}); value["type"] != nil || len(toInterfaceSlice(value["anyOf"])) != 2 {
+		t.Errorf("value = %v, want anyOf number and string", value)
+	}
+	if unit := properties["unit"].(map[string]interface{}); !reflect.DeepEqual(unit["enum"], []interface{}{"celsius"}) {
+		t.Errorf("unit = %v, want enum [celsius]", unit)
+	}
+	for _, keyword := range []string{"prefixItems", "$defs", "unevaluatedProperties", "const", "examples"} {
+		if pointer := findKey(exported, "", keyword); pointer != "" {
+			t.Errorf("%s is left at %s", keyword, pointer)
+		}
+	}
+
+	if _, warnings, _ := converter.ConvertJSONToSwagger(context.Background(), api, "yaml", "3.1"); len(warnings) != 0 {
+		t.Errorf("export as 3.1 warned %v, want nothing", warnings)
+	}
+}
+
+// TestExportTo30KeepsTighterBound turns numeric exclusive bounds into the
+// OpenAPI 3.0 flags without losing an inclusive bound on the same side
+func TestExportTo30KeepsTighterBound(t *testing.T) {
+	api := importSpec(t, `
+openapi: 3.1.0
+info:
+  title: Pets
+  version: 1.0.0
+components:
+  schemas:
+    Weight:
+      type: number
+      minimum: 1
+      exclusiveMinimum: 0
+      maximum: 50
+      exclusiveMaximum: 40
+`)
+
+	exported := exportYAML(t, api, "3.0")
+	weight := getMap(getMap(getMap(exported, "components"), "schemas"), "Weight")
+	want := map[string]interface{}{"type": "number", "minimum": 1, "maximum": 40, "exclusiveMaximum": true}
+	if !reflect.DeepEqual(weight, want) {
+		t.Errorf("Weight = %v, want %v", weight, want)
+	}
+}
+
+// TestExportTo30WrapsRefWithSiblings moves a reference with sibling
+// keywords into allOf, where OpenAPI 3.0 does not ignore the siblings
+func TestExportTo30WrapsRefWithSiblings(t *testing.T) {
+	api := importSpec(t, `
+openapi: 3.1.0
+info:
+  title: Pets
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Tag:
+      type: string
+    Label:
+      $ref: "#/components/schemas/Tag"
+      description: Tags double as labels
+`)
+	converter := &ConverterService{}
+
+	output, warnings, err := converter.ConvertJSONToSwagger(context.Background(), api, "yaml", "3.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) != 1 || warnings[0].Path != "/components/schemas/Label/$ref" {
+		t.Errorf("warnings = %v, want one at /components/schemas/Label/$ref", warnings)
+	}
+	exported, err := parseSpec(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	label := getMap(getMap(getMap(exported, "components"), "schemas"), "Label")
+	want := map[string]interface{}{
+		"description": "Tags double as labels",
+		"allOf":       []interface{}{map[string]interface{}{"$ref": "#/components/schemas/Tag"}},
+	}
+	if !reflect.DeepEqual(label, want) {
+		t.Errorf("Label = %v, want %v", label, want)
+	}
+}
+
+// findKey returns the pointer of the first map entry named key, or "" when
+// there is none
+func findKey(value interface{}, pointer, key string) string {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, item := range v {
+			if name == key {
+				return resolver.JoinPointer(pointer, name)
+			}
+			if found := findKey(item, resolver.JoinPointer(pointer, name), key); found != "" {
+				return found
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			if found := findKey(item, resolver.JoinPointer(pointer, strconv.Itoa(i)), key); found != "" {
+				return found
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,830 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// httpMethods lists the operation keys of a path item in output order
+var httpMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// openAPI3Ingester builds a normalized API definition from an OpenAPI 3.x document
+type openAPI3Ingester struct {
+	spec     map[string]interface{}
+	resolver *resolver.Resolver
+	ids      *idRegistry
+	refs     map[string]string
+	params   map[string]domain.Parameter
+	warnings []domain.Warning
+	api      *domain.APIDefinition
+
+	// webhookSection is the pointer of the webhooks being converted, which
+	// documents exported to 3.0 keep under x-webhooks
+	webhookSection string
+}
+
+func newOpenAPI3Ingester(spec map[string]interface{}) *openAPI3Ingester {
+	return &openAPI3Ingester{
+		spec:     spec,
+		resolver: resolver.New("", map[string]interface{}{"": spec}),
+		ids:      newIDRegistry(),
+		refs:     make(map[string]string),
+		params:   make(map[string]domain.Parameter),
+	}
+}
+
+// ingest converts the whole document and returns the definition plus any warnings
+func (in *openAPI3Ingester) ingest() (*domain.APIDefinition, []domain.Warning) {
+	info := getMap(in.spec, "info")
+	components := getMap(in.spec, "components")
+
+	// The ID is left to whoever stores the definition
+	in.api = &domain.APIDefinition{
+		Metadata: domain.APIMetadata{
+			Name:           getStringValue(info, "title", "Untitled API"),
+			Version:        getStringValue(info, "version", "1.0.0"),
+			SpecVersion:    getStringValue(in.spec, "openapi", ""),
+			Summary:        getStringValue(info, "summary", ""),
+			Description:    getStringValue(info, "description", ""),
+			TermsOfService: getStringValue(info, "termsOfService", ""),
+			Servers:        serversFromList(in.spec["servers"]),
+			Contact:        extractContact(info),
+			License:        extractLicense(info),
+			ExternalDocs:   externalDocsFromMap(getMap(in.spec, "externalDocs")),
+			Extensions:     extensionsOf(info),
+		},
+		Endpoints:     []domain.Endpoint{},
+		Schemas:       []domain.Schema{},
+		Parameters:    []domain.Parameter{},
+		Responses:     []domain.Response{},
+		RequestBodies: []domain.RequestBody{},
+		Tags:          tagsFromList(in.spec["tags"]),
+		Extensions:    extensionsOf(in.spec),
+	}
+
+	if len(in.api.Metadata.Servers) > 0 {
+		in.api.Metadata.BaseURL = in.api.Metadata.Servers[0].URL
+	}
+
+	// OpenAPI 3.0 documents exported from 3.1 carry their webhooks as x-webhooks
+	webhooks := getMap(in.spec, "webhooks")
+	in.webhookSection = "/webhooks"
+	if webhooks == nil && in.api.Extensions["x-webhooks"] != nil {
+		webhooks = getMap(in.spec, "x-webhooks")
+		in.webhookSection = "/x-webhooks"
+		delete(in.api.Extensions, "x-webhooks")
+	}
+
+	// Component IDs have to be known before anything that references them is converted
+	in.registerComponents(components)
+
+	in.extractSchemas(getMap(components, "schemas"))
+	in.extractComponentParameters(getMap(components, "parameters"))
+	in.extractComponentResponses(getMap(components, "responses"))
+	in.extractComponentRequestBodies(getMap(components, "requestBodies"))
+	in.extractSecuritySchemes(getMap(components, "securitySchemes"))
+
+	in.api.Security = in.securityRequirements(in.spec["security"], "/security", "/security")
+	in.extractEndpoints(getMap(in.spec, "paths"))
+	in.extractWebhooks(webhooks)
+	in.api.Metadata.Tags = in.collectTags()
+
+	// Sections without a normalized form are kept as they are
+	for _, section := range sortedKeys(components) {
+		switch section {
+		case "schemas", "parameters", "responses", "requestBodies", "securitySchemes":
+		default:
+			if in.api.Components == nil {
+				in.api.Components = make(map[string]interface{})
+			}
+			in.api.Components[section] = in.normalizeSchema(components[section])
+		}
+	}
+
+	return in.api, in.warnings
+}
+
+// registerComponents assigns IDs to every named component so that $ref
+// pointers can be rewritten to IDs while converting
+func (in *openAPI3Ingester) registerComponents(components map[string]interface{}) {
+	kinds := []struct{ section, prefix string }{
+		{"schemas", "schema"},
+		{"parameters", "param"},
+		{"responses", "response"},
+		{"requestBodies", "request-body"},
+		{"securitySchemes", "security"},
+	}
+
+	for _, kind := range kinds {
+		for _, name := range sortedKeys(getMap(components, kind.section)) {
+			pointer := "#/components/" + kind.section + "/" + escapePointerToken(name)
+			in.refs[pointer] = in.ids.next(kind.prefix, name)
+		}
+	}
+}
+
+func (in *openAPI3Ingester) extractSchemas(schemas map[string]interface{}) {
+	for _, name := range sortedKeys(schemas) {
+		raw, _ := schemas[name].(map[string]interface{})
+		id := in.refs["#/components/schemas/"+escapePointerToken(name)]
+		in.api.Schemas = append(in.api.Schemas, in.schemaFromMap(id, name, raw))
+	}
+}
+
+func (in *openAPI3Ingester) extractComponentParameters(parameters map[string]interface{}) {
+	for _, name := range sortedKeys(parameters) {
+		raw := in.deref(parameters[name])
+		id := in.refs["#/components/parameters/"+escapePointerToken(name)]
+		param := in.parameterFromMap(id, raw)
+		param.Component = name
+		in.addParameter(param)
+	}
+}
+
+func (in *openAPI3Ingester) extractComponentResponses(responses map[string]interface{}) {
+	for _, name := range sortedKeys(responses) {
+		raw := in.deref(responses[name])
+		id := in.refs["#/components/responses/"+escapePointerToken(name)]
+		response := in.responseFromMap(id, raw)
+		response.Component = name
+		in.api.Responses = append(in.api.Responses, response)
+	}
+}
+
+func (in *openAPI3Ingester) extractComponentRequestBodies(bodies map[string]interface{}) {
+	for _, name := range sortedKeys(bodies) {
+		raw := in.deref(bodies[name])
+		id := in.refs["#/components/requestBodies/"+escapePointerToken(name)]
+		body := in.requestBodyFromMap(id, raw)
+		body.Component = name
+		in.api.RequestBodies = append(in.api.RequestBodies, body)
+	}
+}
+
+func (in *openAPI3Ingester) extractSecuritySchemes(schemes map[string]interface{}) {
+	for _, name := range sortedKeys(schemes) {
+		raw := in.deref(schemes[name])
+		in.api.SecuritySchemes = append(in.api.SecuritySchemes, domain.SecurityScheme{
+			ID:               in.refs["#/components/securitySchemes/"+escapePointerToken(name)],
+			Component:        name,
+			Type:             getStringValue(raw, "type", ""),
+			Description:      getStringValue(raw, "description", ""),
+			Name:             getStringValue(raw, "name", ""),
+			In:               getStringValue(raw, "in", ""),
+			Scheme:           getStringValue(raw, "scheme", ""),
+			BearerFormat:     getStringValue(raw, "bearerFormat", ""),
+			Flows:            raw["flows"],
+			OpenIDConnectURL: getStringValue(raw, "openIdConnectUrl", ""),
+			Extensions:       extensionsOf(raw),
+		})
+	}
+}
+
+func (in *openAPI3Ingester) extractEndpoints(paths map[string]interface{}) {
+	for _, path := range sortedKeys(paths) {
+		pathItem, _ := paths[path].(map[string]interface{})
+		pointer := resolver.JoinPointer("/paths", path)
+		if ref, ok := pathItem["$ref"].(string); ok {
+			in.warn(resolver.JoinPointer(pointer, "$ref"), "path item %s references %s, which is not resolved", path, ref)
+			continue
+		}
+
+		if item, ok := pathItemFrom(path, pathItem); ok {
+			in.api.PathItems = append(in.api.PathItems, item)
+		}
+
+		// Path-level parameters are shared by every operation under the path
+		pathParams := in.operationParameters(pathItem["parameters"], path)
+
+		for _, method := range httpMethods {
+			operation, ok := pathItem[method].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			in.api.Endpoints = append(in.api.Endpoints, in.endpointFromOperation("endpoint", path, method, operation, pathParams))
+		}
+	}
+}
+
+// extractWebhooks converts the OpenAPI 3.1 webhooks map; each webhook is a
+// path item keyed by name rather than by URL
+func (in *openAPI3Ingester) extractWebhooks(webhooks map[string]interface{}) {
+	for _, name := range sortedKeys(webhooks) {
+		pathItem, _ := webhooks[name].(map[string]interface{})
+		pointer := resolver.JoinPointer(in.webhookSection, name)
+		if ref, ok := pathItem["$ref"].(string); ok {
+			in.warn(resolver.JoinPointer(pointer, "$ref"), "webhook %s references %s, which is not resolved", name, ref)
+			continue
+		}
+
+		if item, ok := pathItemFrom(name, pathItem); ok {
+			in.api.WebhookItems = append(in.api.WebhookItems, item)
+		}
+
+		pathParams := in.operationParameters(pathItem["parameters"], "webhook-"+name)
+
+		for _, method := range httpMethods {
+			operation, ok := pathItem[method].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			in.api.Webhooks = append(in.api.Webhooks, in.endpointFromOperation("webhook", name, method, operation, pathParams))
+		}
+	}
+}
+
+// pathItemFrom keeps the fields of a path item that apply to all of its
+// operations; ok is false when it has none
+func pathItemFrom(path string, pathItem map[string]interface{}) (domain.PathItem, bool) {
+	item := domain.PathItem{
+		Path:        path,
+		Summary:     getStringValue(pathItem, "summary", ""),
+		Description: getStringValue(pathItem, "description", ""),
+		Servers:     serversFromList(pathItem["servers"]),
+		Extensions:  extensionsOf(pathItem),
+	}
+	ok := item.Summary != "" || item.Description != "" || item.Servers != nil || item.Extensions != nil
+	return item, ok
+}
+
+func (in *openAPI3Ingester) endpointFromOperation(prefix, path, method string, operation map[string]interface{}, pathParams []string) domain.Endpoint {
+	operationID := getStringValue(operation, "operationId", "")
+	key := operationID
+	if key == "" {
+		key = method + "-" + path
+	}
+
+	endpoint := domain.Endpoint{
+		ID:           in.ids.next(prefix, key),
+		Path:         path,
+		Method:       strings.ToUpper(method),
+		OperationID:  operationID,
+		Summary:      getStringValue(operation, "summary", ""),
+		Description:  getStringValue(operation, "description", ""),
+		Tags:         getStringSlice(operation, "tags"),
+		Responses:    make(map[string]string),
+		Servers:      serversFromList(operation["servers"]),
+		ExternalDocs: externalDocsFromMap(getMap(operation, "externalDocs")),
+		Deprecated:   getBoolValue(operation, "deprecated"),
+		Extensions:   extensionsOf(operation),
+	}
+
+	if callbacks := getMap(operation, "callbacks"); callbacks != nil {
+		endpoint.Callbacks = in.normalizeSchema(callbacks).(map[string]interface{})
+	}
+
+	endpoint.Parameters = in.mergeParameters(pathParams, in.operationParameters(operation["parameters"], key))
+
+	if body, ok := operation["requestBody"].(map[string]interface{}); ok {
+		if ref, ok := body["$ref"].(string); ok {
+			endpoint.RequestBody = in.resolveRef(ref)
+		} else {
+			requestBody := in.requestBodyFromMap(in.ids.next("request-body", key), body)
+			in.api.RequestBodies = append(in.api.RequestBodies, requestBody)
+			endpoint.RequestBody = requestBody.ID
+		}
+	}
+
+	responses := getMap(operation, "responses")
+	for _, code := range sortedKeys(responses) {
+		raw, _ := responses[code].(map[string]interface{})
+		if ref, ok := raw["$ref"].(string); ok {
+			endpoint.Responses[code] = in.resolveRef(ref)
+			continue
+		}
+		response := in.responseFromMap(in.ids.next("response", key+"-"+code), raw)
+		in.api.Responses = append(in.api.Responses, response)
+		endpoint.Responses[code] = response.ID
+	}
+
+	section := "/paths"
+	if prefix == "webhook" {
+		section = in.webhookSection
+	}
+	endpoint.Security = in.securityRequirements(operation["security"], path+"/"+method+"/security",
+		resolver.JoinPointer(section, path, method, "security"))
+
+	return endpoint
+}
+
+// operationParameters converts a parameters array and returns the IDs of its entries
+func (in *openAPI3Ingester) operationParameters(value interface{}, owner string) []string {
+	list, _ := value.([]interface{})
+	ids := make([]string, 0, len(list))
+
+	for _, item := range list {
+		raw, _ := item.(map[string]interface{})
+		if ref, ok := raw["$ref"].(string); ok {
+			ids = append(ids, in.resolveRef(ref))
+			continue
+		}
+		name := getStringValue(raw, "name", "")
+		param := in.parameterFromMap(in.ids.next("param", owner+"-"+name), raw)
+		in.addParameter(param)
+		ids = append(ids, param.ID)
+	}
+
+	return ids
+}
+
+// mergeParameters combines path-level and operation-level parameter IDs; an
+// operation parameter overrides a path parameter with the same name and location
+func (in *openAPI3Ingester) mergeParameters(pathParams, opParams []string) []string {
+	overridden := make(map[string]bool)
+	for _, id := range opParams {
+		if param, ok := in.params[id]; ok {
+			overridden[param.In+":"+param.Name] = true
+		}
+	}
+
+	merged := []string{}
+	for _, id := range pathParams {
+		if param, ok := in.params[id]; ok && overridden[param.In+":"+param.Name] {
+			continue
+		}
+		merged = append(merged, id)
+	}
+
+	return append(merged, opParams...)
+}
+
+func (in *openAPI3Ingester) addParameter(param domain.Parameter) {
+	in.api.Parameters = append(in.api.Parameters, param)
+	in.params[param.ID] = param
+}
+
+func (in *openAPI3Ingester) schemaFromMap(id, name string, raw map[string]interface{}) domain.Schema {
+	schema := domain.Schema{
+		ID:                    id,
+		Name:                  name,
+		Type:                  getStringValue(raw, "type", ""),
+		Title:                 getStringValue(raw, "title", ""),
+		Required:              getStringSlice(raw, "required"),
+		AdditionalProperties:  in.normalizeSchema(raw["additionalProperties"]),
+		UnevaluatedProperties: in.normalizeSchema(raw["unevaluatedProperties"]),
+		PropertyNames:         in.normalizeSchema(raw["propertyNames"]),
+		Items:                 in.schemaLink(raw["items"]),
+		PrefixItems:           in.normalizeSchemaList(raw["prefixItems"]),
+		UnevaluatedItems:      in.normalizeSchema(raw["unevaluatedItems"]),
+		Contains:              in.normalizeSchema(raw["contains"]),
+		MinContains:           getIntPtr(raw, "minContains"),
+		MaxContains:           getIntPtr(raw, "maxContains"),
+		AllOf:                 in.normalizeSchemaList(raw["allOf"]),
+		OneOf:                 in.normalizeSchemaList(raw["oneOf"]),
+		AnyOf:                 in.normalizeSchemaList(raw["anyOf"]),
+		Not:                   in.normalizeSchema(raw["not"]),
+		If:                    in.normalizeSchema(raw["if"]),
+		Then:                  in.normalizeSchema(raw["then"]),
+		Else:                  in.normalizeSchema(raw["else"]),
+		Discriminator:         in.normalizeSchema(raw["discriminator"]),
+		Format:                getStringValue(raw, "format", ""),
+		Pattern:               getStringValue(raw, "pattern", ""),
+		MinLength:             getIntPtr(raw, "minLength"),
+		MaxLength:             getIntPtr(raw, "maxLength"),
+		MinItems:              getIntPtr(raw, "minItems"),
+		MaxItems:              getIntPtr(raw, "maxItems"),
+		Minimum:               getFloatPtr(raw, "minimum"),
+		Maximum:               getFloatPtr(raw, "maximum"),
+		ExclusiveMinimum:      getBoolValue(raw, "exclusiveMinimum"),
+		ExclusiveMaximum:      getBoolValue(raw, "exclusiveMaximum"),
+		ExclusiveMinimumValue: getFloatPtr(raw, "exclusiveMinimum"),
+		ExclusiveMaximumValue: getFloatPtr(raw, "exclusiveMaximum"),
+		MultipleOf:            getFloatPtr(raw, "multipleOf"),
+		UniqueItems:           getBoolValue(raw, "uniqueItems"),
+		MinProperties:         getIntPtr(raw, "minProperties"),
+		MaxProperties:         getIntPtr(raw, "maxProperties"),
+		Nullable:              getBoolValue(raw, "nullable"),
+		ReadOnly:              getBoolValue(raw, "readOnly"),
+		WriteOnly:             getBoolValue(raw, "writeOnly"),
+		Deprecated:            getBoolValue(raw, "deprecated"),
+		Default:               raw["default"],
+		Const:                 raw["const"],
+		Example:               raw["example"],
+		XML:                   raw["xml"],
+		ExternalDocs:          externalDocsFromMap(getMap(raw, "externalDocs")),
+		Description:           getStringValue(raw, "description", ""),
+		Extensions:            extensionsOf(raw),
+	}
+
+	// OpenAPI 3.1 allows a list of types, with "null" replacing nullable
+	if types, ok := raw["type"].([]interface{}); ok {
+		schema.Types = toStringSlice(types)
+		for _, t := range schema.Types {
+			if t == "null" {
+				schema.Nullable = true
+			} else if schema.Type == "" {
+				schema.Type = t
+			}
+		}
+		if len(schema.Types) <= 2 && (len(schema.Types) == 1 || schema.Nullable) {
+			schema.Types = nil
+		}
+	}
+
+	if examples, ok := raw["examples"].([]interface{}); ok {
+		schema.Examples = examples
+	}
+	if defs, ok := raw["$defs"].(map[string]interface{}); ok {
+		schema.Defs = in.normalizeSchema(defs).(map[string]interface{})
+	}
+
+	if enum, ok := raw["enum"].([]interface{}); ok {
+		schema.Enum = enum
+	}
+
+	if properties, ok := raw["properties"].(map[string]interface{}); ok {
+		schema.Properties = make(map[string]interface{}, len(properties))
+		for key, prop := range properties {
+			schema.Properties[key] = in.normalizeSchema(prop)
+		}
+	}
+	if patterns, ok := raw["patternProperties"].(map[string]interface{}); ok {
+		schema.PatternProperties = in.normalizeSchema(patterns).(map[string]interface{})
+	}
+	if dependents, ok := raw["dependentSchemas"].(map[string]interface{}); ok {
+		schema.DependentSchemas = in.normalizeSchema(dependents).(map[string]interface{})
+	}
+	if dependents := getMap(raw, "dependentRequired"); dependents != nil {
+		schema.DependentRequired = make(map[string][]string, len(dependents))
+		for key := range dependents {
+			schema.DependentRequired[key] = getStringSlice(dependents, key)
+		}
+	}
+
+	if ref, ok := raw["$ref"].(string); ok {
+		if strings.HasPrefix(in.api.Metadata.SpecVersion, "3.1") {
+			// OpenAPI 3.1 applies the keywords next to a reference as well
+			schema.Ref = in.resolveRef(ref)
+		} else {
+			// A component that is only an alias for another schema, as
+			// OpenAPI 3.0 ignores any keywords next to the reference
+			schema.AllOf = []interface{}{map[string]interface{}{"$ref": in.resolveRef(ref)}}
+		}
+	}
+
+	if schema.Type == "" && schema.Properties != nil {
+		schema.Type = "object"
+	}
+
+	return schema
+}
+
+func (in *openAPI3Ingester) parameterFromMap(id string, raw map[string]interface{}) domain.Parameter {
+	param := domain.Parameter{
+		ID:              id,
+		Name:            getStringValue(raw, "name", ""),
+		In:              getStringValue(raw, "in", ""),
+		Description:     getStringValue(raw, "description", ""),
+		Required:        getBoolValue(raw, "required"),
+		Deprecated:      getBoolValue(raw, "deprecated"),
+		AllowEmptyValue: getBoolValue(raw, "allowEmptyValue"),
+		AllowReserved:   getBoolValue(raw, "allowReserved"),
+		Schema:          in.normalizeSchema(raw["schema"]),
+		Content:         in.contentFromMap(getMap(raw, "content")),
+		Style:           getStringValue(raw, "style", ""),
+		Example:         raw["example"],
+		Examples:        getMap(raw, "examples"),
+		Extensions:      extensionsOf(raw),
+	}
+
+	if explode, ok := raw["explode"].(bool); ok {
+		param.Explode = &explode
+	}
+
+	return param
+}
+
+func (in *openAPI3Ingester) responseFromMap(id string, raw map[string]interface{}) domain.Response {
+	headers := getMap(raw, "headers")
+	if headers != nil {
+		headers = in.normalizeSchema(headers).(map[string]interface{})
+	}
+
+	return domain.Response{
+		ID:          id,
+		Description: getStringValue(raw, "description", ""),
+		Headers:     headers,
+		Content:     in.contentFromMap(getMap(raw, "content")),
+		Links:       getMap(raw, "links"),
+		Extensions:  extensionsOf(raw),
+	}
+}
+
+func (in *openAPI3Ingester) requestBodyFromMap(id string, raw map[string]interface{}) domain.RequestBody {
+	content := in.contentFromMap(getMap(raw, "content"))
+	if content == nil {
+		content = map[string]domain.MediaType{}
+	}
+
+	return domain.RequestBody{
+		ID:          id,
+		Description: getStringValue(raw, "description", ""),
+		Content:     content,
+		Required:    getBoolValue(raw, "required"),
+		Extensions:  extensionsOf(raw),
+	}
+}
+
+func (in *openAPI3Ingester) contentFromMap(content map[string]interface{}) map[string]domain.MediaType {
+	if content == nil {
+		return nil
+	}
+
+	result := make(map[string]domain.MediaType, len(content))
+	for mediaType, value := range content {
+		raw, _ := value.(map[string]interface{})
+		media := domain.MediaType{
+			Schema:     in.schemaLink(raw["schema"]),
+			Example:    raw["example"],
+			Examples:   getMap(raw, "examples"),
+			Extensions: extensionsOf(raw),
+		}
+		if encoding := getMap(raw, "encoding"); encoding != nil {
+			media.Encoding = in.normalizeSchema(encoding).(map[string]interface{})
+		}
+		result[mediaType] = media
+	}
+
+	return result
+}
+
+// securityRequirements rewrites requirement keys from scheme names to scheme
+// IDs; path names the requirements in warnings and pointer locates them
+func (in *openAPI3Ingester) securityRequirements(value interface{}, path, pointer string) []domain.SecurityRequirement {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	requirements := make([]domain.SecurityRequirement, 0, len(list))
+	for i, item := range list {
+		raw, _ := item.(map[string]interface{})
+		requirement := domain.SecurityRequirement{}
+		for name, scopes := range raw {
+			id, ok := in.refs["#/components/securitySchemes/"+escapePointerToken(name)]
+			if !ok {
+				in.warn(resolver.JoinPointer(pointer, strconv.Itoa(i), name), "%s references undefined security scheme %s", path, name)
+				id = name
+			}
+			requirement[id] = toStringSlice(scopes)
+		}
+		requirements = append(requirements, requirement)
+	}
+
+	return requirements
+}
+
+// schemaLink returns the schema ID when value is a plain reference to a
+// component schema, and the normalized inline schema otherwise
+func (in *openAPI3Ingester) schemaLink(value interface{}) interface{} {
+	if raw, ok := value.(map[string]interface{}); ok && len(raw) == 1 {
+		if ref, ok := raw["$ref"].(string); ok {
+			if id, ok := in.refs[ref]; ok {
+				return id
+			}
+		}
+	}
+
+	return in.normalizeSchema(value)
+}
+
+// normalizeSchema walks a raw schema fragment and rewrites every $ref to the
+// ID of the component it points at
+func (in *openAPI3Ingester) normalizeSchema(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			if ref, ok := item.(string); ok && key == "$ref" {
+				result[key] = in.resolveRef(ref)
+				continue
+			}
+			result[key] = in.normalizeSchema(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = in.normalizeSchema(item)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+func (in *openAPI3Ingester) normalizeSchemaList(value interface{}) []interface{} {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	return in.normalizeSchema(list).([]interface{})
+}
+
+// deref returns the object a component stands for, following the component
+// when it is only a reference to another one
+func (in *openAPI3Ingester) deref(value interface{}) map[string]interface{} {
+	raw, _ := value.(map[string]interface{})
+	ref, ok := raw["$ref"].(string)
+	if !ok {
+		return raw
+	}
+
+	target, err := in.resolver.Resolve("", ref)
+	if err != nil {
+		in.warn(resolver.FindRef(in.spec, ref), "%v", err)
+		return raw
+	}
+
+	resolved, _ := target.Value.(map[string]interface{})
+	return resolved
+}
+
+// resolveRef maps a component pointer to its normalized ID. Other pointers,
+// such as references to raw component sections or into other files, are
+// returned unchanged.
+func (in *openAPI3Ingester) resolveRef(ref string) string {
+	if id, ok := in.refs[ref]; ok {
+		return id
+	}
+
+	if _, err := in.resolver.Resolve("", ref); err == nil {
+		return ref
+	}
+
+	in.warn(resolver.FindRef(in.spec, ref), "unresolved reference %s was kept as-is", ref)
+	return ref
+}
+
+// collectTags returns the declared tags followed by any tag only used on operations
+func (in *openAPI3Ingester) collectTags() []string {
+	seen := make(map[string]bool)
+	var tags []string
+
+	add := func(tag string) {
+		if tag != "" && !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+
+	for _, tag := range in.api.Tags {
+		add(tag.Name)
+	}
+
+	for _, endpoint := range in.api.Endpoints {
+		for _, tag := range endpoint.Tags {
+			add(tag)
+		}
+	}
+
+	return tags
+}
+
+// warn records a warning about the value at pointer in the ingested document
+func (in *openAPI3Ingester) warn(pointer, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	for _, existing := range in.warnings {
+		if existing.Message == message {
+			return
+		}
+	}
+	in.warnings = append(in.warnings, domain.Warning{Path: pointer, Message: message})
+}
+
+// idRegistry hands out readable IDs that are unique within one definition
+type idRegistry struct {
+	used map[string]bool
+}
+
+var idUnsafeChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+func newIDRegistry() *idRegistry {
+	return &idRegistry{used: make(map[string]bool)}
+}
+
+// next returns "<prefix>-<sanitized name>", adding a numeric suffix on collision
+func (r *idRegistry) next(prefix, name string) string {
+	base := prefix
+	if sanitized := strings.Trim(idUnsafeChars.ReplaceAllString(strings.ToLower(name), "-"), "-"); sanitized != "" {
+		base = prefix + "-" + sanitized
+	}
+
+	id := base
+	for i := 2; r.used[id]; i++ {
+		id = fmt.Sprintf("%s-%d", base, i)
+	}
+	r.used[id] = true
+
+	return id
+}
+
+func extractContact(info map[string]interface{}) *domain.Contact {
+	contact := getMap(info, "contact")
+	if contact == nil {
+		return nil
+	}
+
+	return &domain.Contact{
+		Name:  getStringValue(contact, "name", ""),
+		URL:   getStringValue(contact, "url", ""),
+		Email: getStringValue(contact, "email", ""),
+	}
+}
+
+func extractLicense(info map[string]interface{}) *domain.License {
+	license := getMap(info, "license")
+	if license == nil {
+		return nil
+	}
+
+	return &domain.License{
+		Name:       getStringValue(license, "name", ""),
+		Identifier: getStringValue(license, "identifier", ""),
+		URL:        getStringValue(license, "url", ""),
+	}
+}
+
+func externalDocsFromMap(raw map[string]interface{}) *domain.ExternalDocs {
+	if raw == nil {
+		return nil
+	}
+
+	return &domain.ExternalDocs{
+		Description: getStringValue(raw, "description", ""),
+		URL:         getStringValue(raw, "url", ""),
+	}
+}
+
+func serversFromList(value interface{}) []domain.Server {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	servers := make([]domain.Server, 0, len(list))
+	for _, item := range list {
+		raw, _ := item.(map[string]interface{})
+		servers = append(servers, domain.Server{
+			URL:         getStringValue(raw, "url", ""),
+			Description: getStringValue(raw, "description", ""),
+			Variables:   getMap(raw, "variables"),
+			Extensions:  extensionsOf(raw),
+		})
+	}
+	return servers
+}
+
+func tagsFromList(value interface{}) []domain.Tag {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	tags := make([]domain.Tag, 0, len(list))
+	for _, item := range list {
+		raw, _ := item.(map[string]interface{})
+		tags = append(tags, domain.Tag{
+			Name:         getStringValue(raw, "name", ""),
+			Description:  getStringValue(raw, "description", ""),
+			ExternalDocs: externalDocsFromMap(getMap(raw, "externalDocs")),
+			Extensions:   extensionsOf(raw),
+		})
+	}
+	return tags
+}
+
+// extensionsOf returns the x- fields of an object, or nil when it has none
+func extensionsOf(raw map[string]interface{}) map[string]interface{} {
+	var extensions map[string]interface{}
+	for key, value := range raw {
+		if strings.HasPrefix(key, "x-") {
+			if extensions == nil {
+				extensions = make(map[string]interface{})
+			}
+			extensions[key] = value
+		}
+	}
+	return extensions
+}
+
+// escapePointerToken escapes a map key for use in a JSON Pointer (RFC 6901)
+func escapePointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
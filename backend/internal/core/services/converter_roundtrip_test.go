@@ -0,0 +1,405 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// fixturesDir holds the sample specifications shared with the frontend tests
+const fixturesDir = "../../../../frontend/tests/fixtures"
+
+// TestRoundTripFixtures imports every fixture, exports it again and checks
+// that the exported document says the same thing as the original
+func TestRoundTripFixtures(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join(fixturesDir, "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Skipf("no fixtures found in %s", fixturesDir)
+	}
+
+	for _, file := range files {
+		file := file
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			original, err := parseSpec(string(content))
+			if err != nil {
+				t.Fatalf("fixture does not parse: %v", err)
+			}
+
+			if original["openapi"] == nil && original["swagger"] == nil {
+				assertNormalizedRoundTrip(t, content)
+				return
+			}
+			assertSpecRoundTrip(t, string(content), original)
+		})
+	}
+}
+
+// TestRoundTripPathItemFields keeps the summary, description, servers and
+// extensions that paths and webhooks declare for all of their operations
+func TestRoundTripPathItemFields(t *testing.T) {
+	specs := map[string]string{
+		"paths": `
+openapi: 3.0.3
+info:
+  title: Pets
+  version: 1.0.0
+paths:
+  /pets:
+    summary: Pets
+    description: Every pet in the store
+    servers:
+      - url: https://pets.example.com
+    x-owner: pets-team
+    parameters:
+      - name: limit
+        in: query
+        schema:
+          type: integer
+    get:
+      responses:
+        "200":
+          description: The pets
+  /archive:
+    summary: Kept without operations
+`,
+		"webhooks": `
+openapi: 3.1.0
+info:
+  title: Pets
+  version: 1.0.0
+webhooks:
+  newPet:
+    summary: A pet was added
+    x-retries: 3
+    post:
+      responses:
+        "200":
+          description: Received
+`,
+	}
+	for name, content := range specs {
+		t.Run(name, func(t *testing.T) {
+			original, err := parseSpec(content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertSpecRoundTrip(t, content, original)
+		})
+	}
+}
+
+// TestRoundTripSchemaKeywords keeps the keywords of named schemas, which are
+// stored as fields rather than as raw maps: JSON Schema 2020-12 applicators,
+// numeric exclusive bounds, references with sibling keywords and limits set
+// to zero
+func TestRoundTripSchemaKeywords(t *testing.T) {
+	specs := map[string]string{
+		"applicators": `
+openapi: 3.1.0
+info:
+  title: Pets
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Tag:
+      type: string
+    Label:
+      $ref: "#/components/schemas/Tag"
+      description: Tags double as labels
+    Labels:
+      type: object
+      patternProperties:
+        "^x-":
+          $ref: "#/components/schemas/Tag"
+      propertyNames:
+        maxLength: 20
+      dependentRequired:
+        billing: [address]
+      dependentSchemas:
+        discount:
+          required: [code]
+      unevaluatedProperties: false
+    Tags:
+      type: array
+      contains:
+        $ref: "#/components/schemas/Tag"
+      minContains: 1
+      maxContains: 3
+      unevaluatedItems: false
+    Shipment:
+      type: object
+      if:
+        properties:
+          country:
+            const: US
+      then:
+        required: [zip]
+      else:
+        required: [postcode]
+`,
+		"bounds": `
+openapi: 3.1.0
+info:
+  title: Pets
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Weight:
+      type: number
+      minimum: 1
+      exclusiveMinimum: 0
+      maximum: 50
+      exclusiveMaximum: 40
+`,
+		"empty arrays": `
+openapi: 3.1.0
+info:
+  title: Pets
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    None:
+      type: array
+      minItems: 0
+      maxItems: 0
+`,
+	}
+	for name, content := range specs {
+		t.Run(name, func(t *testing.T) {
+			original, err := parseSpec(content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertSpecRoundTrip(t, content, original)
+
+			// OpenAPI 3.0 has none of these keywords
+			exported := exportYAML(t, importSpec(t, content), "3.0")
+			for _, keyword := range []string{"patternProperties", "propertyNames", "dependentRequired", "contains", "if"} {
+				if pointer := findKey(exported, "", keyword); pointer != "" {
+					t.Errorf("%s is left at %s in the 3.0 export", keyword, pointer)
+				}
+			}
+		})
+	}
+}
+
+// assertSpecRoundTrip checks that import followed by export reproduces the
+// document. Swagger 2.0 input is compared with its OpenAPI 3.0 upgrade.
+func assertSpecRoundTrip(t *testing.T, content string, original map[string]interface{}) {
+	expected := original
+	if isSwagger2(original) {
+		expected = newSwagger2Upgrader(original).upgrade()
+	}
+
+	exported := exportYAML(t, importSpec(t, content), "")
+
+	assertEquivalent(t, canonicalSpec(t, expected), canonicalSpec(t, exported))
+}
+
+// assertNormalizedRoundTrip checks that a normalized definition exports to a
+// document that survives another import and export unchanged
+func assertNormalizedRoundTrip(t *testing.T, content []byte) {
+	var api domain.APIDefinition
+	if err := json.Unmarshal(content, &api); err != nil {
+		t.Fatalf("fixture is not a normalized definition: %v", err)
+	}
+
+	first := exportYAML(t, &api, "")
+	data, err := json.Marshal(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second := exportYAML(t, importSpec(t, string(data)), "")
+
+	assertEquivalent(t, canonicalSpec(t, first), canonicalSpec(t, second))
+}
+
+func importSpec(t *testing.T, content string) *domain.APIDefinition {
+	t.Helper()
+
+	converter := &ConverterService{}
+	result, err := converter.ConvertSwaggerToJSON(context.Background(), &domain.ConversionRequest{SwaggerContent: content})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Success {
+		t.Fatalf("import failed: %s", result.Error)
+	}
+	return result.Data
+}
+
+func exportYAML(t *testing.T, api *domain.APIDefinition, version string) map[string]interface{} {
+	t.Helper()
+
+	converter := &ConverterService{}
+	output, _, err := converter.ConvertJSONToSwagger(context.Background(), api, "yaml", version)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exported, err := parseSpec(output)
+	if err != nil {
+		t.Fatalf("export is not valid YAML: %v", err)
+	}
+	return exported
+}
+
+// canonicalSpec rewrites a document into a form where equivalent documents
+// compare equal: numbers share one representation, the version is reduced
+// to major.minor and path-level parameters are pushed down to operations.
+func canonicalSpec(t *testing.T, spec map[string]interface{}) map[string]interface{} {
+	t.Helper()
+
+	data, err := json.Marshal(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var canonical map[string]interface{}
+	if err := json.Unmarshal(data, &canonical); err != nil {
+		t.Fatal(err)
+	}
+
+	if version, ok := canonical["openapi"].(string); ok {
+		parts := strings.SplitN(version, ".", 3)
+		canonical["openapi"] = strings.Join(parts[:2], ".")
+	}
+
+	for _, section := range []string{"paths", "webhooks", "x-webhooks"} {
+		for _, item := range getMap(canonical, section) {
+			pushDownParameters(item.(map[string]interface{}))
+		}
+	}
+
+	dropFalseDefaults(canonical)
+	return canonical
+}
+
+// falseDefaults are the boolean fields whose absence means false
+var falseDefaults = map[string]bool{
+	"required": true, "deprecated": true, "allowEmptyValue": true, "allowReserved": true,
+	"nullable": true, "readOnly": true, "writeOnly": true, "uniqueItems": true,
+}
+
+// dropFalseDefaults removes explicit false values that only restate a default
+func dropFalseDefaults(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if falseDefaults[key] && item == false {
+				delete(v, key)
+				continue
+			}
+			dropFalseDefaults(item)
+		}
+	case []interface{}:
+		for _, item := range v {
+			dropFalseDefaults(item)
+		}
+	}
+}
+
+// pushDownParameters moves path-level parameters into each operation, which
+// is how the normalized model stores them
+func pushDownParameters(pathItem map[string]interface{}) {
+	shared, _ := pathItem["parameters"].([]interface{})
+	delete(pathItem, "parameters")
+	if len(shared) == 0 {
+		return
+	}
+
+	key := func(param interface{}) string {
+		raw, _ := param.(map[string]interface{})
+		if ref, ok := raw["$ref"].(string); ok {
+			return ref
+		}
+		return fmt.Sprint(raw["in"], ":", raw["name"])
+	}
+
+	for _, method := range httpMethods {
+		operation, ok := pathItem[method].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		own, _ := operation["parameters"].([]interface{})
+		overridden := make(map[string]bool, len(own))
+		for _, param := range own {
+			overridden[key(param)] = true
+		}
+
+		var merged []interface{}
+		for _, param := range shared {
+			if !overridden[key(param)] {
+				merged = append(merged, param)
+			}
+		}
+		operation["parameters"] = append(merged, own...)
+	}
+}
+
+func assertEquivalent(t *testing.T, expected, actual interface{}) {
+	t.Helper()
+
+	var diffs []string
+	collectDiffs("", expected, actual, &diffs)
+	for _, diff := range diffs {
+		t.Error(diff)
+	}
+}
+
+// collectDiffs records the JSON Pointer of every value that differs
+func collectDiffs(pointer string, expected, actual interface{}, diffs *[]string) {
+	expectedMap, expectedIsMap := expected.(map[string]interface{})
+	actualMap, actualIsMap := actual.(map[string]interface{})
+	if expectedIsMap && actualIsMap {
+		keys := make(map[string]bool)
+		for key := range expectedMap {
+			keys[key] = true
+		}
+		for key := range actualMap {
+			keys[key] = true
+		}
+		sorted := make([]string, 0, len(keys))
+		for key := range keys {
+			sorted = append(sorted, key)
+		}
+		sort.Strings(sorted)
+
+		for _, key := range sorted {
+			collectDiffs(pointer+"/"+escapePointerToken(key), expectedMap[key], actualMap[key], diffs)
+		}
+		return
+	}
+
+	expectedList, expectedIsList := expected.([]interface{})
+	actualList, actualIsList := actual.([]interface{})
+	if expectedIsList && actualIsList && len(expectedList) == len(actualList) {
+		for i := range expectedList {
+			collectDiffs(fmt.Sprintf("%s/%d", pointer, i), expectedList[i], actualList[i], diffs)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		*diffs = append(*diffs, fmt.Sprintf("%s: expected %v, got %v", pointer, expected, actual))
+	}
+}
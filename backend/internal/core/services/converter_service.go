@@ -4,9 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 
 	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/resolver"
 	"gopkg.in/yaml.v3"
 )
 
@@ -16,188 +16,228 @@ type ConverterService struct {
 
 // ConvertSwaggerToJSON converts Swagger/OpenAPI to normalized JSON
 func (s *ConverterService) ConvertSwaggerToJSON(ctx context.Context, request *domain.ConversionRequest) (*domain.ConversionResponse, error) {
-	// This is a simplified implementation
-	// In production, you would use the TypeScript converter or a Go equivalent
+	bundle, err := parseBundle(request.SwaggerContent, request.Files)
+	if err != nil {
+		return &domain.ConversionResponse{
+			Success: false,
+			Error:   "Failed to parse Swagger content: " + err.Error(),
+		}, nil
+	}
 
-	// Parse the content to detect format
-	var spec map[string]interface{}
-	var err error
+	// Pull referenced files into the root document so a single document is ingested
+	spec, refErrors := bundle.Bundle()
+	if spec == nil {
+		return &domain.ConversionResponse{
+			Success: false,
+			Error:   "Failed to parse Swagger content: " + refErrors[0].Message,
+		}, nil
+	}
 
-	// Try JSON first
-	err = json.Unmarshal([]byte(request.SwaggerContent), &spec)
-	if err != nil {
-		// Try YAML
-		err = yaml.Unmarshal([]byte(request.SwaggerContent), &spec)
-		if err != nil {
-			return &domain.ConversionResponse{
-				Success: false,
-				Error:   "Failed to parse Swagger content: " + err.Error(),
-			}, nil
-		}
+	document := spec
+	if isSwagger2(spec) {
+		document = newSwagger2Upgrader(spec).upgrade()
 	}
 
-	// Extract basic information
-	info, _ := spec["info"].(map[string]interface{})
-	paths, _ := spec["paths"].(map[string]interface{})
-
-	// Create a simplified API definition
-	api := &domain.APIDefinition{
-		ID: "generated-" + generateID(),
-		Metadata: domain.APIMetadata{
-			Name:        getStringValue(info, "title", "Untitled API"),
-			Version:     getStringValue(info, "version", "1.0.0"),
-			Description: getStringValue(info, "description", ""),
-		},
-		Endpoints:     extractEndpoints(paths),
-		Schemas:       []domain.Schema{},
-		Parameters:    []domain.Parameter{},
-		Responses:     []domain.Response{},
-		RequestBodies: []domain.RequestBody{},
-	}
-
-	// Extract servers/host for base URL
-	if servers, ok := spec["servers"].([]interface{}); ok && len(servers) > 0 {
-		if server, ok := servers[0].(map[string]interface{}); ok {
-			api.Metadata.BaseURL = getStringValue(server, "url", "")
-		}
-	} else if host, ok := spec["host"].(string); ok {
-		scheme := "https"
-		if schemes, ok := spec["schemes"].([]interface{}); ok && len(schemes) > 0 {
-			scheme = schemes[0].(string)
+	api, warnings := newOpenAPI3Ingester(document).ingest()
+	if isSwagger2(spec) {
+		for i := range warnings {
+			warnings[i].Path = swagger2SourcePointer(warnings[i].Path)
 		}
-		basePath := spec["basePath"]
-		if basePath == nil {
-			basePath = ""
-		}
-		api.Metadata.BaseURL = fmt.Sprintf("%s://%s%s", scheme, host, basePath)
 	}
+	for _, refError := range refErrors {
+		warnings = append(warnings, domain.Warning{
+			Path:    refError.Path,
+			Message: fmt.Sprintf("%s: %s", refError.Path, refError.Message),
+		})
+	}
+	if isSwagger2(spec) {
+		api.Metadata.SpecVersion = getStringValue(spec, "swagger", "")
+	}
+
+	if spec["openapi"] == nil && spec["swagger"] == nil {
+		warnings = append(warnings, domain.Warning{Path: "/", Message: "No OpenAPI or Swagger version specified"})
+	}
+	if len(getMap(spec, "paths")) == 0 && len(api.Webhooks) == 0 {
+		warnings = append(warnings, domain.Warning{Path: "/paths", Message: "No paths defined in the specification"})
+	}
+
+	messages, details := newSourceLocator(request.SwaggerContent, request.Files).warnings(warnings)
 
 	return &domain.ConversionResponse{
-		Success:  true,
-		Data:     api,
-		Warnings: []string{"This is a simplified conversion. Full conversion logic to be implemented."},
+		Success:        true,
+		Data:           api,
+		Warnings:       messages,
+		WarningDetails: details,
 	}, nil
 }
 
-// ConvertJSONToSwagger converts normalized JSON back to Swagger/OpenAPI
-func (s *ConverterService) ConvertJSONToSwagger(ctx context.Context, api *domain.APIDefinition, format string) (string, error) {
-	// Create OpenAPI 3.0 structure
-	swagger := map[string]interface{}{
-		"openapi": "3.0.0",
-		"info": map[string]interface{}{
-			"title":       api.Metadata.Name,
-			"version":     api.Metadata.Version,
-			"description": api.Metadata.Description,
-		},
-		"paths": buildPaths(api.Endpoints),
-	}
-
-	if api.Metadata.BaseURL != "" {
-		swagger["servers"] = []map[string]string{
-			{"url": api.Metadata.BaseURL},
-		}
+// ConvertJSONToSwagger converts normalized JSON back to Swagger/OpenAPI. The
+// version selects the OpenAPI version to emit (3.0.x or 3.1.x); when empty,
+// definitions imported from 3.1 stay on 3.1 and everything else becomes 3.0.3.
+// The warnings list every keyword that was dropped or rewritten for 3.0.
+func (s *ConverterService) ConvertJSONToSwagger(ctx context.Context, api *domain.APIDefinition, format string, version string) (string, []domain.Warning, error) {
+	exporter, err := newSwaggerExporter(api, version)
+	if err != nil {
+		return "", nil, err
 	}
+	swagger, warnings := exporter.export()
 
 	// Convert to requested format
 	if format == "json" {
 		bytes, err := json.MarshalIndent(swagger, "", "  ")
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
-		return string(bytes), nil
+		return string(bytes), warnings, nil
 	}
 
 	// Default to YAML
 	bytes, err := yaml.Marshal(swagger)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
-	return string(bytes), nil
+	return string(bytes), warnings, nil
 }
 
 // Helper functions
 
-func generateID() string {
-	return fmt.Sprintf("%d", 1234567890)
-}
+// parseBundle decodes the root document and any additional files of a
+// multi-file specification into a resolver. The root document is stored
+// under the empty path.
+func parseBundle(content string, files map[string]string) (*resolver.Resolver, error) {
+	root, err := parseSpec(content)
+	if err != nil {
+		return nil, err
+	}
 
-func getStringValue(m map[string]interface{}, key, defaultValue string) string {
-	if val, ok := m[key].(string); ok {
-		return val
+	documents := map[string]interface{}{"": root}
+	for name, fileContent := range files {
+		document, err := parseDocument(fileContent)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		documents[name] = document
 	}
-	return defaultValue
+
+	return resolver.New("", documents), nil
 }
 
-func extractEndpoints(paths map[string]interface{}) []domain.Endpoint {
-	endpoints := []domain.Endpoint{}
-
-	for path, pathItem := range paths {
-		if pathMap, ok := pathItem.(map[string]interface{}); ok {
-			for method, operation := range pathMap {
-				if opMap, ok := operation.(map[string]interface{}); ok {
-					endpoint := domain.Endpoint{
-						ID:          fmt.Sprintf("endpoint-%s-%s", method, strings.ReplaceAll(path, "/", "-")),
-						Path:        path,
-						Method:      strings.ToUpper(method),
-						Summary:     getStringValue(opMap, "summary", ""),
-						Description: getStringValue(opMap, "description", ""),
-						Responses:   make(map[string]string),
-					}
-
-					// Extract operation ID
-					if opId, ok := opMap["operationId"].(string); ok {
-						endpoint.OperationID = opId
-					}
-
-					// Extract tags
-					if tags, ok := opMap["tags"].([]interface{}); ok {
-						for _, tag := range tags {
-							if tagStr, ok := tag.(string); ok {
-								endpoint.Tags = append(endpoint.Tags, tagStr)
-							}
-						}
-					}
-
-					endpoints = append(endpoints, endpoint)
-				}
-			}
-		}
+// parseSpec decodes a JSON or YAML document into generic maps keyed by string
+func parseSpec(content string) (map[string]interface{}, error) {
+	document, err := parseDocument(content)
+	if err != nil {
+		return nil, err
 	}
 
-	return endpoints
+	spec, ok := document.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("document root must be an object")
+	}
+	return spec, nil
 }
 
-func buildPaths(endpoints []domain.Endpoint) map[string]interface{} {
-	paths := make(map[string]interface{})
+// parseDocument decodes any JSON or YAML value; referenced files may hold a
+// bare schema or even a scalar
+func parseDocument(content string) (interface{}, error) {
+	var document interface{}
 
-	for _, endpoint := range endpoints {
-		if paths[endpoint.Path] == nil {
-			paths[endpoint.Path] = make(map[string]interface{})
-		}
+	// Try JSON first
+	if err := json.Unmarshal([]byte(content), &document); err == nil {
+		return document, nil
+	}
 
-		pathItem := paths[endpoint.Path].(map[string]interface{})
+	// Try YAML
+	if err := yaml.Unmarshal([]byte(content), &document); err != nil {
+		return nil, err
+	}
+	return normalizeYAML(document), nil
+}
 
-		operation := map[string]interface{}{
-			"summary":     endpoint.Summary,
-			"description": endpoint.Description,
-			"responses": map[string]interface{}{
-				"200": map[string]interface{}{
-					"description": "Successful response",
-				},
-			},
+// normalizeYAML converts maps with non-string keys, such as unquoted response
+// codes, into maps keyed by string so YAML and JSON input look the same
+func normalizeYAML(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = normalizeYAML(item)
 		}
-
-		if endpoint.OperationID != "" {
-			operation["operationId"] = endpoint.OperationID
+		return v
+	case map[interface{}]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[fmt.Sprint(key)] = normalizeYAML(item)
 		}
-
-		if len(endpoint.Tags) > 0 {
-			operation["tags"] = endpoint.Tags
+		return result
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalizeYAML(item)
 		}
+		return v
+	default:
+		return value
+	}
+}
 
-		pathItem[strings.ToLower(endpoint.Method)] = operation
+func getStringValue(m map[string]interface{}, key, defaultValue string) string {
+	if val, ok := m[key].(string); ok {
+		return val
 	}
+	return defaultValue
+}
 
-	return paths
+func getMap(m map[string]interface{}, key string) map[string]interface{} {
+	val, _ := m[key].(map[string]interface{})
+	return val
 }
 
+func getBoolValue(m map[string]interface{}, key string) bool {
+	val, _ := m[key].(bool)
+	return val
+}
+
+func getStringSlice(m map[string]interface{}, key string) []string {
+	return toStringSlice(m[key])
+}
+
+func toStringSlice(value interface{}) []string {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	result := make([]string, 0, len(list))
+	for _, item := range list {
+		if str, ok := item.(string); ok {
+			result = append(result, str)
+		}
+	}
+	return result
+}
+
+func getFloatPtr(m map[string]interface{}, key string) *float64 {
+	var val float64
+	switch v := m[key].(type) {
+	case float64:
+		val = v
+	case int:
+		val = float64(v)
+	case int64:
+		val = float64(v)
+	case uint64:
+		val = float64(v)
+	case *float64:
+		return v
+	default:
+		return nil
+	}
+	return &val
+}
+
+func getIntPtr(m map[string]interface{}, key string) *int {
+	f := getFloatPtr(m, key)
+	if f == nil {
+		return nil
+	}
+	val := int(*f)
+	return &val
+}
@@ -0,0 +1,622 @@
+package services
+
+import (
+	"strings"
+)
+
+// swagger2SchemaKeywords are the parameter and header fields that describe the
+// value's schema in Swagger 2.0 and move under "schema" in OpenAPI 3
+var swagger2SchemaKeywords = []string{
+	"type", "format", "items", "default", "enum", "maximum", "exclusiveMaximum",
+	"minimum", "exclusiveMinimum", "maxLength", "minLength", "pattern",
+	"maxItems", "minItems", "uniqueItems", "multipleOf",
+}
+
+// swagger2RefPrefixes maps Swagger 2.0 reference prefixes to their OpenAPI 3 locations
+var swagger2RefPrefixes = map[string]string{
+	"#/definitions/": "#/components/schemas/",
+	"#/parameters/":  "#/components/parameters/",
+	"#/responses/":   "#/components/responses/",
+}
+
+// swagger2Upgrader rewrites a Swagger 2.0 document into the equivalent
+// OpenAPI 3.0 structure so it can go through the same ingestion path
+type swagger2Upgrader struct {
+	spec           map[string]interface{}
+	consumes       []string
+	produces       []string
+	bodyParams     map[string]map[string]interface{}
+	formDataParams map[string]map[string]interface{}
+}
+
+func newSwagger2Upgrader(spec map[string]interface{}) *swagger2Upgrader {
+	return &swagger2Upgrader{
+		spec:           spec,
+		consumes:       getStringSlice(spec, "consumes"),
+		produces:       getStringSlice(spec, "produces"),
+		bodyParams:     make(map[string]map[string]interface{}),
+		formDataParams: make(map[string]map[string]interface{}),
+	}
+}
+
+// isSwagger2 reports whether the document declares Swagger 2.0
+func isSwagger2(spec map[string]interface{}) bool {
+	version, _ := spec["swagger"].(string)
+	return strings.HasPrefix(version, "2.")
+}
+
+// upgrade returns the OpenAPI 3.0 form of the document
+func (u *swagger2Upgrader) upgrade() map[string]interface{} {
+	result := map[string]interface{}{
+		"openapi": "3.0.3",
+	}
+
+	for key, value := range u.spec {
+		switch key {
+		case "swagger", "host", "basePath", "schemes", "consumes", "produces",
+			"definitions", "parameters", "responses", "securityDefinitions", "paths":
+		default:
+			result[key] = value
+		}
+	}
+
+	if servers := u.servers(); len(servers) > 0 {
+		result["servers"] = servers
+	}
+
+	components := map[string]interface{}{}
+	if definitions := getMap(u.spec, "definitions"); definitions != nil {
+		schemas := make(map[string]interface{}, len(definitions))
+		for name, schema := range definitions {
+			schemas[name] = upgradeSwagger2Schema(schema)
+		}
+		components["schemas"] = schemas
+	}
+
+	parameters, requestBodies := u.upgradeGlobalParameters(getMap(u.spec, "parameters"))
+	if len(parameters) > 0 {
+		components["parameters"] = parameters
+	}
+	if len(requestBodies) > 0 {
+		components["requestBodies"] = requestBodies
+	}
+
+	if responses := getMap(u.spec, "responses"); responses != nil {
+		upgraded := make(map[string]interface{}, len(responses))
+		for name, response := range responses {
+			raw, _ := response.(map[string]interface{})
+			upgraded[name] = u.upgradeResponse(raw, u.produces)
+		}
+		components["responses"] = upgraded
+	}
+
+	if definitions := getMap(u.spec, "securityDefinitions"); definitions != nil {
+		schemes := make(map[string]interface{}, len(definitions))
+		for name, definition := range definitions {
+			raw, _ := definition.(map[string]interface{})
+			schemes[name] = upgradeSecurityDefinition(raw)
+		}
+		components["securitySchemes"] = schemes
+	}
+
+	if len(components) > 0 {
+		result["components"] = components
+	}
+
+	paths := getMap(u.spec, "paths")
+	upgradedPaths := make(map[string]interface{}, len(paths))
+	for path, item := range paths {
+		raw, _ := item.(map[string]interface{})
+		upgradedPaths[path] = u.upgradePathItem(raw)
+	}
+	result["paths"] = upgradedPaths
+
+	return result
+}
+
+// servers builds one server URL per declared scheme from host and basePath
+func (u *swagger2Upgrader) servers() []interface{} {
+	host := getStringValue(u.spec, "host", "")
+	basePath := getStringValue(u.spec, "basePath", "")
+	if host == "" {
+		if basePath == "" {
+			return nil
+		}
+		return []interface{}{map[string]interface{}{"url": basePath}}
+	}
+
+	schemes := getStringSlice(u.spec, "schemes")
+	if len(schemes) == 0 {
+		schemes = []string{"https"}
+	}
+
+	servers := make([]interface{}, 0, len(schemes))
+	for _, scheme := range schemes {
+		servers = append(servers, map[string]interface{}{"url": scheme + "://" + host + basePath})
+	}
+	return servers
+}
+
+// upgradeGlobalParameters splits the top-level parameters into OpenAPI 3
+// parameters and request bodies. formData parameters have no component
+// equivalent and are inlined into the operations that reference them.
+func (u *swagger2Upgrader) upgradeGlobalParameters(params map[string]interface{}) (map[string]interface{}, map[string]interface{}) {
+	parameters := map[string]interface{}{}
+	requestBodies := map[string]interface{}{}
+
+	for name, param := range params {
+		raw, _ := param.(map[string]interface{})
+		switch getStringValue(raw, "in", "") {
+		case "body":
+			u.bodyParams[name] = raw
+			requestBodies[name] = u.upgradeBodyParameter(raw, u.consumes)
+		case "formData":
+			u.formDataParams[name] = raw
+		default:
+			parameters[name] = upgradeSwagger2Parameter(raw)
+		}
+	}
+
+	return parameters, requestBodies
+}
+
+func (u *swagger2Upgrader) upgradePathItem(item map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	pathParams, _ := item["parameters"].([]interface{})
+
+	for key, value := range item {
+		switch {
+		case key == "parameters":
+			// Only parameters that stay parameters in OpenAPI 3 remain at path level
+			var kept []interface{}
+			for _, param := range pathParams {
+				if kind, _ := u.parameterKind(param); kind != "body" && kind != "formData" {
+					kept = append(kept, u.upgradeParameterEntry(param))
+				}
+			}
+			if len(kept) > 0 {
+				result["parameters"] = kept
+			}
+		case isHTTPMethod(key):
+			operation, _ := value.(map[string]interface{})
+			result[key] = u.upgradeOperation(operation, pathParams)
+		default:
+			result[key] = upgradeSwagger2Schema(value)
+		}
+	}
+
+	return result
+}
+
+func (u *swagger2Upgrader) upgradeOperation(operation map[string]interface{}, pathParams []interface{}) map[string]interface{} {
+	consumes := u.consumes
+	if _, ok := operation["consumes"]; ok {
+		consumes = getStringSlice(operation, "consumes")
+	}
+	produces := u.produces
+	if _, ok := operation["produces"]; ok {
+		produces = getStringSlice(operation, "produces")
+	}
+
+	result := map[string]interface{}{}
+	for key, value := range operation {
+		switch key {
+		case "consumes", "produces", "parameters", "responses", "schemes":
+		default:
+			result[key] = value
+		}
+	}
+
+	opParams, _ := operation["parameters"].([]interface{})
+
+	var parameters []interface{}
+	var body map[string]interface{}
+	var bodyRef string
+	var formFields []map[string]interface{}
+
+	// Body and form parameters declared on the path apply to every operation
+	// unless the operation declares its own
+	for _, param := range append(append([]interface{}{}, pathParams...), opParams...) {
+		kind, resolved := u.parameterKind(param)
+		switch kind {
+		case "body":
+			body, bodyRef = resolved, ""
+			if raw, _ := param.(map[string]interface{}); raw["$ref"] != nil {
+				ref, _ := raw["$ref"].(string)
+				bodyRef = "#/components/requestBodies/" + strings.TrimPrefix(ref, "#/parameters/")
+			}
+		case "formData":
+			formFields = replaceFormField(formFields, resolved)
+		}
+	}
+	for _, param := range opParams {
+		if kind, _ := u.parameterKind(param); kind != "body" && kind != "formData" {
+			parameters = append(parameters, u.upgradeParameterEntry(param))
+		}
+	}
+
+	if len(parameters) > 0 {
+		result["parameters"] = parameters
+	}
+
+	// A shared body parameter can only stay a reference when the operation
+	// consumes the same media types as the document
+	switch {
+	case bodyRef != "" && equalStrings(consumes, u.consumes):
+		result["requestBody"] = map[string]interface{}{"$ref": bodyRef}
+	case body != nil:
+		result["requestBody"] = u.upgradeBodyParameter(body, consumes)
+	case len(formFields) > 0:
+		result["requestBody"] = upgradeFormParameters(formFields, consumes)
+	}
+
+	if responses := getMap(operation, "responses"); responses != nil {
+		upgraded := make(map[string]interface{}, len(responses))
+		for code, response := range responses {
+			raw, _ := response.(map[string]interface{})
+			if ref, ok := raw["$ref"].(string); ok {
+				upgraded[code] = map[string]interface{}{"$ref": upgradeSwagger2Ref(ref)}
+				continue
+			}
+			upgraded[code] = u.upgradeResponse(raw, produces)
+		}
+		result["responses"] = upgraded
+	}
+
+	return result
+}
+
+// parameterKind returns the location of a parameter entry, following a
+// reference to a top-level parameter when needed
+func (u *swagger2Upgrader) parameterKind(param interface{}) (string, map[string]interface{}) {
+	raw, _ := param.(map[string]interface{})
+	if ref, ok := raw["$ref"].(string); ok {
+		name := strings.TrimPrefix(ref, "#/parameters/")
+		if body, ok := u.bodyParams[name]; ok {
+			return "body", body
+		}
+		if field, ok := u.formDataParams[name]; ok {
+			return "formData", field
+		}
+		return "ref", raw
+	}
+	return getStringValue(raw, "in", ""), raw
+}
+
+func (u *swagger2Upgrader) upgradeParameterEntry(param interface{}) interface{} {
+	raw, _ := param.(map[string]interface{})
+	if ref, ok := raw["$ref"].(string); ok {
+		return map[string]interface{}{"$ref": upgradeSwagger2Ref(ref)}
+	}
+	return upgradeSwagger2Parameter(raw)
+}
+
+// upgradeBodyParameter turns an "in: body" parameter into a request body with
+// one media type entry per consumed content type
+func (u *swagger2Upgrader) upgradeBodyParameter(param map[string]interface{}, consumes []string) map[string]interface{} {
+	if len(consumes) == 0 {
+		consumes = []string{"application/json"}
+	}
+
+	content := make(map[string]interface{}, len(consumes))
+	for _, mediaType := range consumes {
+		entry := map[string]interface{}{}
+		if schema, ok := param["schema"]; ok {
+			entry["schema"] = upgradeSwagger2Schema(schema)
+		}
+		content[mediaType] = entry
+	}
+
+	body := map[string]interface{}{"content": content}
+	copyKeys(body, param, "description", "required")
+	copyExtensions(body, param)
+	if name := getStringValue(param, "name", ""); name != "" && name != "body" {
+		body["x-codegen-request-body-name"] = name
+	}
+	return body
+}
+
+// upgradeResponse moves the response schema and examples under content,
+// keyed by each produced media type
+func (u *swagger2Upgrader) upgradeResponse(response map[string]interface{}, produces []string) map[string]interface{} {
+	result := map[string]interface{}{
+		"description": getStringValue(response, "description", ""),
+	}
+	copyExtensions(result, response)
+
+	if headers := getMap(response, "headers"); headers != nil {
+		upgraded := make(map[string]interface{}, len(headers))
+		for name, header := range headers {
+			raw, _ := header.(map[string]interface{})
+			upgraded[name] = upgradeSwagger2Header(raw)
+		}
+		result["headers"] = upgraded
+	}
+
+	schema, hasSchema := response["schema"]
+	examples := getMap(response, "examples")
+	if !hasSchema && len(examples) == 0 {
+		return result
+	}
+
+	mediaTypes := produces
+	if len(mediaTypes) == 0 {
+		mediaTypes = []string{"application/json"}
+	}
+	for mediaType := range examples {
+		if !containsString(mediaTypes, mediaType) {
+			mediaTypes = append(mediaTypes, mediaType)
+		}
+	}
+
+	content := make(map[string]interface{}, len(mediaTypes))
+	for _, mediaType := range mediaTypes {
+		entry := map[string]interface{}{}
+		if hasSchema {
+			entry["schema"] = upgradeSwagger2Schema(schema)
+		}
+		if example, ok := examples[mediaType]; ok {
+			entry["example"] = example
+		}
+		content[mediaType] = entry
+	}
+	result["content"] = content
+
+	return result
+}
+
+// upgradeFormParameters collects formData parameters into a single object
+// schema, sent as multipart when a file is uploaded or multipart is consumed
+func upgradeFormParameters(fields []map[string]interface{}, consumes []string) map[string]interface{} {
+	properties := map[string]interface{}{}
+	var required []interface{}
+	multipart := containsString(consumes, "multipart/form-data")
+
+	for _, field := range fields {
+		name := getStringValue(field, "name", "")
+		schema := swagger2ParameterSchema(field)
+		if getStringValue(field, "type", "") == "file" {
+			multipart = true
+		}
+		if description := getStringValue(field, "description", ""); description != "" {
+			schema["description"] = description
+		}
+		properties[name] = schema
+		if getBoolValue(field, "required") {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	mediaType := "application/x-www-form-urlencoded"
+	if multipart {
+		mediaType = "multipart/form-data"
+	}
+
+	return map[string]interface{}{
+		"content": map[string]interface{}{
+			mediaType: map[string]interface{}{"schema": schema},
+		},
+	}
+}
+
+func upgradeSwagger2Parameter(param map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	copyKeys(result, param, "name", "in", "description", "required", "allowEmptyValue")
+	copyExtensions(result, param)
+
+	if schema, ok := param["schema"]; ok {
+		result["schema"] = upgradeSwagger2Schema(schema)
+	} else {
+		result["schema"] = swagger2ParameterSchema(param)
+	}
+
+	switch getStringValue(param, "collectionFormat", "") {
+	case "csv":
+		if in := getStringValue(param, "in", ""); in == "query" || in == "cookie" {
+			result["style"], result["explode"] = "form", false
+		} else {
+			result["style"] = "simple"
+		}
+	case "multi":
+		result["style"], result["explode"] = "form", true
+	case "ssv":
+		result["style"] = "spaceDelimited"
+	case "pipes":
+		result["style"] = "pipeDelimited"
+	case "tsv":
+		result["x-collection-format"] = "tsv"
+	}
+
+	return result
+}
+
+func upgradeSwagger2Header(header map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{
+		"schema": swagger2ParameterSchema(header),
+	}
+	copyKeys(result, header, "description")
+	copyExtensions(result, header)
+	return result
+}
+
+// swagger2ParameterSchema gathers the inline schema keywords of a non-body parameter
+func swagger2ParameterSchema(param map[string]interface{}) map[string]interface{} {
+	schema := map[string]interface{}{}
+	for _, keyword := range swagger2SchemaKeywords {
+		if value, ok := param[keyword]; ok {
+			schema[keyword] = value
+		}
+	}
+	return upgradeSwagger2Schema(schema).(map[string]interface{})
+}
+
+// upgradeSwagger2Schema rewrites references and the few schema constructs
+// that changed shape between Swagger 2.0 and OpenAPI 3
+func upgradeSwagger2Schema(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			switch key {
+			case "$ref":
+				ref, _ := item.(string)
+				result[key] = upgradeSwagger2Ref(ref)
+			case "discriminator":
+				if name, ok := item.(string); ok {
+					result[key] = map[string]interface{}{"propertyName": name}
+				} else {
+					result[key] = item
+				}
+			case "x-nullable":
+				result["nullable"] = item
+			case "type":
+				if item == "file" {
+					result["type"], result["format"] = "string", "binary"
+				} else {
+					result[key] = item
+				}
+			default:
+				result[key] = upgradeSwagger2Schema(item)
+			}
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = upgradeSwagger2Schema(item)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+// upgradeSecurityDefinition maps a Swagger 2.0 security definition to an OpenAPI 3 security scheme
+func upgradeSecurityDefinition(definition map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	copyKeys(result, definition, "description")
+	copyExtensions(result, definition)
+
+	switch getStringValue(definition, "type", "") {
+	case "basic":
+		result["type"], result["scheme"] = "http", "basic"
+	case "apiKey":
+		result["type"] = "apiKey"
+		copyKeys(result, definition, "name", "in")
+	case "oauth2":
+		flow := map[string]interface{}{}
+		copyKeys(flow, definition, "authorizationUrl", "tokenUrl")
+		scopes := getMap(definition, "scopes")
+		if scopes == nil {
+			scopes = map[string]interface{}{}
+		}
+		flow["scopes"] = scopes
+
+		flowNames := map[string]string{
+			"implicit":    "implicit",
+			"password":    "password",
+			"application": "clientCredentials",
+			"accessCode":  "authorizationCode",
+		}
+		result["type"] = "oauth2"
+		result["flows"] = map[string]interface{}{
+			flowNames[getStringValue(definition, "flow", "implicit")]: flow,
+		}
+	default:
+		copyKeys(result, definition, "type")
+	}
+
+	return result
+}
+
+func upgradeSwagger2Ref(ref string) string {
+	for prefix, replacement := range swagger2RefPrefixes {
+		if strings.HasPrefix(ref, prefix) {
+			return replacement + strings.TrimPrefix(ref, prefix)
+		}
+	}
+	return ref
+}
+
+// replaceFormField adds a form field, replacing an earlier one with the same name
+func replaceFormField(fields []map[string]interface{}, field map[string]interface{}) []map[string]interface{} {
+	name := getStringValue(field, "name", "")
+	for i, existing := range fields {
+		if getStringValue(existing, "name", "") == name {
+			fields[i] = field
+			return fields
+		}
+	}
+	return append(fields, field)
+}
+
+func isHTTPMethod(key string) bool {
+	return containsString(httpMethods, key)
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func copyKeys(dst, src map[string]interface{}, keys ...string) {
+	for _, key := range keys {
+		if value, ok := src[key]; ok {
+			dst[key] = value
+		}
+	}
+}
+
+func copyExtensions(dst, src map[string]interface{}) {
+	for key, value := range src {
+		if strings.HasPrefix(key, "x-") {
+			dst[key] = value
+		}
+	}
+}
+
+// swagger2Sections maps the component sections of an upgraded document back
+// to the Swagger 2.0 sections they were built from
+var swagger2Sections = []struct{ upgraded, original string }{
+	{"/components/schemas/", "/definitions/"},
+	{"/components/parameters/", "/parameters/"},
+	{"/components/requestBodies/", "/parameters/"},
+	{"/components/responses/", "/responses/"},
+	{"/components/securitySchemes/", "/securityDefinitions/"},
+}
+
+// swagger2SourcePointer maps a pointer into the upgraded document to the
+// same place in the Swagger 2.0 original. Paths keep their pointers; parts
+// the upgrade restructured resolve to their nearest existing ancestor.
+func swagger2SourcePointer(pointer string) string {
+	for _, section := range swagger2Sections {
+		if strings.HasPrefix(pointer, section.upgraded) {
+			return section.original + strings.TrimPrefix(pointer, section.upgraded)
+		}
+	}
+	return pointer
+}
@@ -0,0 +1,330 @@
+package services
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// swagger2Header starts every document in the upgrade tests
+const swagger2Header = `
+swagger: "2.0"
+info:
+  title: Pets
+  version: 1.0.0
+`
+
+// TestSwagger2Upgrade upgrades small Swagger 2.0 documents and checks the
+// OpenAPI 3.0 value found at each pointer
+func TestSwagger2Upgrade(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		want map[string]string
+	}{
+		{
+			name: "body parameter becomes a request body per consumed media type",
+			spec: `
+consumes: [application/json, application/xml]
+paths:
+  /pets:
+    post:
+      parameters:
+        - name: pet
+          in: body
+          description: The pet to add
+          required: true
+          schema:
+            $ref: '#/definitions/Pet'
+      responses:
+        "201":
+          description: Created
+definitions:
+  Pet:
+    type: object
+`,
+			want: map[string]string{
+				"/paths/~1pets/post/requestBody": `{
+					"description": "The pet to add",
+					"required": true,
+					"x-codegen-request-body-name": "pet",
+					"content": {
+						"application/json": {"schema": {"$ref": "#/components/schemas/Pet"}},
+						"application/xml": {"schema": {"$ref": "#/components/schemas/Pet"}}
+					}
+				}`,
+				"/paths/~1pets/post/parameters": `null`,
+			},
+		},
+		{
+			name: "operation consumes replaces the document's",
+			spec: `
+consumes: [application/json]
+paths:
+  /pets:
+    put:
+      consumes: [text/plain]
+      parameters:
+        - name: body
+          in: body
+          schema:
+            type: string
+      responses:
+        "204":
+          description: Replaced
+`,
+			want: map[string]string{
+				"/paths/~1pets/put/requestBody": `{"content": {"text/plain": {"schema": {"type": "string"}}}}`,
+			},
+		},
+		{
+			name: "formData parameters become a url-encoded object",
+			spec: `
+paths:
+  /login:
+    post:
+      parameters:
+        - name: user
+          in: formData
+          type: string
+          required: true
+        - name: remember
+          in: formData
+          type: boolean
+          description: Keep the session
+        - name: client
+          in: header
+          type: string
+      responses:
+        "200":
+          description: Signed in
+`,
+			want: map[string]string{
+				"/paths/~1login/post/requestBody/content": `{
+					"application/x-www-form-urlencoded": {"schema": {
+						"type": "object",
+						"required": ["user"],
+						"properties": {
+							"user": {"type": "string"},
+							"remember": {"type": "boolean", "description": "Keep the session"}
+						}
+					}}
+				}`,
+				"/paths/~1login/post/parameters": `[{"name": "client", "in": "header", "schema": {"type": "string"}}]`,
+			},
+		},
+		{
+			name: "file upload makes the form multipart",
+			spec: `
+paths:
+  /pets/{id}/photo:
+    post:
+      parameters:
+        - name: id
+          in: path
+          required: true
+          type: integer
+        - name: photo
+          in: formData
+          type: file
+      responses:
+        "204":
+          description: Uploaded
+`,
+			want: map[string]string{
+				"/paths/~1pets~1{id}~1photo/post/requestBody/content": `{
+					"multipart/form-data": {"schema": {
+						"type": "object",
+						"properties": {"photo": {"type": "string", "format": "binary"}}
+					}}
+				}`,
+			},
+		},
+		{
+			name: "produces keys the response content, with examples per media type",
+			spec: `
+produces: [application/json]
+paths:
+  /pets:
+    get:
+      responses:
+        "200":
+          description: The pets
+          schema:
+            type: array
+            items:
+              $ref: '#/definitions/Pet'
+          examples:
+            text/csv: "id,name"
+  /pets/export:
+    get:
+      produces: [application/xml]
+      responses:
+        "200":
+          description: The pets as XML
+          schema:
+            type: string
+definitions:
+  Pet:
+    type: object
+`,
+			want: map[string]string{
+				"/paths/~1pets/get/responses/200/content": `{
+					"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Pet"}}},
+					"text/csv": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Pet"}}, "example": "id,name"}
+				}`,
+				"/paths/~1pets~1export/get/responses/200/content": `{"application/xml": {"schema": {"type": "string"}}}`,
+			},
+		},
+		{
+			name: "securityDefinitions become security schemes",
+			spec: `
+securityDefinitions:
+  basic:
+    type: basic
+  key:
+    type: apiKey
+    name: X-API-Key
+    in: header
+  oauth:
+    type: oauth2
+    flow: accessCode
+    authorizationUrl: https://auth.example.com/authorize
+    tokenUrl: https://auth.example.com/token
+    scopes:
+      read: Read pets
+security:
+  - oauth: [read]
+paths: {}
+`,
+			want: map[string]string{
+				"/components/securitySchemes": `{
+					"basic": {"type": "http", "scheme": "basic"},
+					"key": {"type": "apiKey", "name": "X-API-Key", "in": "header"},
+					"oauth": {"type": "oauth2", "flows": {"authorizationCode": {
+						"authorizationUrl": "https://auth.example.com/authorize",
+						"tokenUrl": "https://auth.example.com/token",
+						"scopes": {"read": "Read pets"}
+					}}}
+				}`,
+				"/security": `[{"oauth": ["read"]}]`,
+			},
+		},
+		{
+			name: "definitions, parameters and responses refs point into components",
+			spec: `
+parameters:
+  limit:
+    name: limit
+    in: query
+    type: integer
+  newPet:
+    name: pet
+    in: body
+    schema:
+      $ref: '#/definitions/Pet'
+responses:
+  NotFound:
+    description: Not found
+    schema:
+      $ref: '#/definitions/Error'
+paths:
+  /pets:
+    get:
+      parameters:
+        - $ref: '#/parameters/limit'
+      responses:
+        "404":
+          $ref: '#/responses/NotFound'
+    post:
+      parameters:
+        - $ref: '#/parameters/newPet'
+      responses:
+        "201":
+          description: Created
+definitions:
+  Pet:
+    type: object
+    properties:
+      owner:
+        $ref: '#/definitions/Owner'
+      tags:
+        type: array
+        items:
+          $ref: '#/definitions/Tag'
+  Owner:
+    type: object
+  Tag:
+    type: string
+  Error:
+    type: object
+`,
+			want: map[string]string{
+				"/components/schemas/Pet/properties": `{
+					"owner": {"$ref": "#/components/schemas/Owner"},
+					"tags": {"type": "array", "items": {"$ref": "#/components/schemas/Tag"}}
+				}`,
+				"/components/responses/NotFound/content/application~1json/schema":   `{"$ref": "#/components/schemas/Error"}`,
+				"/components/requestBodies/newPet/content/application~1json/schema": `{"$ref": "#/components/schemas/Pet"}`,
+				"/paths/~1pets/get/parameters":                                      `[{"$ref": "#/components/parameters/limit"}]`,
+				"/paths/~1pets/get/responses/404":                                   `{"$ref": "#/components/responses/NotFound"}`,
+				"/paths/~1pets/post/requestBody":                                    `{"$ref": "#/components/requestBodies/newPet"}`,
+				"/components/parameters/limit/schema":                               `{"type": "integer"}`,
+			},
+		},
+		{
+			name: "host, basePath and schemes become servers",
+			spec: `
+host: api.example.com
+basePath: /v1
+schemes: [https, http]
+paths: {}
+`,
+			want: map[string]string{
+				"/servers": `[{"url": "https://api.example.com/v1"}, {"url": "http://api.example.com/v1"}]`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, err := parseSpec(swagger2Header + tt.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			upgraded := newSwagger2Upgrader(spec).upgrade()
+
+			for pointer, want := range tt.want {
+				got, err := resolver.Get(upgraded, pointer)
+				if err != nil && want != "null" {
+					t.Errorf("%s: %v", pointer, err)
+					continue
+				}
+				if got, want := normalizedJSON(t, got), normalizedJSON(t, parseJSON(t, want)); got != want {
+					t.Errorf("%s:\n got %s\nwant %s", pointer, got, want)
+				}
+			}
+		})
+	}
+}
+
+func parseJSON(t *testing.T, content string) interface{} {
+	t.Helper()
+	var value interface{}
+	if err := json.Unmarshal([]byte(content), &value); err != nil {
+		t.Fatalf("bad expectation %s: %v", content, err)
+	}
+	return value
+}
+
+// normalizedJSON marshals a value with sorted keys, so that YAML and JSON
+// numbers compare equal
+func normalizedJSON(t *testing.T, value interface{}) string {
+	t.Helper()
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/swagger-editor/backend/internal/core/diff"
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/ports"
+)
+
+// DiffService implements the diff service interface
+type DiffService struct {
+	apiService ports.APIService
+	converter  ports.ConverterService
+}
+
+// NewDiffService creates a new diff service
+func NewDiffService(apiService ports.APIService, converter ports.ConverterService) *DiffService {
+	return &DiffService{
+		apiService: apiService,
+		converter:  converter,
+	}
+}
+
+// Diff compares two API definitions, each given as a stored definition
+// (optionally at a revision), a raw specification or a normalized definition
+func (s *DiffService) Diff(ctx context.Context, request *domain.DiffRequest) (*domain.DiffResult, error) {
+	if request.Format != "" && request.Format != "json" && request.Format != "markdown" {
+		return nil, fmt.Errorf("unsupported diff format: %s", request.Format)
+	}
+
+	old, err := s.load(ctx, request.Old)
+	if err != nil {
+		return nil, fmt.Errorf("old: %w", err)
+	}
+	new, err := s.load(ctx, request.New)
+	if err != nil {
+		return nil, fmt.Errorf("new: %w", err)
+	}
+
+	changes := diff.Compare(old, new)
+	if changes == nil {
+		changes = []domain.DiffChange{}
+	}
+
+	result := &domain.DiffResult{
+		Changes: changes,
+		Summary: diff.Summarize(changes),
+	}
+	if request.Format == "markdown" {
+		result.Markdown = diff.Markdown(changes)
+	}
+
+	return result, nil
+}
+
+// CheckCompatibility compares the current state of an API definition with
+// an earlier revision and classifies each change. The release is blocked
+// when a change is breaking and the major version was not increased. A
+// definition with a single revision has nothing to break, so its report is
+// empty, against revision 0.
+func (s *DiffService) CheckCompatibility(ctx context.Context, id string, against int) (*domain.CompatibilityReport, error) {
+	current, err := s.apiService.GetAPIDefinition(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if against == 0 {
+		against = current.Revision - 1
+	}
+	if against < 1 {
+		return &domain.CompatibilityReport{
+			DefinitionID: id,
+			Revision:     current.Revision,
+			OldVersion:   current.Metadata.Version,
+			NewVersion:   current.Metadata.Version,
+			Changes:      []domain.ClassifiedChange{},
+		}, nil
+	}
+
+	old, err := s.apiService.GetRevision(ctx, id, against)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.CompatibilityReport{
+		DefinitionID:     id,
+		Revision:         current.Revision,
+		Against:          against,
+		OldVersion:       old.Metadata.Version,
+		NewVersion:       current.Metadata.Version,
+		MajorVersionBump: diff.MajorVersionBump(old.Metadata.Version, current.Metadata.Version),
+		Changes:          diff.Classify(diff.Compare(old, current)),
+	}
+	for _, change := range report.Changes {
+		if change.Severity == domain.SeverityBreaking {
+			report.Breaking++
+		} else {
+			report.NonBreaking++
+		}
+	}
+	report.ReleaseBlocked = report.Breaking > 0 && !report.MajorVersionBump
+
+	return report, nil
+}
+
+// load returns the definition a diff source describes
+func (s *DiffService) load(ctx context.Context, source domain.DiffSource) (*domain.APIDefinition, error) {
+	switch {
+	case source.Definition != nil:
+		return source.Definition, nil
+
+	case source.DefinitionID != "" && source.Revision > 0:
+		return s.apiService.GetRevision(ctx, source.DefinitionID, source.Revision)
+
+	case source.DefinitionID != "":
+		return s.apiService.GetAPIDefinition(ctx, source.DefinitionID)
+
+	case source.Content != "":
+		conversionResult, err := s.converter.ConvertSwaggerToJSON(ctx, &domain.ConversionRequest{
+			SwaggerContent: source.Content,
+			Files:          source.Files,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("conversion failed: %w", err)
+		}
+		if !conversionResult.Success || conversionResult.Data == nil {
+			return nil, fmt.Errorf("conversion failed: %s", conversionResult.Error)
+		}
+		return conversionResult.Data, nil
+	}
+
+	return nil, errors.New("a definition, definitionId or content is required")
+}
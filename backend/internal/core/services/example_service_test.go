@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/swagger-editor/backend/internal/adapters/secondary/events"
+	"github.com/swagger-editor/backend/internal/adapters/secondary/repository"
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// TestWriteExamplesErrors writes examples into what does not exist and into
+// a stored definition that no longer validates, and gets errors the REST
+// layer can tell apart
+func TestWriteExamplesErrors(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewInMemoryAPIRepository()
+	service := NewExampleService(NewAPIService(repo, &ConverterService{}, NewValidatorService(repo), events.NewInMemoryEventBus()))
+
+	api := newTestDefinition("pets", "Pets")
+	api.Schemas = []domain.Schema{{ID: "schema-pet", Name: "Pet", Type: "string"}}
+	if err := repo.Save(ctx, api); err != nil {
+		t.Fatal(err)
+	}
+	// Stored before the name became required
+	unnamed := newTestDefinition("unnamed", "")
+	if err := repo.Save(ctx, unnamed); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		id       string
+		schemaID string
+		want     error
+	}{
+		{"missing definition", "owners", "", domain.ErrNotFound},
+		{"missing schema", "pets", "schema-owner", domain.ErrNotFound},
+		{"definition that no longer validates", "unnamed", "", domain.ErrInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := service.WriteExamples(ctx, tt.id, tt.schemaID, 1, false, domain.Change{}); !errors.Is(err, tt.want) {
+				t.Errorf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	written, err := service.WriteExamples(ctx, "pets", "Pet", 1, false, domain.Change{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := written.Schemas[0].Example.(string); !ok {
+		t.Errorf("example = %#v, want a string", written.Schemas[0].Example)
+	}
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// collectExtensions lists the vendor extensions set anywhere in a definition,
+// limited to one key when key is not empty
+func collectExtensions(api *domain.APIDefinition, key string) []domain.ExtensionUsage {
+	usages := []domain.ExtensionUsage{}
+
+	add := func(extensions map[string]interface{}, pointer, level, ownerID string) {
+		names := make([]string, 0, len(extensions))
+		for name := range extensions {
+			if key == "" || name == key {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			usages = append(usages, domain.ExtensionUsage{
+				Key:     name,
+				Value:   extensions[name],
+				Path:    resolver.JoinPointer(pointer, "extensions", name),
+				Level:   level,
+				OwnerID: ownerID,
+			})
+		}
+	}
+
+	addContent := func(content map[string]domain.MediaType, pointer, level, ownerID string) {
+		for _, mediaType := range sortedMediaTypes(content) {
+			add(content[mediaType].Extensions, resolver.JoinPointer(pointer, "content", mediaType), level, ownerID)
+		}
+	}
+
+	add(api.Extensions, "", "api", "")
+	add(api.Metadata.Extensions, "/metadata", "info", "")
+	for i, server := range api.Metadata.Servers {
+		add(server.Extensions, fmt.Sprintf("/metadata/servers/%d", i), "server", "")
+	}
+	for i, tag := range api.Tags {
+		add(tag.Extensions, fmt.Sprintf("/tags/%d", i), "tag", "")
+	}
+
+	for i, item := range api.PathItems {
+		add(item.Extensions, fmt.Sprintf("/pathItems/%d", i), "pathItem", item.Path)
+	}
+	for i, item := range api.WebhookItems {
+		add(item.Extensions, fmt.Sprintf("/webhookItems/%d", i), "webhookItem", item.Path)
+	}
+	for i, endpoint := range api.Endpoints {
+		add(endpoint.Extensions, fmt.Sprintf("/endpoints/%d", i), "endpoint", endpoint.ID)
+	}
+	for i, webhook := range api.Webhooks {
+		add(webhook.Extensions, fmt.Sprintf("/webhooks/%d", i), "webhook", webhook.ID)
+	}
+	for i, schema := range api.Schemas {
+		add(schema.Extensions, fmt.Sprintf("/schemas/%d", i), "schema", schema.ID)
+	}
+	for i, param := range api.Parameters {
+		pointer := fmt.Sprintf("/parameters/%d", i)
+		add(param.Extensions, pointer, "parameter", param.ID)
+		addContent(param.Content, pointer, "parameter", param.ID)
+	}
+	for i, response := range api.Responses {
+		pointer := fmt.Sprintf("/responses/%d", i)
+		add(response.Extensions, pointer, "response", response.ID)
+		addContent(response.Content, pointer, "response", response.ID)
+	}
+	for i, body := range api.RequestBodies {
+		pointer := fmt.Sprintf("/requestBodies/%d", i)
+		add(body.Extensions, pointer, "requestBody", body.ID)
+		addContent(body.Content, pointer, "requestBody", body.ID)
+	}
+	for i, scheme := range api.SecuritySchemes {
+		add(scheme.Extensions, fmt.Sprintf("/securitySchemes/%d", i), "securityScheme", scheme.ID)
+	}
+
+	return usages
+}
+
+func sortedMediaTypes(content map[string]domain.MediaType) []string {
+	mediaTypes := make([]string, 0, len(content))
+	for mediaType := range content {
+		mediaTypes = append(mediaTypes, mediaType)
+	}
+	sort.Strings(mediaTypes)
+	return mediaTypes
+}
@@ -0,0 +1,30 @@
+package services
+
+import (
+	"context"
+
+	"github.com/swagger-editor/backend/internal/core/ports"
+	"github.com/swagger-editor/backend/internal/core/sdl"
+)
+
+// GraphQLExportService implements the GraphQL export service interface
+type GraphQLExportService struct {
+	apiService ports.APIService
+}
+
+// NewGraphQLExportService creates a new GraphQL export service
+func NewGraphQLExportService(apiService ports.APIService) *GraphQLExportService {
+	return &GraphQLExportService{
+		apiService: apiService,
+	}
+}
+
+// ExportGraphQL returns the GraphQL schema of a stored API definition
+func (s *GraphQLExportService) ExportGraphQL(ctx context.Context, id string) (string, error) {
+	api, err := s.apiService.GetAPIDefinition(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	return sdl.New(api).Generate()
+}
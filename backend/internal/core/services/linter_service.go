@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/lint"
+)
+
+// LinterService implements the linter service interface
+type LinterService struct {
+	ruleset *lint.Ruleset
+}
+
+// NewLinterService creates a linter with the given ruleset; nil runs every
+// built-in rule with its default severity
+func NewLinterService(ruleset *lint.Ruleset) *LinterService {
+	if ruleset == nil {
+		ruleset = lint.DefaultRuleset()
+	}
+	return &LinterService{
+		ruleset: ruleset,
+	}
+}
+
+// Lint checks a specification against the service's ruleset, with the
+// request's own ruleset applied on top. Findings of severity error make the
+// document invalid; warn and info findings are returned as warnings.
+func (s *LinterService) Lint(ctx context.Context, request *domain.LintRequest) (*domain.ValidationResponse, error) {
+	ruleset := s.ruleset
+	if request.Ruleset != "" {
+		overrides, err := lint.ParseRuleset([]byte(request.Ruleset))
+		if err != nil {
+			return nil, err
+		}
+		ruleset = ruleset.Override(overrides)
+	}
+
+	bundle, err := parseBundle(request.Content, request.Files)
+	if err != nil {
+		return &domain.ValidationResponse{
+			Valid: false,
+			Errors: []domain.ValidationError{
+				parseError(err, request.Content, request.Files),
+			},
+		}, nil
+	}
+	spec, _ := bundle.Root().(map[string]interface{})
+
+	var errors []domain.ValidationError
+	var warnings []domain.Warning
+	for _, finding := range ruleset.Lint(spec) {
+		if finding.Severity == lint.SeverityError {
+			errors = append(errors, domain.ValidationError{
+				Path:     finding.Path,
+				Message:  finding.Message,
+				Keyword:  "lint",
+				Rule:     finding.Rule,
+				Severity: string(finding.Severity),
+			})
+			continue
+		}
+		warnings = append(warnings, domain.Warning{
+			Path:     finding.Path,
+			Message:  fmt.Sprintf("%s: %s (%s)", finding.Path, finding.Message, finding.Rule),
+			Rule:     finding.Rule,
+			Severity: string(finding.Severity),
+		})
+	}
+
+	locator := newSourceLocator(request.Content, request.Files)
+	locator.locateErrors(errors)
+	messages, details := locator.warnings(warnings)
+
+	return &domain.ValidationResponse{
+		Valid:          len(errors) == 0,
+		Errors:         errors,
+		Warnings:       messages,
+		WarningDetails: details,
+	}, nil
+}
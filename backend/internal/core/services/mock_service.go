@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/mock"
+	"github.com/swagger-editor/backend/internal/core/ports"
+)
+
+// MockService implements the mock service interface
+type MockService struct {
+	apiService ports.APIService
+}
+
+// NewMockService creates a new mock service
+func NewMockService(apiService ports.APIService) *MockService {
+	return &MockService{
+		apiService: apiService,
+	}
+}
+
+// Serve answers a request on behalf of the current state of a stored API
+// definition, so that edits show up in the mock straight away
+func (s *MockService) Serve(ctx context.Context, id string, request *domain.MockRequest) (*domain.MockResponse, error) {
+	api, err := s.apiService.GetAPIDefinition(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return mock.New(api).Serve(request), nil
+}
@@ -0,0 +1,115 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/resolver"
+	"github.com/swagger-editor/backend/internal/core/sourcemap"
+)
+
+// sourceLocator finds the source range of paths that are JSON Pointers into
+// the entry document or, written file#pointer, into another file of a bundle
+type sourceLocator struct {
+	sources map[string]string
+	maps    map[string]*sourcemap.Map
+}
+
+func newSourceLocator(content string, files map[string]string) *sourceLocator {
+	sources := map[string]string{"": content}
+	for name, fileContent := range files {
+		sources[resolver.CleanPath(name)] = fileContent
+	}
+
+	return &sourceLocator{
+		sources: sources,
+		maps:    make(map[string]*sourcemap.Map),
+	}
+}
+
+func (l *sourceLocator) locate(path string) domain.SourceRange {
+	file, pointer := "", path
+	if !strings.HasPrefix(pointer, "/") {
+		if name, fragment, ok := strings.Cut(pointer, "#"); ok {
+			file, pointer = name, fragment
+		}
+	}
+	if pointer == "/" {
+		pointer = ""
+	}
+
+	source, ok := l.maps[file]
+	if !ok {
+		// Sources that do not parse as YAML leave their paths unplaced
+		source, _ = sourcemap.Parse(l.sources[file])
+		l.maps[file] = source
+	}
+	return source.Range(pointer)
+}
+
+func (l *sourceLocator) locateErrors(errs []domain.ValidationError) {
+	for i := range errs {
+		errs[i].SourceRange = l.locate(errs[i].Path)
+	}
+}
+
+// warnings positions warnings and also returns their messages, for the
+// plain Warnings list of responses
+func (l *sourceLocator) warnings(warnings []domain.Warning) ([]string, []domain.Warning) {
+	if len(warnings) == 0 {
+		return nil, nil
+	}
+
+	messages := make([]string, len(warnings))
+	for i := range warnings {
+		if warnings[i].Path != "" {
+			warnings[i].SourceRange = l.locate(warnings[i].Path)
+		}
+		messages[i] = warnings[i].Message
+	}
+	return messages, warnings
+}
+
+// parseError reports a document of a bundle that is not valid JSON or YAML,
+// on the line the parser stopped at
+func parseError(err error, content string, files map[string]string) domain.ValidationError {
+	parseErr := domain.ValidationError{
+		Message: "Invalid JSON or YAML format: " + err.Error(),
+	}
+
+	source := content
+	for name, fileContent := range files {
+		if strings.HasPrefix(err.Error(), name+": ") {
+			parseErr.Path = resolver.CleanPath(name) + "#"
+			source = fileContent
+			break
+		}
+	}
+
+	parseErr.SourceRange = sourcemap.ParseErrorRange(source, err)
+	return parseErr
+}
+
+// jsonErrorRange locates a syntax error of encoding/json from its offset
+func jsonErrorRange(content string, err error) domain.SourceRange {
+	var syntaxErr *json.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		return domain.SourceRange{}
+	}
+
+	offset := int(syntaxErr.Offset)
+	if offset > len(content) {
+		offset = len(content)
+	}
+	before := content[:offset]
+	line := strings.Count(before, "\n") + 1
+	column := utf8.RuneCountInString(before[strings.LastIndex(before, "\n")+1:])
+	if column < 1 {
+		column = 1
+	}
+
+	return domain.SourceRange{Line: line, Column: column, EndLine: line, EndColumn: column + 1}
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// TestValidateSwaggerBundlePositionsErrors validates a bundle with errors in
+// the entry document and in a referenced file, and finds each error on its
+// line in its own file
+func TestValidateSwaggerBundlePositionsErrors(t *testing.T) {
+	content := openapi30Header + `paths:
+  /pets:
+    get:
+      summary: 12
+      responses:
+        "200":
+          $ref: 'responses.yaml#/Ok'
+`
+	files := map[string]string{
+		"responses.yaml": `Ok:
+  description: The pets
+  content:
+    application/json:
+      schema:
+        $ref: '#/Missing'
+`,
+	}
+
+	result, err := NewValidatorService(nil).ValidateSwaggerBundle(context.Background(), content, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]domain.SourceRange{
+		"/paths/~1pets/get/summary":                                {Line: 9, Column: 7, EndLine: 9, EndColumn: 18},
+		"responses.yaml#/Ok/content/application~1json/schema/$ref": {Line: 6, Column: 9, EndLine: 6, EndColumn: 26},
+	}
+	if len(result.Errors) != len(want) {
+		t.Errorf("errors = %v, want %d", result.Errors, len(want))
+	}
+	for _, err := range result.Errors {
+		if got, ok := want[err.Path]; !ok || err.SourceRange != got {
+			t.Errorf("%s at %+v, want %+v", err.Path, err.SourceRange, got)
+		}
+	}
+}
+
+// TestValidateSwaggerBundlePositionsParseErrors reports a file that is not
+// YAML at the line the parser stopped, naming the file
+func TestValidateSwaggerBundlePositionsParseErrors(t *testing.T) {
+	files := map[string]string{"schemas.yaml": "Pet:\n  type: object\n bad: true\n"}
+
+	result, err := NewValidatorService(nil).ValidateSwaggerBundle(context.Background(), openapi30Header+"paths: {}\n", files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Errors) != 1 {
+		t.Fatalf("errors = %v, want one parse error", result.Errors)
+	}
+	if got := result.Errors[0]; got.Path != "schemas.yaml#" || got.Line != 3 {
+		t.Errorf("parse error at %s line %d, want schemas.yaml# line 3", got.Path, got.Line)
+	}
+}
+
+// TestValidateJSONPositionsErrors places JSON syntax errors at their offset
+// and schema errors at the offending value
+func TestValidateJSONPositionsErrors(t *testing.T) {
+	validator := NewValidatorService(nil)
+	schema := map[string]interface{}{
+		"properties": map[string]interface{}{"age": map[string]interface{}{"type": "integer"}},
+	}
+
+	result, err := validator.ValidateJSON(context.Background(), &domain.ValidationRequest{
+		JSONContent: "{\n  \"name\": \"Rex\",\n  \"age\": \"old\"\n}",
+		Schema:      schema,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := domain.SourceRange{Line: 3, Column: 3, EndLine: 3, EndColumn: 15}
+	if len(result.Errors) != 1 || result.Errors[0].SourceRange != want {
+		t.Errorf("errors = %+v, want one at %+v", result.Errors, want)
+	}
+
+	result, err = validator.ValidateJSON(context.Background(), &domain.ValidationRequest{
+		JSONContent: "{\n  \"name\": \"Rex\",\n  \"age\" 3\n}",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Errors) != 1 || result.Errors[0].Line != 3 || result.Errors[0].Column != 9 {
+		t.Errorf("errors = %+v, want one at line 3 column 9", result.Errors)
+	}
+}
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/ports"
+	"github.com/swagger-editor/backend/internal/core/traffic"
+)
+
+// TrafficService implements the traffic service interface
+type TrafficService struct {
+	apiService ports.APIService
+}
+
+// NewTrafficService creates a new traffic service
+func NewTrafficService(apiService ports.APIService) *TrafficService {
+	return &TrafficService{
+		apiService: apiService,
+	}
+}
+
+// ValidateTraffic checks recorded exchanges, given as a HAR document or JSON
+// lines, against a stored API definition
+func (s *TrafficService) ValidateTraffic(ctx context.Context, id string, data []byte, format string) (*domain.TrafficReport, error) {
+	api, err := s.apiService.GetAPIDefinition(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	exchanges, err := traffic.Parse(data, format)
+	if err != nil {
+		return nil, err
+	}
+
+	return traffic.New(api).Validate(exchanges), nil
+}
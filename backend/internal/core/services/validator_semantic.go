@@ -0,0 +1,350 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+var (
+	// pathTemplatePattern matches the {name} expressions of a path template
+	pathTemplatePattern = regexp.MustCompile(`\{([^{}]+)\}`)
+
+	// responseCodePattern matches response keys of OpenAPI 3.x, which allow
+	// ranges such as 4XX; Swagger 2.0 only allows plain codes
+	responseCodePattern = regexp.MustCompile(`^[1-5](\d{2}|XX)$`)
+	swagger2CodePattern = regexp.MustCompile(`^\d{3}$`)
+)
+
+// semanticChecker finds mistakes a schema cannot express, in the entry
+// document of a bundle. Errors are reported where the entry document says
+// the offending thing, even when it is reached through a $ref.
+type semanticChecker struct {
+	bundle   *resolver.Resolver
+	spec     map[string]interface{}
+	swagger2 bool
+
+	errors   []domain.ValidationError
+	warnings []domain.Warning
+
+	// requiredParams records the parameters reported as not required, whose
+	// structural errors only repeat the same problem
+	requiredParams map[string]bool
+	// responseKeys records the malformed response codes, keyed by the pointer
+	// of their responses object
+	responseKeys map[string]map[string]bool
+}
+
+func newSemanticChecker(bundle *resolver.Resolver, spec map[string]interface{}) *semanticChecker {
+	return &semanticChecker{
+		bundle:         bundle,
+		spec:           spec,
+		swagger2:       isSwagger2(spec),
+		requiredParams: make(map[string]bool),
+		responseKeys:   make(map[string]map[string]bool),
+	}
+}
+
+func (c *semanticChecker) check() {
+	operationIDs := make(map[string]string)
+	templates := make(map[string]string)
+
+	paths := getMap(c.spec, "paths")
+	for _, path := range sortedKeys(paths) {
+		if !strings.HasPrefix(path, "/") {
+			continue
+		}
+		pointer := resolver.JoinPointer("/paths", path)
+
+		c.checkPathConflict(templates, path, pointer)
+
+		pathItem, _ := c.deref(paths[path]).(map[string]interface{})
+		c.checkPathItem(pathItem, path, pointer, operationIDs)
+	}
+
+	// Webhook operations share the operationId namespace with paths
+	webhooks := getMap(c.spec, "webhooks")
+	for _, name := range sortedKeys(webhooks) {
+		pointer := resolver.JoinPointer("/webhooks", name)
+		pathItem, _ := c.deref(webhooks[name]).(map[string]interface{})
+		for _, method := range httpMethods {
+			if operation, ok := pathItem[method].(map[string]interface{}); ok {
+				operationPointer := resolver.JoinPointer(pointer, method)
+				c.checkOperationID(operationIDs, operation, operationPointer)
+				c.checkResponses(operation, operationPointer)
+			}
+		}
+	}
+}
+
+func (c *semanticChecker) checkPathItem(pathItem map[string]interface{}, path, pointer string, operationIDs map[string]string) {
+	if pathItem == nil {
+		return
+	}
+
+	shared := c.parameters(pathItem, pointer)
+	c.checkRequiredPathParams(shared)
+
+	names := pathTemplateNames(path)
+	for _, method := range httpMethods {
+		operation, ok := pathItem[method].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		operationPointer := resolver.JoinPointer(pointer, method)
+
+		own := c.parameters(operation, operationPointer)
+		c.checkRequiredPathParams(own)
+		c.checkPathParams(path, names, mergeParameters(shared, own), operationPointer)
+		c.checkOperationID(operationIDs, operation, operationPointer)
+		c.checkResponses(operation, operationPointer)
+	}
+}
+
+// checkPathConflict reports templated paths that only differ in the names
+// of their parameters, such as /a/{x} and /a/{y}
+func (c *semanticChecker) checkPathConflict(templates map[string]string, path, pointer string) {
+	shape := pathTemplatePattern.ReplaceAllString(path, "{}")
+	if first, ok := templates[shape]; ok {
+		c.fail(pointer, "pathConflict",
+			fmt.Sprintf("path %s conflicts with %s: they only differ in parameter names", path, first),
+			map[string]interface{}{"conflictsWith": first})
+		return
+	}
+	templates[shape] = path
+}
+
+func (c *semanticChecker) checkOperationID(seen map[string]string, operation map[string]interface{}, pointer string) {
+	id, ok := operation["operationId"].(string)
+	if !ok || id == "" {
+		return
+	}
+
+	idPointer := resolver.JoinPointer(pointer, "operationId")
+	if first, ok := seen[id]; ok {
+		c.fail(idPointer, "uniqueOperationId",
+			fmt.Sprintf("operationId %s is already used at %s", id, first),
+			map[string]interface{}{"operationId": id, "firstPath": first})
+		return
+	}
+	seen[id] = idPointer
+}
+
+// checkPathParams matches the expressions of a path template with the path
+// parameters of one operation, in both directions
+func (c *semanticChecker) checkPathParams(path string, names []string, params []parameterRef, pointer string) {
+	declared := make(map[string]bool)
+	for _, param := range params {
+		if param.in() == "path" {
+			declared[param.name()] = true
+		}
+	}
+
+	inTemplate := make(map[string]bool, len(names))
+	for _, name := range names {
+		inTemplate[name] = true
+		if !declared[name] {
+			c.fail(pointer, "pathParameter",
+				fmt.Sprintf("path parameter {%s} of %s has no matching parameter with in: path", name, path),
+				map[string]interface{}{"missingParameter": name})
+		}
+	}
+
+	for _, param := range params {
+		if param.in() == "path" && !inTemplate[param.name()] {
+			c.fail(param.pointer, "pathParameter",
+				fmt.Sprintf("path parameter %s does not appear in %s", param.name(), path),
+				map[string]interface{}{"unusedParameter": param.name()})
+		}
+	}
+}
+
+func (c *semanticChecker) checkRequiredPathParams(params []parameterRef) {
+	for _, param := range params {
+		if param.in() != "path" || param.value["required"] == true {
+			continue
+		}
+		// The same parameter component may be used by many operations
+		if c.requiredParams[param.defined] {
+			continue
+		}
+		c.requiredParams[param.defined] = true
+		c.fail(param.defined, "requiredPathParameter",
+			fmt.Sprintf("path parameter %s must be declared with required: true", param.name()),
+			map[string]interface{}{"parameter": param.name()})
+	}
+}
+
+func (c *semanticChecker) checkResponses(operation map[string]interface{}, pointer string) {
+	responses, ok := operation["responses"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	pointer = resolver.JoinPointer(pointer, "responses")
+
+	pattern := responseCodePattern
+	if c.swagger2 {
+		pattern = swagger2CodePattern
+	}
+
+	for _, key := range sortedKeys(responses) {
+		if key == "default" || strings.HasPrefix(key, "x-") {
+			continue
+		}
+
+		if !pattern.MatchString(key) {
+			if c.responseKeys[pointer] == nil {
+				c.responseKeys[pointer] = make(map[string]bool)
+			}
+			c.responseKeys[pointer][key] = true
+			c.fail(resolver.JoinPointer(pointer, key), "responseCode",
+				fmt.Sprintf("%s is not a valid response code", key),
+				map[string]interface{}{"code": key})
+			continue
+		}
+
+		// Well-formed codes HTTP does not define are allowed but suspicious
+		if code, err := strconv.Atoi(key); err == nil && http.StatusText(code) == "" {
+			codePointer := resolver.JoinPointer(pointer, key)
+			c.warnings = append(c.warnings, domain.Warning{
+				Path:    codePointer,
+				Message: fmt.Sprintf("%s: response code %s is not a registered HTTP status code", codePointer, key),
+			})
+		}
+	}
+}
+
+// structural drops the schema errors that a semantic error already explains
+func (c *semanticChecker) structural(errs []domain.ValidationError) []domain.ValidationError {
+	var kept []domain.ValidationError
+	for _, err := range errs {
+		if c.explained(err) {
+			continue
+		}
+		kept = append(kept, err)
+	}
+	return kept
+}
+
+func (c *semanticChecker) explained(err domain.ValidationError) bool {
+	if c.requiredParams[err.Path] || c.requiredParams[strings.TrimSuffix(err.Path, "/required")] {
+		return true
+	}
+
+	if err.Keyword == "additionalProperties" {
+		params, _ := err.Params.(map[string]interface{})
+		key, _ := params["additionalProperty"].(string)
+		return c.responseKeys[err.Path][key]
+	}
+	return false
+}
+
+func (c *semanticChecker) fail(pointer, keyword, message string, params map[string]interface{}) {
+	c.errors = append(c.errors, domain.ValidationError{
+		Path:    pointer,
+		Message: message,
+		Keyword: keyword,
+		Params:  params,
+	})
+}
+
+// parameterRef is a parameter with the pointer it is written at in the
+// entry document and its value with any $ref followed. A parameter reached
+// through a $ref within the entry document is defined at its target;
+// otherwise it is defined where it is written.
+type parameterRef struct {
+	pointer string
+	defined string
+	value   map[string]interface{}
+}
+
+func (p parameterRef) name() string {
+	name, _ := p.value["name"].(string)
+	return name
+}
+
+func (p parameterRef) in() string {
+	in, _ := p.value["in"].(string)
+	return in
+}
+
+func (c *semanticChecker) parameters(owner map[string]interface{}, pointer string) []parameterRef {
+	list, _ := owner["parameters"].([]interface{})
+
+	params := make([]parameterRef, 0, len(list))
+	for i, item := range list {
+		itemPointer := resolver.JoinPointer(pointer, "parameters", strconv.Itoa(i))
+		target, defined := c.derefAt(item, itemPointer)
+		value, ok := target.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		params = append(params, parameterRef{
+			pointer: itemPointer,
+			defined: defined,
+			value:   value,
+		})
+	}
+	return params
+}
+
+// deref follows a $ref from the entry document. Broken references yield
+// nil; they are reported by the resolver's own check.
+func (c *semanticChecker) deref(value interface{}) interface{} {
+	value, _ = c.derefAt(value, "")
+	return value
+}
+
+// derefAt is deref for a value written at pointer, and also returns where
+// the result is written in the entry document: the target of a $ref that
+// stays within it, or pointer itself
+func (c *semanticChecker) derefAt(value interface{}, pointer string) (interface{}, string) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return value, pointer
+	}
+	ref, ok := object["$ref"].(string)
+	if !ok {
+		return value, pointer
+	}
+
+	target, err := c.bundle.Resolve("", ref)
+	if err != nil {
+		return nil, pointer
+	}
+	if target.File == "" {
+		return target.Value, target.Pointer
+	}
+	return target.Value, pointer
+}
+
+// mergeParameters applies operation parameters over the path-level ones
+// with the same name and location
+func mergeParameters(shared, own []parameterRef) []parameterRef {
+	overridden := make(map[string]bool, len(own))
+	for _, param := range own {
+		overridden[param.in()+":"+param.name()] = true
+	}
+
+	var merged []parameterRef
+	for _, param := range shared {
+		if !overridden[param.in()+":"+param.name()] {
+			merged = append(merged, param)
+		}
+	}
+	return append(merged, own...)
+}
+
+// pathTemplateNames lists the parameter names used in a path template
+func pathTemplateNames(path string) []string {
+	var names []string
+	for _, match := range pathTemplatePattern.FindAllStringSubmatch(path, -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
@@ -0,0 +1,319 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// openapi30Header starts every document in the semantic check tests
+const openapi30Header = `
+openapi: 3.0.3
+info:
+  title: Pets
+  version: 1.0.0
+`
+
+// TestValidateSwaggerSemanticChecks validates documents that are well formed
+// for their schema but wrong in ways only the semantic checks find, and
+// lists each error as its path and keyword
+func TestValidateSwaggerSemanticChecks(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		want []string
+	}{
+		{
+			name: "valid document",
+			spec: openapi30Header + `
+paths:
+  /pets/{id}:
+    parameters:
+      - name: id
+        in: path
+        required: true
+        schema:
+          type: string
+    get:
+      operationId: getPet
+      responses:
+        "200":
+          description: The pet
+        4XX:
+          description: Client error
+        default:
+          description: Error
+`,
+		},
+		{
+			name: "duplicate operationId across paths and webhooks",
+			spec: `
+openapi: 3.1.0
+info:
+  title: Pets
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        "200":
+          description: The pets
+    post:
+      operationId: listPets
+      responses:
+        "201":
+          description: Created
+webhooks:
+  newPet:
+    post:
+      operationId: listPets
+      responses:
+        "200":
+          description: Received
+`,
+			want: []string{
+				"/paths/~1pets/post/operationId uniqueOperationId",
+				"/webhooks/newPet/post/operationId uniqueOperationId",
+			},
+		},
+		{
+			name: "template parameter without a path parameter, and the reverse",
+			spec: openapi30Header + `
+paths:
+  /pets/{id}:
+    get:
+      parameters:
+        - name: petId
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        "200":
+          description: The pet
+`,
+			want: []string{
+				"/paths/~1pets~1{id}/get pathParameter",
+				"/paths/~1pets~1{id}/get/parameters/0 pathParameter",
+			},
+		},
+		{
+			name: "operation parameter overrides the path item's",
+			spec: openapi30Header + `
+paths:
+  /pets/{id}:
+    parameters:
+      - name: id
+        in: path
+        required: true
+        schema:
+          type: string
+    get:
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: integer
+      responses:
+        "200":
+          description: The pet
+`,
+		},
+		{
+			name: "path parameter not required",
+			spec: openapi30Header + `
+paths:
+  /pets/{id}:
+    get:
+      parameters:
+        - name: id
+          in: path
+          required: false
+          schema:
+            type: string
+      responses:
+        "200":
+          description: The pet
+`,
+			want: []string{
+				"/paths/~1pets~1{id}/get/parameters/0 requiredPathParameter",
+			},
+		},
+		{
+			name: "path parameter not required, reported once at a shared component",
+			spec: openapi30Header + `
+paths:
+  /pets/{id}:
+    get:
+      parameters:
+        - $ref: '#/components/parameters/id'
+      responses:
+        "200":
+          description: The pet
+    delete:
+      parameters:
+        - $ref: '#/components/parameters/id'
+      responses:
+        "204":
+          description: Deleted
+components:
+  parameters:
+    id:
+      name: id
+      in: path
+      schema:
+        type: string
+`,
+			want: []string{
+				"/components/parameters/id requiredPathParameter",
+			},
+		},
+		{
+			name: "malformed response codes",
+			spec: openapi30Header + `
+paths:
+  /pets:
+    get:
+      responses:
+        "200":
+          description: The pets
+        "2xx":
+          description: Lower case range
+        "600":
+          description: Out of range
+        x-note:
+          description: Extension
+`,
+			want: []string{
+				"/paths/~1pets/get/responses/2xx responseCode",
+				"/paths/~1pets/get/responses/600 responseCode",
+			},
+		},
+		{
+			name: "Swagger 2.0 allows no ranges",
+			spec: swagger2Header + `
+paths:
+  /pets:
+    get:
+      responses:
+        "200":
+          description: The pets
+        4XX:
+          description: Client error
+`,
+			want: []string{
+				"/paths/~1pets/get/responses/4XX responseCode",
+			},
+		},
+		{
+			name: "paths that only differ in parameter names",
+			spec: openapi30Header + `
+paths:
+  /pets/{id}:
+    get:
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        "200":
+          description: The pet
+  /pets/{name}:
+    get:
+      parameters:
+        - name: name
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        "200":
+          description: The pet
+`,
+			want: []string{
+				"/paths/~1pets~1{name} pathConflict",
+			},
+		},
+		{
+			name: "unresolved reference",
+			spec: openapi30Header + `
+paths:
+  /pets:
+    get:
+      responses:
+        "200":
+          $ref: '#/components/responses/Missing'
+`,
+			want: []string{
+				"/paths/~1pets/get/responses/200/$ref $ref",
+			},
+		},
+		{
+			name: "structural error from the meta-schema",
+			spec: openapi30Header + `
+paths:
+  /pets:
+    get:
+      summary: 12
+      responses:
+        "200":
+          description: The pets
+`,
+			want: []string{
+				"/paths/~1pets/get/summary type",
+			},
+		},
+	}
+
+	validator := NewValidatorService(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validator.ValidateSwagger(context.Background(), tt.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, err := range result.Errors {
+				got = append(got, err.Path+" "+err.Keyword)
+			}
+			sort.Strings(got)
+			if strings.Join(got, ", ") != strings.Join(tt.want, ", ") {
+				t.Errorf("errors = %v, want %v", got, tt.want)
+				for _, err := range result.Errors {
+					t.Logf("  %s: %s", err.Path, err.Message)
+				}
+			}
+			if result.Valid != (len(tt.want) == 0) {
+				t.Errorf("valid = %t with errors %v", result.Valid, got)
+			}
+		})
+	}
+}
+
+// TestValidateSwaggerWarnsAboutUnregisteredCodes accepts a well-formed
+// response code HTTP does not define and warns about it
+func TestValidateSwaggerWarnsAboutUnregisteredCodes(t *testing.T) {
+	spec := openapi30Header + `
+paths:
+  /pets:
+    get:
+      responses:
+        "299":
+          description: Odd
+`
+	result, err := NewValidatorService(nil).ValidateSwagger(context.Background(), spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Valid {
+		t.Errorf("errors = %v, want none", result.Errors)
+	}
+	if len(result.WarningDetails) != 1 || result.WarningDetails[0].Path != "/paths/~1pets/get/responses/299" {
+		t.Errorf("warnings = %v, want one about 299", result.WarningDetails)
+	}
+}
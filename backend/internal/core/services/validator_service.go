@@ -3,98 +3,102 @@ package services
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/swagger-editor/backend/internal/core/domain"
-	"gopkg.in/yaml.v3"
+	"github.com/swagger-editor/backend/internal/core/jsonschema"
+	"github.com/swagger-editor/backend/internal/core/metaschema"
+	"github.com/swagger-editor/backend/internal/core/ports"
+	"github.com/swagger-editor/backend/internal/core/resolver"
 )
 
-// ValidatorService implements the validator service interface
+// ValidatorService implements the validator service interface. The
+// repository is only needed to validate against the schemas of stored
+// definitions.
 type ValidatorService struct {
+	repo ports.APIRepository
+}
+
+// NewValidatorService creates a validator that can look up stored definitions
+func NewValidatorService(repo ports.APIRepository) *ValidatorService {
+	return &ValidatorService{
+		repo: repo,
+	}
 }
 
 // ValidateSwagger validates a Swagger/OpenAPI specification
 func (s *ValidatorService) ValidateSwagger(ctx context.Context, content string) (*domain.ValidationResponse, error) {
-	// Basic validation implementation
-	// In production, you would use a proper OpenAPI validator
+	return s.ValidateSwaggerBundle(ctx, content, nil)
+}
 
-	var spec map[string]interface{}
+// ValidateSwaggerBundle validates a Swagger/OpenAPI specification whose
+// $refs may point into the additional files, keyed by relative path. The
+// document is checked against the schema of its version and then for the
+// mistakes a schema cannot express; every error carries its position.
+func (s *ValidatorService) ValidateSwaggerBundle(ctx context.Context, content string, files map[string]string) (*domain.ValidationResponse, error) {
 	var errors []domain.ValidationError
+	var warnings []domain.Warning
 
-	// Try to parse as JSON
-	err := json.Unmarshal([]byte(content), &spec)
+	bundle, err := parseBundle(content, files)
 	if err != nil {
-		// Try to parse as YAML
-		err = yaml.Unmarshal([]byte(content), &spec)
-		if err != nil {
-			return &domain.ValidationResponse{
-				Valid: false,
-				Errors: []domain.ValidationError{
-					{
-						Message: "Invalid JSON or YAML format: " + err.Error(),
-					},
-				},
-			}, nil
-		}
+		return &domain.ValidationResponse{
+			Valid: false,
+			Errors: []domain.ValidationError{
+				parseError(err, content, files),
+			},
+		}, nil
 	}
+	spec, _ := bundle.Root().(map[string]interface{})
 
-	// Check for required fields
-	if spec["openapi"] == nil && spec["swagger"] == nil {
+	version := specVersion(spec)
+	switch {
+	case version == "":
 		errors = append(errors, domain.ValidationError{
 			Path:    "/",
 			Message: "Missing required field: openapi or swagger version",
 			Keyword: "required",
 		})
-	}
-
-	if spec["info"] == nil {
+	case !isSupportedSpecVersion(version):
 		errors = append(errors, domain.ValidationError{
 			Path:    "/",
-			Message: "Missing required field: info",
-			Keyword: "required",
+			Message: "Unsupported specification version: " + version,
+			Keyword: "enum",
+			Params:  map[string]interface{}{"allowedValues": []string{"2.0", "3.0.x", "3.1.x"}},
 		})
-	} else {
-		info, ok := spec["info"].(map[string]interface{})
-		if ok {
-			if info["title"] == nil {
-				errors = append(errors, domain.ValidationError{
-					Path:    "/info",
-					Message: "Missing required field: title",
-					Keyword: "required",
-				})
-			}
-			if info["version"] == nil {
-				errors = append(errors, domain.ValidationError{
-					Path:    "/info",
-					Message: "Missing required field: version",
-					Keyword: "required",
-				})
-			}
+	default:
+		structural, err := metaschema.Validate(spec, version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check document structure: %w", err)
 		}
-	}
 
-	if spec["paths"] == nil {
-		errors = append(errors, domain.ValidationError{
-			Path:    "/",
-			Message: "Missing required field: paths",
-			Keyword: "required",
-		})
+		checker := newSemanticChecker(bundle, spec)
+		checker.check()
+
+		errors = append(errors, checker.structural(structural)...)
+		errors = append(errors, checker.errors...)
+		warnings = checker.warnings
 	}
 
-	valid := len(errors) == 0
+	// Every $ref must resolve, within the document or across the bundle
+	errors = append(errors, bundle.Check()...)
+
+	locator := newSourceLocator(content, files)
+	locator.locateErrors(errors)
+	messages, details := locator.warnings(warnings)
 
 	return &domain.ValidationResponse{
-		Valid:  valid,
-		Errors: errors,
-		Warnings: []string{
-			"This is a basic validation. Full OpenAPI validation to be implemented.",
-		},
+		Valid:          len(errors) == 0,
+		Errors:         errors,
+		Warnings:       messages,
+		WarningDetails: details,
 	}, nil
 }
 
-// ValidateJSON validates JSON against a schema
+// ValidateJSON validates JSON against a named schema of a stored definition
+// or against an inline schema. Without either, only the syntax is checked.
 func (s *ValidatorService) ValidateJSON(ctx context.Context, request *domain.ValidationRequest) (*domain.ValidationResponse, error) {
-	// Basic JSON validation
 	var jsonData interface{}
 	err := json.Unmarshal([]byte(request.JSONContent), &jsonData)
 	if err != nil {
@@ -102,22 +106,87 @@ func (s *ValidatorService) ValidateJSON(ctx context.Context, request *domain.Val
 			Valid: false,
 			Errors: []domain.ValidationError{
 				{
-					Message: "Invalid JSON format: " + err.Error(),
+					Message:     "Invalid JSON format: " + err.Error(),
+					SourceRange: jsonErrorRange(request.JSONContent, err),
 				},
 			},
 		}, nil
 	}
 
-	// If schema validation is requested, implement it here
-	// For now, just check if it's valid JSON
+	var api *domain.APIDefinition
+	if request.DefinitionID != "" {
+		api, err = s.findDefinition(ctx, request.DefinitionID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var refs jsonschema.Refs
+	if api != nil {
+		refs = resolver.NewSchemaResolver(api)
+	}
+
+	var schema interface{}
+	switch {
+	case request.SchemaID != "":
+		if api == nil {
+			return nil, errors.New("definitionId is required to validate against a named schema")
+		}
+		named, ok := findSchema(api, request.SchemaID)
+		if !ok {
+			return nil, fmt.Errorf("schema not found in api definition %s: %s", api.ID, request.SchemaID)
+		}
+		schema = resolver.SchemaMap(named)
+	case request.Schema != nil:
+		schema = request.Schema
+	default:
+		message := "No schema given. Only JSON syntax validated."
+		return &domain.ValidationResponse{
+			Valid:          true,
+			Warnings:       []string{message},
+			WarningDetails: []domain.Warning{{Message: message}},
+		}, nil
+	}
+
+	validationErrors := jsonschema.New(refs).Validate(schema, jsonData)
+	newSourceLocator(request.JSONContent, nil).locateErrors(validationErrors)
+
 	return &domain.ValidationResponse{
-		Valid: true,
-		Warnings: []string{
-			"Schema validation not yet implemented. Only JSON syntax validated.",
-		},
+		Valid:  len(validationErrors) == 0,
+		Errors: validationErrors,
 	}, nil
 }
 
+func (s *ValidatorService) findDefinition(ctx context.Context, id string) (*domain.APIDefinition, error) {
+	if s.repo == nil {
+		return nil, errors.New("no repository configured to look up api definitions")
+	}
+
+	api, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api definition: %w", err)
+	}
+	if api == nil {
+		return nil, fmt.Errorf("api definition not found: %s", id)
+	}
+	return api, nil
+}
+
+// findSchema looks a schema up by ID, falling back to its name
+func findSchema(api *domain.APIDefinition, idOrName string) (domain.Schema, bool) {
+	for _, schema := range api.Schemas {
+		if schema.ID == idOrName {
+			return schema, true
+		}
+	}
+	for _, schema := range api.Schemas {
+		if schema.Name == idOrName {
+			return schema, true
+		}
+	}
+	return domain.Schema{}, false
+}
+
 // ValidateAPIDefinition validates an API definition
 func (s *ValidatorService) ValidateAPIDefinition(ctx context.Context, api *domain.APIDefinition) (*domain.ValidationResponse, error) {
 	var errors []domain.ValidationError
@@ -160,7 +229,7 @@ func (s *ValidatorService) ValidateAPIDefinition(ctx context.Context, api *domai
 		// Validate HTTP method
 		validMethods := map[string]bool{
 			"GET": true, "POST": true, "PUT": true, "DELETE": true,
-			"PATCH": true, "OPTIONS": true, "HEAD": true,
+			"PATCH": true, "OPTIONS": true, "HEAD": true, "TRACE": true,
 		}
 		if !validMethods[endpoint.Method] {
 			errors = append(errors, domain.ValidationError{
@@ -171,10 +240,28 @@ func (s *ValidatorService) ValidateAPIDefinition(ctx context.Context, api *domai
 		}
 	}
 
+	// Schema references and endpoint links must point at existing components
+	errors = append(errors, resolver.NewSchemaResolver(api).Check(api)...)
+
 	valid := len(errors) == 0
 
 	return &domain.ValidationResponse{
 		Valid:  valid,
 		Errors: errors,
 	}, nil
-}
\ No newline at end of file
+}
+
+// specVersion returns the openapi or swagger version declared by a document
+func specVersion(spec map[string]interface{}) string {
+	if version, ok := spec["openapi"].(string); ok {
+		return version
+	}
+	if version, ok := spec["swagger"].(string); ok {
+		return version
+	}
+	return ""
+}
+
+func isSupportedSpecVersion(version string) bool {
+	return version == "2.0" || strings.HasPrefix(version, "3.0.") || strings.HasPrefix(version, "3.1.")
+}
@@ -0,0 +1,187 @@
+// Package sourcemap finds where the values of a JSON or YAML document were
+// written in its source text, so diagnostics addressed by JSON Pointer can be
+// shown on the right line.
+package sourcemap
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+var (
+	// parseErrorLine finds the line number in yaml.v3 syntax errors
+	parseErrorLine = regexp.MustCompile(`line (\d+):`)
+
+	// parserErrorMessage matches the errors yaml.v3 raises while parsing
+	// rather than scanning. They count lines from 0, scanner errors from 1.
+	parserErrorMessage = regexp.MustCompile(`did not find expected (<document start>|node content|'-' indicator|key|',' or '[\]}]')`)
+)
+
+// Map locates the nodes of one parsed document
+type Map struct {
+	root  *yaml.Node
+	lines []string
+}
+
+// Parse reads JSON or YAML source, keeping the position of every node
+func Parse(content string) (*Map, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &document); err != nil {
+		return nil, err
+	}
+
+	root := &document
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	return &Map{root: root, lines: strings.Split(content, "\n")}, nil
+}
+
+// Range returns the span of the value at pointer. An object member spans its
+// key and, when it is a scalar on the same line, its value; a collection
+// spans its first line. When the pointer leads to something missing, the
+// range of the deepest node that exists is returned.
+func (m *Map) Range(pointer string) domain.SourceRange {
+	if m == nil || m.root == nil || m.root.Line == 0 {
+		return domain.SourceRange{}
+	}
+
+	var key *yaml.Node
+	node := m.root
+
+	tokens, err := resolver.SplitPointer(pointer)
+	if err == nil {
+		for _, token := range tokens {
+			childKey, value := child(resolveAlias(node), token)
+			if value == nil {
+				break
+			}
+			key, node = childKey, value
+		}
+	}
+
+	if key == nil {
+		return m.span(node)
+	}
+
+	start := m.span(key)
+	if node.Kind == yaml.ScalarNode && node.Line == key.Line {
+		value := m.span(node)
+		start.EndLine, start.EndColumn = value.EndLine, value.EndColumn
+	}
+	return start
+}
+
+// ParseErrorRange locates a syntax error of yaml.v3 in source that failed to parse
+func ParseErrorRange(content string, err error) domain.SourceRange {
+	match := parseErrorLine.FindStringSubmatch(err.Error())
+	if match == nil {
+		return domain.SourceRange{}
+	}
+
+	line, _ := strconv.Atoi(match[1])
+	if parserErrorMessage.MatchString(err.Error()) {
+		line++
+	}
+	lines := strings.Split(content, "\n")
+	if line < 1 || line > len(lines) {
+		return domain.SourceRange{}
+	}
+	return lineRange(lines, line, 1)
+}
+
+// span returns the range of a single node: the whole token for scalars and
+// the rest of the first line for collections
+func (m *Map) span(node *yaml.Node) domain.SourceRange {
+	if node.Line < 1 || node.Line > len(m.lines) {
+		return domain.SourceRange{Line: node.Line, Column: node.Column}
+	}
+	if node.Kind != yaml.ScalarNode {
+		return lineRange(m.lines, node.Line, node.Column)
+	}
+
+	text := []rune(m.lines[node.Line-1])
+	start := node.Column - 1
+	if start < 0 || start > len(text) {
+		return lineRange(m.lines, node.Line, node.Column)
+	}
+
+	end := -1
+	switch node.Style {
+	case yaml.DoubleQuotedStyle:
+		end = closingQuote(text, start, '"')
+	case yaml.SingleQuotedStyle:
+		end = closingQuote(text, start, '\'')
+	case yaml.LiteralStyle, yaml.FoldedStyle:
+		// Block scalars are marked at their indicator line
+	default:
+		if !strings.Contains(node.Value, "\n") {
+			end = start + utf8.RuneCountInString(node.Value)
+		}
+	}
+	if end < 0 || end > len(text) {
+		return lineRange(m.lines, node.Line, node.Column)
+	}
+
+	return domain.SourceRange{Line: node.Line, Column: node.Column, EndLine: node.Line, EndColumn: end + 1}
+}
+
+// closingQuote returns the index just past the quote that closes the string
+// opened at start, or -1 when the string continues on the next line
+func closingQuote(text []rune, start int, quote rune) int {
+	for i := start + 1; i < len(text); i++ {
+		switch {
+		case quote == '"' && text[i] == '\\':
+			i++
+		case text[i] == quote && quote == '\'' && i+1 < len(text) && text[i+1] == '\'':
+			i++
+		case text[i] == quote:
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// lineRange spans from column to the last character of the line that is not
+// white space
+func lineRange(lines []string, line, column int) domain.SourceRange {
+	text := []rune(strings.TrimRight(lines[line-1], " \t\r"))
+	end := len(text) + 1
+	if end < column {
+		end = column
+	}
+	return domain.SourceRange{Line: line, Column: column, EndLine: line, EndColumn: end}
+}
+
+// child returns the key and value nodes for token inside a mapping, or the
+// element node for an index into a sequence
+func child(node *yaml.Node, token string) (*yaml.Node, *yaml.Node) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == token {
+				return node.Content[i], node.Content[i+1]
+			}
+		}
+	case yaml.SequenceNode:
+		index, err := strconv.Atoi(token)
+		if err == nil && index >= 0 && index < len(node.Content) {
+			return nil, node.Content[index]
+		}
+	}
+	return nil, nil
+}
+
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+	return node
+}
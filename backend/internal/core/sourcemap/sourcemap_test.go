@@ -0,0 +1,112 @@
+package sourcemap
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+const yamlSource = `openapi: 3.0.3
+info:
+  title: "Pets \"API\""
+  version: 'it''s 1'
+paths:
+  /pets/{id}:
+    get:
+      tags: [pets, café]
+      description: |
+        Many lines
+      responses:
+        "200":
+          description: The pet
+x-base: &base
+  type: string
+x-alias: *base
+`
+
+const jsonSource = `{
+  "openapi": "3.1.0",
+  "paths": {
+    "/pets": {"get": {"operationId": "listPets"}}
+  }
+}`
+
+// TestRange locates pointers in YAML and JSON source, following the
+// escaping of pointer tokens and YAML aliases
+func TestRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		pointer string
+		want    domain.SourceRange
+	}{
+		{"root", yamlSource, "", domain.SourceRange{Line: 1, Column: 1, EndLine: 1, EndColumn: 15}},
+		{"plain scalar member", yamlSource, "/openapi", domain.SourceRange{Line: 1, Column: 1, EndLine: 1, EndColumn: 15}},
+		{"double quoted with escapes", yamlSource, "/info/title", domain.SourceRange{Line: 3, Column: 3, EndLine: 3, EndColumn: 24}},
+		{"single quoted with escapes", yamlSource, "/info/version", domain.SourceRange{Line: 4, Column: 3, EndLine: 4, EndColumn: 21}},
+		{"collection member spans its key", yamlSource, "/paths/~1pets~1{id}/get", domain.SourceRange{Line: 7, Column: 5, EndLine: 7, EndColumn: 8}},
+		{"flow sequence element counts characters", yamlSource, "/paths/~1pets~1{id}/get/tags/1", domain.SourceRange{Line: 8, Column: 20, EndLine: 8, EndColumn: 24}},
+		{"block scalar is marked at its indicator", yamlSource, "/paths/~1pets~1{id}/get/description", domain.SourceRange{Line: 9, Column: 7, EndLine: 9, EndColumn: 21}},
+		{"quoted key", yamlSource, "/paths/~1pets~1{id}/get/responses/200/description", domain.SourceRange{Line: 13, Column: 11, EndLine: 13, EndColumn: 31}},
+		{"missing member falls back to the deepest node", yamlSource, "/paths/~1pets~1{id}/get/responses/404", domain.SourceRange{Line: 11, Column: 7, EndLine: 11, EndColumn: 16}},
+		{"through an alias", yamlSource, "/x-alias/type", domain.SourceRange{Line: 15, Column: 3, EndLine: 15, EndColumn: 15}},
+		{"JSON member", jsonSource, "/paths/~1pets/get/operationId", domain.SourceRange{Line: 4, Column: 23, EndLine: 4, EndColumn: 48}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.source)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := m.Range(tt.pointer); got != tt.want {
+				t.Errorf("Range(%q) = %+v, want %+v", tt.pointer, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRangeWithoutSource gives no position for a map that could not be made
+func TestRangeWithoutSource(t *testing.T) {
+	var m *Map
+	if got := m.Range("/paths"); got != (domain.SourceRange{}) {
+		t.Errorf("Range on no map = %+v, want the zero range", got)
+	}
+	empty, err := Parse("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := empty.Range("/paths"); got != (domain.SourceRange{}) {
+		t.Errorf("Range on an empty document = %+v, want the zero range", got)
+	}
+}
+
+// TestParseErrorRange marks the line of YAML syntax errors, whether the
+// scanner or the parser found them
+func TestParseErrorRange(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		line   int
+	}{
+		{"tab indentation", "openapi: 3.0.3\ninfo:\n\ttitle: Pets\n", 3},
+		{"mapping value in a plain scalar", "a: 1\nb: 2\nc: d: e\n", 3},
+		{"key at the wrong indentation", "openapi: 3.0.3\ninfo:\n  title: Pets\n bad: x\n", 4},
+		{"sequence entry in a mapping", "a: 1\nb: 2\n- c\n", 3},
+		{"unclosed flow sequence", "openapi: 3.0.3\ninfo:\n  title: [Pets\n", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var value interface{}
+			err := yaml.Unmarshal([]byte(tt.source), &value)
+			if err == nil {
+				t.Fatal("bad YAML parsed")
+			}
+			if got := ParseErrorRange(tt.source, err); got.Line != tt.line || got.Column != 1 || got.EndLine != tt.line {
+				t.Errorf("range of %q = %+v, want line %d", err, got, tt.line)
+			}
+		})
+	}
+}
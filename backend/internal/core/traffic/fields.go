@@ -0,0 +1,155 @@
+package traffic
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// maxDepth bounds how deeply references and composition are followed when
+// collecting the properties of a schema
+const maxDepth = 8
+
+// undeclaredFields lists the properties of a JSON body that its schema does
+// not declare, at /body followed by the path of the property. Objects whose
+// schema lists no properties, or allows additional ones through a schema,
+// may hold anything; those that forbid additional properties are left to
+// the schema check, which reports them already.
+func (v *Validator) undeclaredFields(content map[string]domain.MediaType, header http.Header, body []byte) []domain.ValidationError {
+	schema := mediaSchema(content, header)
+	if schema == nil || len(body) == 0 {
+		return nil
+	}
+	var value interface{}
+	if json.Unmarshal(body, &value) != nil {
+		return nil
+	}
+
+	var errs []domain.ValidationError
+	v.walk(schema, value, "/body", &errs)
+	return errs
+}
+
+func (v *Validator) walk(schema, value interface{}, pointer string, errs *[]domain.ValidationError) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		object := v.resolve(schema)
+		properties, open := v.properties(schema, 0)
+		if len(properties) == 0 || object["additionalProperties"] == false {
+			return
+		}
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			child := resolver.JoinPointer(pointer, key)
+			if property, ok := properties[key]; ok {
+				v.walk(property, value[key], child, errs)
+				continue
+			}
+			if !open {
+				*errs = append(*errs, domain.ValidationError{
+					Path:    child,
+					Message: fmt.Sprintf("property %s is not declared by the schema", key),
+					Keyword: "undeclared",
+				})
+			}
+		}
+	case []interface{}:
+		items := v.items(schema, 0)
+		if items == nil {
+			return
+		}
+		for i, item := range value {
+			v.walk(items, item, pointer+"/"+strconv.Itoa(i), errs)
+		}
+	}
+}
+
+// properties collects the properties a schema declares, including those of
+// its allOf, oneOf and anyOf members. open reports whether other properties
+// are allowed by an additionalProperties schema or patternProperties.
+func (v *Validator) properties(schema interface{}, depth int) (map[string]interface{}, bool) {
+	object := v.resolve(schema)
+	if object == nil || depth > maxDepth {
+		return nil, false
+	}
+
+	properties := make(map[string]interface{})
+	for name, property := range asMap(object["properties"]) {
+		properties[name] = property
+	}
+	open := object["patternProperties"] != nil || object["additionalProperties"] == true
+	if _, ok := object["additionalProperties"].(map[string]interface{}); ok {
+		open = true
+	}
+
+	for _, keyword := range []string{"allOf", "oneOf", "anyOf"} {
+		members, _ := object[keyword].([]interface{})
+		for _, member := range members {
+			memberProperties, memberOpen := v.properties(member, depth+1)
+			for name, property := range memberProperties {
+				if _, ok := properties[name]; !ok {
+					properties[name] = property
+				}
+			}
+			open = open || memberOpen
+		}
+	}
+	return properties, open
+}
+
+// items returns the schema of the items of an array schema
+func (v *Validator) items(schema interface{}, depth int) interface{} {
+	object := v.resolve(schema)
+	if object == nil || depth > maxDepth {
+		return nil
+	}
+	if items, ok := object["items"]; ok {
+		return items
+	}
+	members, _ := object["allOf"].([]interface{})
+	for _, member := range members {
+		if items := v.items(member, depth+1); items != nil {
+			return items
+		}
+	}
+	return nil
+}
+
+// resolve follows references and returns the schema object, or nil. A
+// schema given as a string is the ID of a named schema.
+func (v *Validator) resolve(schema interface{}) map[string]interface{} {
+	for i := 0; i <= maxDepth; i++ {
+		switch s := schema.(type) {
+		case string:
+			schema = map[string]interface{}{"$ref": s}
+		case map[string]interface{}:
+			ref, ok := s["$ref"].(string)
+			if !ok {
+				return s
+			}
+			target, err := v.refs.Lookup(ref)
+			if err != nil {
+				return nil
+			}
+			schema = target
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+func asMap(value interface{}) map[string]interface{} {
+	m, _ := value.(map[string]interface{})
+	return m
+}
@@ -0,0 +1,230 @@
+package traffic
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Formats of recorded traffic
+const (
+	FormatHAR       = "har"
+	FormatJSONLines = "jsonl"
+)
+
+// maxLineSize limits the length of one exchange in a JSON lines log
+const maxLineSize = 10 << 20
+
+// Parse reads recorded traffic in a format, or detects it when format is
+// empty: HAR documents are JSON objects with a log; anything else is read as
+// JSON lines.
+func Parse(data []byte, format string) ([]Exchange, error) {
+	if format == "" {
+		format = FormatJSONLines
+		var probe struct {
+			Log json.RawMessage `json:"log"`
+		}
+		if json.Unmarshal(data, &probe) == nil && probe.Log != nil {
+			format = FormatHAR
+		}
+	}
+
+	switch format {
+	case FormatHAR:
+		return parseHAR(data)
+	case FormatJSONLines:
+		return parseJSONLines(data)
+	}
+	return nil, fmt.Errorf("unsupported traffic format: %s", format)
+}
+
+// harHeader is a header of a HAR request or response
+type harHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// har is the part of an HTTP Archive that describes exchanges
+type har struct {
+	Log struct {
+		Entries []struct {
+			Request struct {
+				Method   string      `json:"method"`
+				URL      string      `json:"url"`
+				Headers  []harHeader `json:"headers"`
+				PostData *struct {
+					MimeType string `json:"mimeType"`
+					Text     string `json:"text"`
+				} `json:"postData"`
+			} `json:"request"`
+			Response struct {
+				Status  int         `json:"status"`
+				Headers []harHeader `json:"headers"`
+				Content struct {
+					MimeType string `json:"mimeType"`
+					Text     string `json:"text"`
+					Encoding string `json:"encoding"`
+				} `json:"content"`
+			} `json:"response"`
+		} `json:"entries"`
+	} `json:"log"`
+}
+
+func parseHAR(data []byte) ([]Exchange, error) {
+	var archive har
+	if err := json.Unmarshal(data, &archive); err != nil {
+		return nil, fmt.Errorf("invalid HAR document: %w", err)
+	}
+
+	exchanges := make([]Exchange, 0, len(archive.Log.Entries))
+	for i, entry := range archive.Log.Entries {
+		exchange := Exchange{
+			Method:          entry.Request.Method,
+			URL:             entry.Request.URL,
+			RequestHeaders:  harHeaders(entry.Request.Headers),
+			Status:          entry.Response.Status,
+			ResponseHeaders: harHeaders(entry.Response.Headers),
+		}
+		if post := entry.Request.PostData; post != nil {
+			exchange.RequestBody = []byte(post.Text)
+			if exchange.RequestHeaders.Get("Content-Type") == "" && post.MimeType != "" {
+				exchange.RequestHeaders.Set("Content-Type", post.MimeType)
+			}
+		}
+
+		content := entry.Response.Content
+		exchange.ResponseBody = []byte(content.Text)
+		if content.Encoding == "base64" {
+			body, err := base64.StdEncoding.DecodeString(content.Text)
+			if err != nil {
+				return nil, fmt.Errorf("entry %d: invalid base64 response body: %w", i, err)
+			}
+			exchange.ResponseBody = body
+		}
+		if exchange.ResponseHeaders.Get("Content-Type") == "" && content.MimeType != "" && len(exchange.ResponseBody) > 0 {
+			exchange.ResponseHeaders.Set("Content-Type", content.MimeType)
+		}
+
+		exchanges = append(exchanges, exchange)
+	}
+	return exchanges, nil
+}
+
+func harHeaders(headers []harHeader) http.Header {
+	header := make(http.Header, len(headers))
+	for _, h := range headers {
+		// HTTP/2 pseudo-headers such as :authority are not headers
+		if !strings.HasPrefix(h.Name, ":") {
+			header.Add(h.Name, h.Value)
+		}
+	}
+	return header
+}
+
+// line is one exchange of a JSON lines log. Headers map names to a value or
+// a list of values; bodies are raw text or, for JSON, the document itself.
+type line struct {
+	Request struct {
+		Method  string                     `json:"method"`
+		URL     string                     `json:"url"`
+		Headers map[string]json.RawMessage `json:"headers"`
+		Body    json.RawMessage            `json:"body"`
+	} `json:"request"`
+	Response *struct {
+		Status  int                        `json:"status"`
+		Headers map[string]json.RawMessage `json:"headers"`
+		Body    json.RawMessage            `json:"body"`
+	} `json:"response"`
+}
+
+func parseJSONLines(data []byte) ([]Exchange, error) {
+	var exchanges []Exchange
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	for number := 1; scanner.Scan(); number++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		var l line
+		if err := json.Unmarshal(text, &l); err != nil {
+			return nil, fmt.Errorf("line %d: %w", number, err)
+		}
+		if l.Request.Method == "" || l.Request.URL == "" {
+			return nil, fmt.Errorf("line %d: request method and url are required", number)
+		}
+
+		exchange := Exchange{
+			Method:         l.Request.Method,
+			URL:            l.Request.URL,
+			RequestHeaders: lineHeaders(l.Request.Headers),
+			RequestBody:    lineBody(l.Request.Body),
+		}
+		if l.Response != nil {
+			exchange.Status = l.Response.Status
+			exchange.ResponseHeaders = lineHeaders(l.Response.Headers)
+			exchange.ResponseBody = lineBody(l.Response.Body)
+		}
+		defaultContentType(exchange.RequestHeaders, l.Request.Body)
+		if l.Response != nil {
+			defaultContentType(exchange.ResponseHeaders, l.Response.Body)
+		}
+		exchanges = append(exchanges, exchange)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(exchanges) == 0 {
+		return nil, errors.New("no exchanges found")
+	}
+	return exchanges, nil
+}
+
+func lineHeaders(headers map[string]json.RawMessage) http.Header {
+	header := make(http.Header, len(headers))
+	for name, raw := range headers {
+		var values []string
+		if json.Unmarshal(raw, &values) != nil {
+			var value string
+			if json.Unmarshal(raw, &value) != nil {
+				continue
+			}
+			values = []string{value}
+		}
+		for _, value := range values {
+			header.Add(name, value)
+		}
+	}
+	return header
+}
+
+// lineBody returns the bytes of a body: the text of a string, or the JSON
+// document as written
+func lineBody(raw json.RawMessage) []byte {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	var text string
+	if json.Unmarshal(raw, &text) == nil {
+		return []byte(text)
+	}
+	return raw
+}
+
+// defaultContentType marks a body given as a JSON document as JSON when the
+// log does not record its Content-Type
+func defaultContentType(header http.Header, raw json.RawMessage) {
+	if header.Get("Content-Type") != "" || len(raw) == 0 || string(raw) == "null" {
+		return
+	}
+	if raw[0] != '"' {
+		header.Set("Content-Type", "application/json")
+	}
+}
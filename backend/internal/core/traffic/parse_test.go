@@ -0,0 +1,121 @@
+package traffic
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestParseHAR reads a HAR document, detected from its log, into exchanges
+func TestParseHAR(t *testing.T) {
+	exchanges, err := Parse([]byte(`{"log": {"entries": [
+		{
+			"request": {
+				"method": "POST", "url": "https://api.example.com/pets",
+				"headers": [{"name": ":authority", "value": "api.example.com"}, {"name": "X-Trace", "value": "a"}, {"name": "X-Trace", "value": "b"}],
+				"postData": {"mimeType": "application/json", "text": "{\"name\": \"Rex\"}"}
+			},
+			"response": {
+				"status": 201,
+				"headers": [],
+				"content": {"mimeType": "application/json", "text": "eyJpZCI6IDF9", "encoding": "base64"}
+			}
+		},
+		{
+			"request": {"method": "DELETE", "url": "https://api.example.com/pets/1", "headers": []},
+			"response": {"status": 204, "headers": [], "content": {"mimeType": "text/plain", "text": ""}}
+		}
+	]}}`), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exchanges) != 2 {
+		t.Fatalf("%d exchanges, want 2", len(exchanges))
+	}
+
+	created := exchanges[0]
+	if created.Method != "POST" || created.URL != "https://api.example.com/pets" || created.Status != 201 {
+		t.Errorf("exchange = %s %s %d, want POST /pets 201", created.Method, created.URL, created.Status)
+	}
+	if got := created.RequestHeaders.Values("X-Trace"); strings.Join(got, ",") != "a,b" {
+		t.Errorf("X-Trace = %v, want both values", got)
+	}
+	if _, ok := created.RequestHeaders[":authority"]; ok {
+		t.Error("pseudo-header :authority kept as a header")
+	}
+	if created.RequestHeaders.Get("Content-Type") != "application/json" || string(created.RequestBody) != `{"name": "Rex"}` {
+		t.Errorf("request body = %s (%s), want the posted JSON", created.RequestBody, created.RequestHeaders.Get("Content-Type"))
+	}
+	if created.ResponseHeaders.Get("Content-Type") != "application/json" || string(created.ResponseBody) != `{"id": 1}` {
+		t.Errorf("response body = %s (%s), want the decoded JSON", created.ResponseBody, created.ResponseHeaders.Get("Content-Type"))
+	}
+
+	if deleted := exchanges[1]; deleted.ResponseHeaders.Get("Content-Type") != "" {
+		t.Errorf("empty response typed %s, want no Content-Type", deleted.ResponseHeaders.Get("Content-Type"))
+	}
+}
+
+// TestParseJSONLines reads a JSON lines log with string and list headers
+// and bodies given as text or as JSON documents
+func TestParseJSONLines(t *testing.T) {
+	exchanges, err := Parse([]byte(`
+{"request": {"method": "POST", "url": "/pets", "headers": {"Accept": ["application/json", "text/plain"]}, "body": {"name": "Rex"}}, "response": {"status": 201, "body": {"id": 1}}}
+
+{"request": {"method": "PUT", "url": "/pets/1", "headers": {"Content-Type": "text/plain"}, "body": "Rex"}, "response": {"status": 204, "headers": {"X-Count": 3}}}
+{"request": {"method": "GET", "url": "/pets"}}
+`), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exchanges) != 3 {
+		t.Fatalf("%d exchanges, want 3 with blank lines skipped", len(exchanges))
+	}
+
+	created := exchanges[0]
+	if got := created.RequestHeaders.Values("Accept"); len(got) != 2 {
+		t.Errorf("Accept = %v, want both values", got)
+	}
+	if created.RequestHeaders.Get("Content-Type") != "application/json" || string(created.RequestBody) != `{"name": "Rex"}` {
+		t.Errorf("request body = %s (%s), want the JSON document typed as JSON", created.RequestBody, created.RequestHeaders.Get("Content-Type"))
+	}
+	if created.Status != 201 || created.ResponseHeaders.Get("Content-Type") != "application/json" {
+		t.Errorf("response = %d (%s), want 201 typed as JSON", created.Status, created.ResponseHeaders.Get("Content-Type"))
+	}
+
+	replaced := exchanges[1]
+	if replaced.RequestHeaders.Get("Content-Type") != "text/plain" || string(replaced.RequestBody) != "Rex" {
+		t.Errorf("request body = %q (%s), want the text as recorded", replaced.RequestBody, replaced.RequestHeaders.Get("Content-Type"))
+	}
+	if _, ok := replaced.ResponseHeaders["X-Count"]; ok {
+		t.Error("header with a number value kept, want it skipped")
+	}
+
+	if listed := exchanges[2]; listed.Status != 0 || listed.ResponseHeaders != nil {
+		t.Errorf("exchange without a response has status %d, want 0", listed.Status)
+	}
+}
+
+// TestParseErrors reads traffic that cannot be used and gets an error
+// naming the problem
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   string
+		format string
+		want   string
+	}{
+		{"unknown format", `{}`, "pcap", "unsupported traffic format"},
+		{"HAR that is not JSON", `{"log": `, FormatHAR, "invalid HAR document"},
+		{"bad base64 body", `{"log": {"entries": [{"request": {"method": "GET", "url": "/"}, "response": {"status": 200, "content": {"text": "!!", "encoding": "base64"}}}]}}`, "", "entry 0: invalid base64"},
+		{"bad line", "{\"request\": {\"method\": \"GET\", \"url\": \"/\"}}\nnot json", "", "line 2"},
+		{"line without a method", `{"request": {"url": "/pets"}}`, "", "line 1: request method and url are required"},
+		{"empty log", "\n\n", "", "no exchanges found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.data), tt.format)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+}
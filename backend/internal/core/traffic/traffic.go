@@ -0,0 +1,254 @@
+// Package traffic validates recorded HTTP exchanges, such as those exported
+// from a gateway, against an API definition. Requests are matched to
+// endpoints and checked as the mock server checks them, responses as the
+// contract tests check them, and bodies are searched for properties their
+// schemas do not declare. The problems are grouped, counted and illustrated
+// with sample requests.
+package traffic
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/swagger-editor/backend/internal/core/contract"
+	"github.com/swagger-editor/backend/internal/core/domain"
+	"github.com/swagger-editor/backend/internal/core/mock"
+	"github.com/swagger-editor/backend/internal/core/resolver"
+)
+
+// maxSamples bounds the sample requests kept for each violation
+const maxSamples = 3
+
+// Exchange is a recorded request and its response. Status is 0 when no
+// response was recorded.
+type Exchange struct {
+	Method          string
+	URL             string
+	RequestHeaders  http.Header
+	RequestBody     []byte
+	Status          int
+	ResponseHeaders http.Header
+	ResponseBody    []byte
+}
+
+// identifierSegment matches path segments that identify a resource, which
+// are grouped together in undocumented endpoints
+var identifierSegment = regexp.MustCompile(`^([0-9]+|[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})$`)
+
+// Validator checks exchanges against one API definition
+type Validator struct {
+	api       *domain.APIDefinition
+	mock      *mock.Mock
+	contract  *contract.Runner
+	refs      *resolver.SchemaResolver
+	bodies    map[string]domain.RequestBody
+	responses map[string]domain.Response
+}
+
+// New creates a validator for an API definition
+func New(api *domain.APIDefinition) *Validator {
+	v := &Validator{
+		api:       api,
+		mock:      mock.New(api),
+		contract:  contract.New(api, nil, 0),
+		refs:      resolver.NewSchemaResolver(api),
+		bodies:    make(map[string]domain.RequestBody, len(api.RequestBodies)),
+		responses: make(map[string]domain.Response, len(api.Responses)),
+	}
+	for _, body := range api.RequestBodies {
+		v.bodies[body.ID] = body
+	}
+	for _, response := range api.Responses {
+		v.responses[response.ID] = response
+	}
+	return v
+}
+
+// Validate checks every exchange and sums up the violations found
+func (v *Validator) Validate(exchanges []Exchange) *domain.TrafficReport {
+	report := &domain.TrafficReport{
+		DefinitionID: v.api.ID,
+		Exchanges:    len(exchanges),
+		Violations:   []domain.TrafficViolation{},
+	}
+
+	groups := make(map[string]int)
+	for _, exchange := range exchanges {
+		violations := v.check(exchange)
+		if len(violations) == 0 {
+			report.Conforming++
+			continue
+		}
+		report.Violating++
+
+		sample := domain.TrafficSample{
+			Method: strings.ToUpper(exchange.Method),
+			URL:    exchange.URL,
+			Status: exchange.Status,
+		}
+		for _, violation := range violations {
+			key := strings.Join([]string{string(violation.Kind), violation.Endpoint, violation.Path, violation.Keyword}, "\x00")
+			i, seen := groups[key]
+			if !seen {
+				i = len(report.Violations)
+				groups[key] = i
+				violation.Samples = []domain.TrafficSample{}
+				report.Violations = append(report.Violations, violation)
+			}
+			group := &report.Violations[i]
+			group.Count++
+			if len(group.Samples) < maxSamples {
+				group.Samples = append(group.Samples, sample)
+			}
+		}
+	}
+
+	sort.SliceStable(report.Violations, func(i, j int) bool {
+		return report.Violations[i].Count > report.Violations[j].Count
+	})
+	return report
+}
+
+// check returns the violations of one exchange, at most one of each
+func (v *Validator) check(exchange Exchange) []domain.TrafficViolation {
+	method := strings.ToUpper(exchange.Method)
+	target, err := url.Parse(exchange.URL)
+	if err != nil {
+		return []domain.TrafficViolation{{
+			Kind:     domain.ViolationRequest,
+			Endpoint: method + " " + exchange.URL,
+			Message:  fmt.Sprintf("invalid URL: %v", err),
+			Keyword:  "url",
+		}}
+	}
+
+	request := &domain.MockRequest{
+		Method:  method,
+		Path:    target.Path,
+		Query:   target.Query(),
+		Headers: exchange.RequestHeaders,
+		Body:    exchange.RequestBody,
+	}
+	endpoint, allowed, errs := v.mock.Check(request)
+	if endpoint == nil {
+		violation := domain.TrafficViolation{
+			Kind:     domain.ViolationUndocumentedEndpoint,
+			Endpoint: method + " " + pathShape(target.Path),
+			Message:  fmt.Sprintf("no endpoint matches %s", target.Path),
+		}
+		if len(allowed) > 0 {
+			violation.Kind = domain.ViolationUndocumentedMethod
+			violation.Message = fmt.Sprintf("method %s is not declared for %s; declared: %s", method, target.Path, strings.Join(allowed, ", "))
+		}
+		return []domain.TrafficViolation{violation}
+	}
+
+	operation := strings.ToUpper(endpoint.Method) + " " + endpoint.Path
+	var violations []domain.TrafficViolation
+	add := func(kind domain.ViolationKind, prefix string, errs []domain.ValidationError) {
+		for _, err := range errs {
+			violations = append(violations, domain.TrafficViolation{
+				Kind:     kind,
+				Endpoint: operation,
+				Path:     prefix + groupPointer(err.Path),
+				Keyword:  err.Keyword,
+				Message:  err.Message,
+			})
+		}
+	}
+
+	add(domain.ViolationRequest, "/request", errs)
+	if body, ok := v.bodies[endpoint.RequestBody]; ok {
+		add(domain.ViolationUndocumentedField, "/request",
+			v.undeclaredFields(body.Content, exchange.RequestHeaders, exchange.RequestBody))
+	}
+
+	if exchange.Status != 0 {
+		header := exchange.ResponseHeaders
+		if header == nil {
+			header = http.Header{}
+		}
+		add(domain.ViolationResponse, "/response",
+			v.contract.CheckResponse(endpoint, exchange.Status, header, exchange.ResponseBody))
+		if response, ok := v.responses[endpoint.Responses[declaredCode(endpoint.Responses, exchange.Status)]]; ok {
+			add(domain.ViolationUndocumentedField, "/response",
+				v.undeclaredFields(response.Content, header, exchange.ResponseBody))
+		}
+	}
+
+	return dedupe(violations)
+}
+
+// declaredCode returns the response code that covers a status: the status
+// itself, its range such as 4XX, or default
+func declaredCode(responses map[string]string, status int) string {
+	code := fmt.Sprint(status)
+	for _, candidate := range []string{code, code[:1] + "XX", code[:1] + "xx", "default"} {
+		if _, ok := responses[candidate]; ok {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// mediaSchema returns the schema declared for the media type of a body
+func mediaSchema(content map[string]domain.MediaType, header http.Header) interface{} {
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil || (mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json")) {
+		return nil
+	}
+	if media, ok := content[mediaType]; ok {
+		return media.Schema
+	}
+	if media, ok := content["application/*"]; ok {
+		return media.Schema
+	}
+	return content["*/*"].Schema
+}
+
+// pathShape replaces the segments of a path that look like identifiers with
+// {id}, so that requests for different resources group together
+func pathShape(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if identifierSegment.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// groupPointer replaces the array indexes in a body pointer with *, so that
+// the same problem in different items groups together
+func groupPointer(pointer string) string {
+	if !strings.HasPrefix(pointer, "/body/") {
+		return pointer
+	}
+	tokens := strings.Split(pointer, "/")
+	for i, token := range tokens {
+		if token != "" && strings.Trim(token, "0123456789") == "" {
+			tokens[i] = "*"
+		}
+	}
+	return strings.Join(tokens, "/")
+}
+
+// dedupe drops repeated violations, such as the same problem in several
+// items of an array
+func dedupe(violations []domain.TrafficViolation) []domain.TrafficViolation {
+	seen := make(map[string]bool)
+	result := violations[:0]
+	for _, violation := range violations {
+		key := string(violation.Kind) + "\x00" + violation.Path + "\x00" + violation.Keyword
+		if !seen[key] {
+			seen[key] = true
+			result = append(result, violation)
+		}
+	}
+	return result
+}
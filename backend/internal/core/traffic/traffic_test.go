@@ -0,0 +1,246 @@
+package traffic
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/swagger-editor/backend/internal/core/domain"
+)
+
+// petsAPI returns a definition with parameters, bodies and responses to
+// check recorded exchanges against
+func petsAPI() *domain.APIDefinition {
+	return &domain.APIDefinition{
+		ID: "pets",
+		Endpoints: []domain.Endpoint{
+			{
+				ID: "endpoint-listpets", Method: "GET", Path: "/pets",
+				Parameters: []string{"param-limit"},
+				Responses:  map[string]string{"200": "response-pets", "4XX": "response-error"},
+			},
+			{
+				ID: "endpoint-createpet", Method: "POST", Path: "/pets",
+				RequestBody: "body-pet",
+				Responses:   map[string]string{"201": "response-pet"},
+			},
+			{
+				ID: "endpoint-getpet", Method: "GET", Path: "/pets/{id}",
+				Parameters: []string{"param-id"},
+				Responses:  map[string]string{"200": "response-pet"},
+			},
+		},
+		Parameters: []domain.Parameter{
+			{ID: "param-limit", Name: "limit", In: "query", Schema: map[string]interface{}{"type": "integer", "maximum": 100.0}},
+			{ID: "param-id", Name: "id", In: "path", Required: true, Schema: map[string]interface{}{"type": "integer"}},
+		},
+		RequestBodies: []domain.RequestBody{
+			{ID: "body-pet", Required: true, Content: map[string]domain.MediaType{"application/json": {Schema: "schema-newpet"}}},
+		},
+		Responses: []domain.Response{
+			{ID: "response-pets", Description: "The pets", Content: map[string]domain.MediaType{
+				"application/json": {Schema: map[string]interface{}{"type": "array", "items": "schema-pet"}},
+			}},
+			{ID: "response-pet", Description: "The pet", Content: map[string]domain.MediaType{
+				"application/json": {Schema: "schema-pet"},
+			}},
+			{ID: "response-error", Description: "Error", Content: map[string]domain.MediaType{
+				"application/problem+json": {Schema: map[string]interface{}{"type": "object", "additionalProperties": true}},
+			}},
+		},
+		Schemas: []domain.Schema{
+			{ID: "schema-newpet", Name: "NewPet", Type: "object", Required: []string{"name"}, Properties: map[string]interface{}{
+				"name":  map[string]interface{}{"type": "string"},
+				"owner": "schema-owner",
+			}},
+			{ID: "schema-pet", Name: "Pet", AllOf: []interface{}{
+				"schema-newpet",
+				map[string]interface{}{"type": "object", "required": []interface{}{"id"}, "properties": map[string]interface{}{
+					"id": map[string]interface{}{"type": "integer"},
+				}},
+			}},
+			{ID: "schema-owner", Name: "Owner", Type: "object", Properties: map[string]interface{}{
+				"email": map[string]interface{}{"type": "string"},
+			}, AdditionalProperties: false},
+		},
+	}
+}
+
+// jsonHeader types the bodies of exchanges as JSON
+var jsonHeader = http.Header{"Content-Type": {"application/json"}}
+
+// TestValidate checks single exchanges and lists each violation as its
+// kind, endpoint, path and keyword
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		exchange Exchange
+		want     []string
+	}{
+		{
+			name:     "conforming list",
+			exchange: Exchange{Method: "get", URL: "https://api.example.com/pets?limit=10", Status: 200, ResponseHeaders: jsonHeader, ResponseBody: []byte(`[{"id": 1, "name": "Rex"}]`)},
+		},
+		{
+			name:     "request without a recorded response",
+			exchange: Exchange{Method: "GET", URL: "/pets/1"},
+		},
+		{
+			name:     "undocumented endpoint",
+			exchange: Exchange{Method: "GET", URL: "/owners/42", Status: 200},
+			want:     []string{"undocumented-endpoint GET /owners/{id}  "},
+		},
+		{
+			name:     "undocumented method",
+			exchange: Exchange{Method: "DELETE", URL: "/pets", Status: 204},
+			want:     []string{"undocumented-method DELETE /pets  "},
+		},
+		{
+			name:     "invalid URL",
+			exchange: Exchange{Method: "GET", URL: "http://%zz"},
+			want:     []string{"request GET http://%zz  url"},
+		},
+		{
+			name:     "query parameter out of range",
+			exchange: Exchange{Method: "GET", URL: "/pets?limit=500", Status: 200, ResponseHeaders: jsonHeader, ResponseBody: []byte(`[]`)},
+			want:     []string{"request GET /pets /request/parameters/query/limit maximum"},
+		},
+		{
+			name:     "request body breaks the schema",
+			exchange: Exchange{Method: "POST", URL: "/pets", RequestHeaders: jsonHeader, RequestBody: []byte(`{}`), Status: 201, ResponseHeaders: jsonHeader, ResponseBody: []byte(`{"id": 1, "name": "Rex"}`)},
+			want:     []string{"request POST /pets /request/body required"},
+		},
+		{
+			name:     "undeclared request field",
+			exchange: Exchange{Method: "POST", URL: "/pets", RequestHeaders: jsonHeader, RequestBody: []byte(`{"name": "Rex", "color": "brown"}`), Status: 201, ResponseHeaders: jsonHeader, ResponseBody: []byte(`{"id": 1, "name": "Rex"}`)},
+			want:     []string{"undocumented-field POST /pets /request/body/color undeclared"},
+		},
+		{
+			name:     "undeclared status",
+			exchange: Exchange{Method: "POST", URL: "/pets", RequestHeaders: jsonHeader, RequestBody: []byte(`{"name": "Rex"}`), Status: 500},
+			want:     []string{"response POST /pets /response/status status"},
+		},
+		{
+			name:     "response body breaks the schema",
+			exchange: Exchange{Method: "GET", URL: "/pets/1", Status: 200, ResponseHeaders: jsonHeader, ResponseBody: []byte(`{"id": "one", "name": "Rex"}`)},
+			want:     []string{"response GET /pets/{id} /response/body/id type"},
+		},
+		{
+			name:     "undeclared fields in array items group together",
+			exchange: Exchange{Method: "GET", URL: "/pets", Status: 200, ResponseHeaders: jsonHeader, ResponseBody: []byte(`[{"id": 1, "name": "Rex", "age": 3}, {"id": 2, "name": "Tom", "age": 5}]`)},
+			want:     []string{"undocumented-field GET /pets /response/body/*/age undeclared"},
+		},
+		{
+			name:     "status range with an open schema",
+			exchange: Exchange{Method: "GET", URL: "/pets?limit=1", Status: 404, ResponseHeaders: http.Header{"Content-Type": {"application/problem+json"}}, ResponseBody: []byte(`{"title": "Not found"}`)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := New(petsAPI()).Validate([]Exchange{tt.exchange})
+			var got []string
+			for _, v := range report.Violations {
+				got = append(got, strings.Join([]string{string(v.Kind), v.Endpoint, v.Path, v.Keyword}, " "))
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("violations:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+				for _, v := range report.Violations {
+					t.Logf("  %s: %s", v.Path, v.Message)
+				}
+			}
+			if conforming := len(tt.want) == 0; (report.Conforming == 1) != conforming || (report.Violating == 1) == conforming {
+				t.Errorf("conforming = %d, violating = %d", report.Conforming, report.Violating)
+			}
+		})
+	}
+}
+
+// TestValidateGroupsViolations validates several exchanges with the same
+// problem and finds one violation counting them, with a few samples, ahead
+// of rarer ones
+func TestValidateGroupsViolations(t *testing.T) {
+	var exchanges []Exchange
+	for _, id := range []string{"1", "2", "3", "4", "5"} {
+		exchanges = append(exchanges, Exchange{Method: "GET", URL: "/owners/" + id, Status: 200})
+	}
+	exchanges = append(exchanges,
+		Exchange{Method: "DELETE", URL: "/pets", Status: 204},
+		Exchange{Method: "GET", URL: "/pets/1", Status: 200, ResponseHeaders: jsonHeader, ResponseBody: []byte(`{"id": 1, "name": "Rex"}`)},
+	)
+
+	report := New(petsAPI()).Validate(exchanges)
+	if report.DefinitionID != "pets" || report.Exchanges != 7 || report.Conforming != 1 || report.Violating != 6 {
+		t.Errorf("report counts %d exchanges, %d conforming, %d violating, want 7, 1, 6", report.Exchanges, report.Conforming, report.Violating)
+	}
+	if len(report.Violations) != 2 {
+		t.Fatalf("violations = %+v, want two groups", report.Violations)
+	}
+
+	owners := report.Violations[0]
+	if owners.Endpoint != "GET /owners/{id}" || owners.Count != 5 {
+		t.Errorf("first group = %s x%d, want GET /owners/{id} x5", owners.Endpoint, owners.Count)
+	}
+	if len(owners.Samples) != maxSamples || owners.Samples[0].URL != "/owners/1" || owners.Samples[0].Status != 200 {
+		t.Errorf("samples = %+v, want the first %d requests", owners.Samples, maxSamples)
+	}
+	if method := report.Violations[1]; method.Kind != domain.ViolationUndocumentedMethod || method.Count != 1 {
+		t.Errorf("second group = %s x%d, want the undocumented method once", method.Kind, method.Count)
+	}
+	if !strings.Contains(report.Violations[1].Message, "declared: GET, POST") {
+		t.Errorf("message = %q, want the declared methods", report.Violations[1].Message)
+	}
+}
+
+// TestUndeclaredFields searches JSON bodies for properties their schemas do
+// not declare
+func TestUndeclaredFields(t *testing.T) {
+	v := New(petsAPI())
+	tests := []struct {
+		name   string
+		schema interface{}
+		header http.Header
+		body   string
+		want   []string
+	}{
+		{"declared through allOf", "schema-pet", jsonHeader, `{"id": 1, "name": "Rex"}`, nil},
+		{"undeclared", "schema-pet", jsonHeader, `{"id": 1, "name": "Rex", "age": 3, "color": "brown"}`, []string{"/body/age", "/body/color"}},
+		{"closed object left to the schema check", "schema-newpet", jsonHeader, `{"name": "Rex", "owner": {"email": "a@b", "phone": "1"}}`, nil},
+		{"array items", map[string]interface{}{"items": "schema-newpet"}, jsonHeader, `[{"name": "Rex"}, {"nick": "T"}]`, []string{"/body/1/nick"}},
+		{"additional properties allowed", map[string]interface{}{"properties": map[string]interface{}{"a": map[string]interface{}{}}, "additionalProperties": map[string]interface{}{"type": "string"}}, jsonHeader, `{"b": "x"}`, nil},
+		{"pattern properties", map[string]interface{}{"properties": map[string]interface{}{"a": map[string]interface{}{}}, "patternProperties": map[string]interface{}{"^x-": map[string]interface{}{}}}, jsonHeader, `{"x-b": 1}`, nil},
+		{"no properties listed", map[string]interface{}{"type": "object"}, jsonHeader, `{"b": 1}`, nil},
+		{"vendor JSON type", "schema-newpet", http.Header{"Content-Type": {"application/vnd.pets+json"}}, `{"nick": "R"}`, []string{"/body/nick"}},
+		{"not JSON", "schema-newpet", http.Header{"Content-Type": {"text/plain"}}, `{"nick": "R"}`, nil},
+		{"invalid JSON", "schema-newpet", jsonHeader, `{"nick":`, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := map[string]domain.MediaType{"*/*": {Schema: tt.schema}}
+			var got []string
+			for _, err := range v.undeclaredFields(content, tt.header, []byte(tt.body)) {
+				got = append(got, err.Path)
+			}
+			if strings.Join(got, ", ") != strings.Join(tt.want, ", ") {
+				t.Errorf("undeclared = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestPathShape groups identifiers in paths of undocumented endpoints
+func TestPathShape(t *testing.T) {
+	tests := map[string]string{
+		"/owners/42":      "/owners/{id}",
+		"/owners/42/pets": "/owners/{id}/pets",
+		"/owners/123e4567-e89b-12d3-a456-426614174000": "/owners/{id}",
+		"/owners/ann": "/owners/ann",
+		"/v2/owners":  "/v2/owners",
+	}
+	for path, want := range tests {
+		if got := pathShape(path); got != want {
+			t.Errorf("pathShape(%s) = %s, want %s", path, got, want)
+		}
+	}
+}
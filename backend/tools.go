@@ -0,0 +1,7 @@
+//go:build tools
+
+// Package tools pins the code generators of the module, so go generate runs
+// the versions in go.mod
+package tools
+
+import _ "github.com/99designs/gqlgen"
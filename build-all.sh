@@ -21,7 +21,8 @@ echo -e "${GREEN}✅ Frontend built successfully${NC}"
 # Build backend
 echo -e "${YELLOW}Building Backend...${NC}"
 cd ../backend
-CGO_ENABLED=0 GOOS=linux go build -a -installsuffix cgo -o bin/api cmd/api/main.go
+# The SQLite storage driver is written in C, so the binary is built with cgo
+CGO_ENABLED=1 GOOS=linux go build -o bin/api cmd/api/main.go
 if [ $? -ne 0 ]; then
     echo -e "${RED}❌ Backend build failed${NC}"
     exit 1
@@ -1,15 +1,51 @@
+/** x- fields, keyed by their full name */
+export type Extensions = Record<string, unknown>;
+
 export interface APIMetadata {
   name: string;
   version: string;
+  specVersion?: string;
+  summary?: string;
   description?: string;
+  termsOfService?: string;
   baseUrl?: string;
-  license?: {
-    name: string;
-    url?: string;
-  };
+  servers?: Server[];
+  contact?: Contact;
+  license?: License;
+  externalDocs?: ExternalDocs;
   tags?: string[];
-  createdAt?: string;
-  updatedAt?: string;
+  extensions?: Extensions;
+}
+
+export interface Contact {
+  name?: string;
+  url?: string;
+  email?: string;
+}
+
+export interface License {
+  name: string;
+  identifier?: string;
+  url?: string;
+}
+
+export interface Server {
+  url: string;
+  description?: string;
+  variables?: Record<string, any>;
+  extensions?: Extensions;
+}
+
+export interface ExternalDocs {
+  description?: string;
+  url: string;
+}
+
+export interface Tag {
+  name: string;
+  description?: string;
+  externalDocs?: ExternalDocs;
+  extensions?: Extensions;
 }
 
 export interface Endpoint {
@@ -23,64 +59,151 @@ export interface Endpoint {
   parameters?: string[];
   requestBody?: string;
   responses: Record<string, string>;
-  security?: SecurityRequirement[];
+  callbacks?: Record<string, any>;
+  /** An empty list disables the global requirements, null inherits them */
+  security?: SecurityRequirement[] | null;
+  servers?: Server[];
+  externalDocs?: ExternalDocs;
+  deprecated?: boolean;
+  extensions?: Extensions;
+}
+
+export interface PathItem {
+  path: string;
+  summary?: string;
+  description?: string;
+  servers?: Server[];
+  extensions?: Extensions;
 }
 
+/**
+ * A named schema. Nested schemas are raw schema objects whose $ref values
+ * hold the ID of another schema. type is the first non-null type; types is
+ * only set when the document lists several.
+ */
 export interface Schema {
   id: string;
   name: string;
+  $ref?: string;
   type: string;
+  types?: string[];
+  title?: string;
   required?: string[];
   properties?: Record<string, any>;
+  patternProperties?: Record<string, any>;
+  additionalProperties?: boolean | any;
+  unevaluatedProperties?: boolean | any;
+  propertyNames?: any;
+  dependentRequired?: Record<string, string[]>;
+  dependentSchemas?: Record<string, any>;
   items?: string | any;
-  enum?: string[];
+  prefixItems?: any[];
+  unevaluatedItems?: boolean | any;
+  contains?: any;
+  minContains?: number;
+  maxContains?: number;
+  allOf?: any[];
+  oneOf?: any[];
+  anyOf?: any[];
+  not?: any;
+  if?: any;
+  then?: any;
+  else?: any;
+  discriminator?: any;
+  enum?: unknown[];
+  const?: unknown;
   format?: string;
+  pattern?: string;
+  minLength?: number;
+  maxLength?: number;
+  minimum?: number;
+  maximum?: number;
+  /** OpenAPI 3.0 flag that makes minimum exclusive */
+  exclusiveMinimum?: boolean;
+  /** OpenAPI 3.0 flag that makes maximum exclusive */
+  exclusiveMaximum?: boolean;
+  /** JSON Schema 2020-12 exclusive bound, kept apart from minimum */
+  exclusiveMinimumValue?: number;
+  /** JSON Schema 2020-12 exclusive bound, kept apart from maximum */
+  exclusiveMaximumValue?: number;
+  multipleOf?: number;
   maxItems?: number;
   minItems?: number;
+  uniqueItems?: boolean;
+  minProperties?: number;
+  maxProperties?: number;
+  nullable?: boolean;
+  readOnly?: boolean;
+  writeOnly?: boolean;
+  deprecated?: boolean;
+  default?: unknown;
   example?: any;
+  examples?: unknown[];
+  $defs?: Record<string, any>;
+  xml?: any;
+  externalDocs?: ExternalDocs;
   description?: string;
+  extensions?: Extensions;
 }
 
 export interface Parameter {
   id: string;
+  component?: string;
   name: string;
   in: 'query' | 'header' | 'path' | 'cookie';
   description?: string;
   required?: boolean;
+  deprecated?: boolean;
+  allowEmptyValue?: boolean;
+  allowReserved?: boolean;
   schema?: any;
+  content?: Record<string, MediaType>;
   style?: string;
   explode?: boolean;
+  example?: any;
+  examples?: Record<string, any>;
+  extensions?: Extensions;
 }
 
 export interface Response {
   id: string;
+  component?: string;
   description: string;
   headers?: Record<string, any>;
   content?: Record<string, MediaType>;
+  links?: Record<string, any>;
+  extensions?: Extensions;
 }
 
 export interface RequestBody {
   id: string;
+  component?: string;
   description?: string;
   content: Record<string, MediaType>;
   required?: boolean;
+  extensions?: Extensions;
 }
 
 export interface MediaType {
   schema?: string | any;
   example?: any;
   examples?: Record<string, any>;
+  encoding?: Record<string, any>;
+  extensions?: Extensions;
 }
 
 export interface SecurityScheme {
   id: string;
-  type: 'apiKey' | 'http' | 'oauth2' | 'openIdConnect';
+  component?: string;
+  type: 'apiKey' | 'http' | 'oauth2' | 'openIdConnect' | 'mutualTLS';
+  description?: string;
   name?: string;
   in?: 'query' | 'header' | 'cookie';
   scheme?: string;
   bearerFormat?: string;
   flows?: any;
   openIdConnectUrl?: string;
+  extensions?: Extensions;
 }
 
 export interface SecurityRequirement {
@@ -89,13 +212,146 @@ export interface SecurityRequirement {
 
 export interface NormalizedAPI {
   id: string;
+  revision?: number;
   metadata: APIMetadata;
   endpoints: Endpoint[];
+  /** Webhook operations, whose path is the webhook name */
+  webhooks?: Endpoint[];
+  pathItems?: PathItem[];
+  webhookItems?: PathItem[];
   schemas: Schema[];
   parameters: Parameter[];
   responses: Response[];
   requestBodies: RequestBody[];
   securitySchemes?: SecurityScheme[];
+  security?: SecurityRequirement[];
+  tags?: Tag[];
+  /** Component sections without a normalized form, kept as they are */
+  components?: Record<string, any>;
+  extensions?: Extensions;
+  createdAt?: string;
+  updatedAt?: string;
+}
+
+/** One x- field found in a definition, as the extensions endpoint lists it */
+export interface ExtensionUsage {
+  key: string;
+  value: unknown;
+  path: string;
+  level: string;
+  ownerId?: string;
+}
+
+export interface Revision {
+  definitionId: string;
+  number: number;
+  author?: string;
+  message?: string;
+  restoredFrom?: number;
+  createdAt: string;
+  definition?: NormalizedAPI;
+}
+
+export interface DiffChange {
+  type: 'added' | 'removed' | 'modified';
+  kind: string;
+  path: string;
+  endpoint?: string;
+  old: any;
+  new: any;
+}
+
+export interface DiffResult {
+  changes: DiffChange[];
+  summary: {
+    added: number;
+    removed: number;
+    modified: number;
+  };
+  markdown?: string;
+}
+
+export interface ClassifiedChange extends DiffChange {
+  severity: 'breaking' | 'non-breaking';
+  reason: string;
+}
+
+export interface CompatibilityReport {
+  definitionId: string;
+  revision: number;
+  against: number;
+  oldVersion: string;
+  newVersion: string;
+  majorVersionBump: boolean;
+  breaking: number;
+  nonBreaking: number;
+  releaseBlocked: boolean;
+  changes: ClassifiedChange[];
+}
+
+export interface SchemaExample {
+  definitionId: string;
+  schemaId: string;
+  seed: number;
+  example: unknown;
+  valid: boolean;
+  errors?: ValidationResult['errors'];
+}
+
+export interface ContractResult {
+  endpointId: string;
+  operationId?: string;
+  method: string;
+  path: string;
+  url: string;
+  status?: number;
+  passed: boolean;
+  durationMs: number;
+  failures?: ValidationResult['errors'];
+}
+
+export interface ContractReport {
+  definitionId: string;
+  baseUrl: string;
+  total: number;
+  passed: number;
+  failed: number;
+  results: ContractResult[];
+}
+
+export interface TrafficSample {
+  method: string;
+  url: string;
+  status?: number;
+}
+
+export interface TrafficViolation {
+  kind: 'undocumented-endpoint' | 'undocumented-method' | 'undocumented-field' | 'request' | 'response';
+  endpoint: string;
+  path?: string;
+  keyword?: string;
+  message: string;
+  count: number;
+  samples: TrafficSample[];
+}
+
+export interface TrafficReport {
+  definitionId: string;
+  exchanges: number;
+  conforming: number;
+  violating: number;
+  violations: TrafficViolation[];
+}
+
+export interface DefinitionEvent {
+  type: 'CREATED' | 'UPDATED' | 'DELETED' | 'VALIDATED';
+  definitionId: string;
+  revision: number;
+  author: string;
+  message: string;
+  valid?: boolean | null;
+  errors: ValidationResult['errors'];
+  occurredAt: string;
 }
 
 export interface SwaggerSpec {
@@ -136,6 +392,17 @@ export interface ConversionResult {
   data?: NormalizedAPI;
   error?: string;
   warnings?: string[];
+  warningDetails?: Array<{
+    path?: string;
+    message: string;
+  } & SourceRange>;
+}
+
+export interface SourceRange {
+  line?: number;
+  column?: number;
+  endLine?: number;
+  endColumn?: number;
 }
 
 export interface ValidationResult {
@@ -145,6 +412,14 @@ export interface ValidationResult {
     message: string;
     keyword?: string;
     params?: any;
-  }>;
+    rule?: string;
+    severity?: string;
+  } & SourceRange>;
   warnings?: string[];
+  warningDetails?: Array<{
+    path?: string;
+    message: string;
+    rule?: string;
+    severity?: string;
+  } & SourceRange>;
 }
\ No newline at end of file